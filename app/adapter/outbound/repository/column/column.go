@@ -0,0 +1,64 @@
+package column
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// MarshalList encodes a list for a jsonb column. A nil list is stored as an empty array.
+// The result is a string since byte slices are sent as bytea by the driver.
+func MarshalList[T any](list []T) (string, error) {
+	if list == nil {
+		list = []T{}
+	}
+	data, err := json.Marshal(list)
+	return string(data), err
+}
+
+// UnmarshalList decodes a jsonb column into a list. An empty array results in a nil list.
+func UnmarshalList[T any](data []byte, list *[]T) error {
+	if err := json.Unmarshal(data, list); err != nil {
+		return err
+	}
+	if len(*list) == 0 {
+		*list = nil
+	}
+	return nil
+}
+
+func NullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func NullInt(value int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(value), Valid: value != 0}
+}
+
+func NullTime(value time.Time) sql.NullTime {
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
+
+// textTimeLayout has a fixed width in UTC, so texts sort like their times. It keeps microseconds like timestamptz.
+const textTimeLayout = "2006-01-02T15:04:05.000000Z"
+
+// FormatTextTime encodes a time for databases without a time type, like SQLite. The zero time results in
+// the text of 0001-01-01, so it compares with other texts.
+func FormatTextTime(value time.Time) string {
+	return value.UTC().Round(time.Microsecond).Format(textTimeLayout)
+}
+
+func NullTextTime(value time.Time) sql.NullString {
+	if value.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: FormatTextTime(value), Valid: true}
+}
+
+// ParseTextTime decodes a time of FormatTextTime. Null results in the zero time.
+func ParseTextTime(value sql.NullString) (time.Time, error) {
+	if !value.Valid {
+		return time.Time{}, nil
+	}
+	return time.Parse(textTimeLayout, value.String)
+}
@@ -0,0 +1,72 @@
+package column
+
+import (
+	"podGopher/core/domain/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_marshal_nil_list_as_empty_array(t *testing.T) {
+	data, err := MarshalList[model.Person](nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", data)
+}
+
+func Test_should_unmarshal_list(t *testing.T) {
+	tests := map[string]struct {
+		data     string
+		expected []model.Person
+	}{
+		"empty array": {`[]`, nil},
+		"filled array": {
+			`[{"Name":"some host","Role":"host"}]`,
+			[]model.Person{{Name: "some host", Role: "host"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var persons []model.Person
+			err := UnmarshalList([]byte(test.data), &persons)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, persons)
+		})
+	}
+}
+
+func Test_should_map_zero_values_to_null(t *testing.T) {
+	assert.False(t, NullString("").Valid)
+	assert.True(t, NullString("value").Valid)
+	assert.False(t, NullInt(0).Valid)
+	assert.Equal(t, int64(3), NullInt(3).Int64)
+}
+
+func Test_should_format_text_time_in_sort_order(t *testing.T) {
+	times := []time.Time{
+		{},
+		time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 1, 14, 0, 0, 500000000, time.FixedZone("CEST", 2*60*60)),
+		time.Date(2024, 5, 1, 12, 0, 1, 0, time.UTC),
+	}
+
+	for i := 1; i < len(times); i++ {
+		assert.Less(t, FormatTextTime(times[i-1]), FormatTextTime(times[i]))
+	}
+	assert.Equal(t, "2024-05-01T12:00:00.500000Z", FormatTextTime(times[2]))
+}
+
+func Test_should_parse_text_time(t *testing.T) {
+	value := time.Date(2024, 5, 1, 14, 0, 0, 123456400, time.FixedZone("CEST", 2*60*60))
+
+	parsed, err := ParseTextTime(NullTextTime(value))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC), parsed)
+
+	parsed, err = ParseTextTime(NullTextTime(time.Time{}))
+	assert.Nil(t, err)
+	assert.True(t, parsed.IsZero())
+}
@@ -0,0 +1,33 @@
+// Package contract holds the behaviour every repository implementation has to show. Each implementation
+// runs the suite in its tests, so the implementations can not drift apart.
+//
+// The suite may run against a shared database, so every test creates its own shows and only looks at them.
+package contract
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"testing"
+)
+
+func Run(t *testing.T, repositories *repository.Repositories) {
+	t.Run("show", func(t *testing.T) {
+		runShowContract(t, repositories)
+	})
+	t.Run("episode", func(t *testing.T) {
+		runEpisodeContract(t, repositories)
+	})
+	t.Run("download", func(t *testing.T) {
+		runDownloadContract(t, repositories)
+	})
+	t.Run("job", func(t *testing.T) {
+		runJobContract(t, repositories)
+	})
+}
+
+// existence is the answer of an exists query, or its error, so a failed query does not pass for a missing entity.
+func existence(exists bool, err error) any {
+	if err != nil {
+		return err
+	}
+	return exists
+}
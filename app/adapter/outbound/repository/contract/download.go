@@ -0,0 +1,60 @@
+package contract
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runDownloadContract(t *testing.T, repositories *repository.Repositories) {
+	downloads := repositories.Downloads
+
+	t.Run("should record and get downloads of a show within period", func(t *testing.T) {
+		showId, episodeId := uuid.NewString(), uuid.NewString()
+		day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		newEvent := func(showId string, requestedAt time.Time) *model.DownloadEvent {
+			return &model.DownloadEvent{
+				EpisodeId:   episodeId,
+				ShowId:      showId,
+				MediaKey:    episodeId + "/episode.mp3",
+				Method:      "GET",
+				IpHash:      "some-ip-hash",
+				UserAgent:   "AppleCoreMedia/1.0.0",
+				Referrer:    "https://example.com",
+				RangeStart:  0,
+				RangeEnd:    1024,
+				RequestedAt: requestedAt,
+			}
+		}
+		later, earlier := newEvent(showId, day.Add(12*time.Hour)), newEvent(showId, day)
+		for _, event := range []*model.DownloadEvent{
+			newEvent(showId, day.Add(-time.Second)),
+			later,
+			earlier,
+			newEvent(showId, day.Add(24*time.Hour)),
+			newEvent(uuid.NewString(), day.Add(12*time.Hour)),
+		} {
+			require.Nil(t, downloads.RecordDownload(t.Context(), event))
+		}
+
+		found, err := downloads.GetDownloadsOfShow(t.Context(), showId, day, day.Add(24*time.Hour))
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.DownloadEvent{earlier, later}, found)
+	})
+
+	t.Run("should get no downloads of a show without downloads", func(t *testing.T) {
+		found, err := downloads.GetDownloadsOfShow(t.Context(), uuid.NewString(), time.Time{}, time.Now())
+		malformed, malformedErr := downloads.GetDownloadsOfShow(t.Context(), "not-a-uuid", time.Time{}, time.Now())
+
+		assert.Nil(t, err)
+		assert.Empty(t, found)
+		assert.Nil(t, malformedErr)
+		assert.Empty(t, malformed)
+	})
+}
@@ -0,0 +1,511 @@
+package contract
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveEpisode(t *testing.T, repositories *repository.Repositories, episode *model.Episode) *model.Episode {
+	if episode.Id == "" {
+		episode.Id = uuid.NewString()
+	}
+	if episode.Title == "" {
+		episode.Title = "episode " + uuid.NewString()
+	}
+	require.Nil(t, repositories.Episodes.SaveEpisode(t.Context(), episode))
+	return episode
+}
+
+func runEpisodeContract(t *testing.T, repositories *repository.Repositories) {
+	episodes := repositories.Episodes
+	publishedAt := time.Date(2024, 5, 1, 12, 30, 15, 123456000, time.UTC)
+
+	t.Run("should save and retrieve an episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "episodes "+uuid.NewString())
+		episode := &model.Episode{
+			Id:            uuid.NewString(),
+			ShowId:        show.Id,
+			Title:         "saved " + uuid.NewString(),
+			Description:   "some show notes",
+			Season:        1,
+			EpisodeNumber: 2,
+			Status:        model.EpisodePublished,
+			PublishedAt:   publishedAt,
+			Transcripts:   []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt", Language: "en"}},
+			Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+			Persons:       []model.Person{{Name: "some guest", Role: "guest"}},
+		}
+
+		require.Nil(t, episodes.SaveEpisode(t.Context(), episode))
+		found, err := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+
+		assert.Nil(t, err)
+		assert.Equal(t, episode, found)
+	})
+
+	t.Run("should refuse to save an episode for a cancelled request", func(t *testing.T) {
+		show := saveShow(t, repositories, "cancelled "+uuid.NewString())
+		cancelled, cancel := context.WithCancel(t.Context())
+		cancel()
+		episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "cancelled " + uuid.NewString()}
+
+		err := episodes.SaveEpisode(cancelled, episode)
+
+		assert.ErrorIs(t, err, context.Canceled)
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, found)
+	})
+
+	t.Run("should store values like database columns", func(t *testing.T) {
+		show := saveShow(t, repositories, "columns "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{
+			ShowId:      show.Id,
+			Status:      model.EpisodeDraft,
+			PublishedAt: time.Date(2024, 5, 1, 14, 30, 15, 123456400, time.FixedZone("CEST", 2*60*60)),
+			Transcripts: []model.Transcript{},
+			Chapters:    &model.Chapters{},
+		})
+
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+
+		assert.Equal(t, publishedAt, found.PublishedAt)
+		assert.Nil(t, found.Transcripts)
+		assert.Nil(t, found.Chapters)
+		assert.Nil(t, found.Persons)
+		assert.Nil(t, found.Media)
+	})
+
+	t.Run("should return nil for missing episode", func(t *testing.T) {
+		found, err := episodes.GetEpisodeOrNil(t.Context(), uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("should not find episodes by ids of another format", func(t *testing.T) {
+		malformed := &model.Episode{Id: "not-a-uuid", ShowId: "not-a-uuid", Title: "malformed " + uuid.NewString(), Status: model.EpisodePublished}
+
+		found, getErr := episodes.GetEpisodeOrNil(t.Context(), malformed.Id)
+		ofShow, ofShowErr := episodes.GetEpisodesOfShow(t.Context(), malformed.ShowId)
+		listed, listErr := episodes.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: malformed.ShowId, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 10})
+		updateErr := episodes.UpdateEpisode(t.Context(), malformed)
+		statusErr := episodes.ChangeEpisodeStatus(t.Context(), malformed, model.EpisodeDraft)
+		mediaErr := episodes.SaveEpisodeMedia(t.Context(), malformed.Id, &model.Media{Key: malformed.Id + "/episode.mp3"})
+		deleteErr := episodes.DeleteEpisode(t.Context(), malformed.Id)
+
+		assert.Nil(t, getErr)
+		assert.Nil(t, found)
+		assert.Nil(t, ofShowErr)
+		assert.Empty(t, ofShow)
+		assert.Nil(t, listErr)
+		assert.Empty(t, listed)
+		assert.Equal(t, false, existence(episodes.ExistsByTitle(t.Context(), malformed.ShowId, malformed.Title)))
+		assert.Equal(t, false, existence(episodes.ExistsOtherByTitle(t.Context(), malformed.ShowId, malformed.Id, malformed.Title)))
+		for _, err := range []error{updateErr, statusErr, mediaErr, deleteErr} {
+			assert.True(t, error2.IsRepositoryFailure(err, error2.NotFound), err)
+		}
+	})
+
+	t.Run("should not save an episode of a missing show", func(t *testing.T) {
+		err := episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: uuid.NewString(), Title: "orphan"})
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+	})
+
+	t.Run("should not save an episode twice", func(t *testing.T) {
+		show := saveShow(t, repositories, "twice "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		err := episodes.SaveEpisode(t.Context(), &model.Episode{Id: episode.Id, ShowId: show.Id, Title: "twice " + uuid.NewString()})
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+	})
+
+	t.Run("should not save an episode with title of another episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "unique "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		err := episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: episode.Title})
+
+		assert.Equal(t, error2.NewEpisodeAlreadyExistsError(episode.Title), err)
+	})
+
+	t.Run("should save episodes with the same title in different shows", func(t *testing.T) {
+		show := saveShow(t, repositories, "scoped "+uuid.NewString())
+		other := saveShow(t, repositories, "scoped other "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		err := episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: other.Id, Title: episode.Title})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("should save episodes with the same title in a show allowing duplicates", func(t *testing.T) {
+		show := newShow("duplicates " + uuid.NewString())
+		show.AllowDuplicateEpisodeTitles = true
+		require.Nil(t, repositories.Shows.SaveShow(t.Context(), show))
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		other := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		saveErr := episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: episode.Title})
+		updateErr := episodes.UpdateEpisode(t.Context(), &model.Episode{Id: other.Id, ShowId: show.Id, Title: episode.Title})
+
+		assert.Nil(t, saveErr)
+		assert.Nil(t, updateErr)
+	})
+
+	t.Run("should follow changes of the show to duplicate titles", func(t *testing.T) {
+		show := saveShow(t, repositories, "toggled "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		show.AllowDuplicateEpisodeTitles = true
+		require.Nil(t, repositories.Shows.UpdateShow(t.Context(), show))
+		require.Nil(t, episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: episode.Title}))
+
+		show.AllowDuplicateEpisodeTitles = false
+		err := repositories.Shows.UpdateShow(t.Context(), show)
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+		found, _ := repositories.Shows.GetShowOrNil(t.Context(), show.Id)
+		assert.True(t, found.AllowDuplicateEpisodeTitles)
+	})
+
+	t.Run("should disallow duplicate titles again once they are gone", func(t *testing.T) {
+		show := saveShow(t, repositories, "retoggled "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		show.AllowDuplicateEpisodeTitles = true
+		require.Nil(t, repositories.Shows.UpdateShow(t.Context(), show))
+
+		show.AllowDuplicateEpisodeTitles = false
+		require.Nil(t, repositories.Shows.UpdateShow(t.Context(), show))
+		err := episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: episode.Title})
+
+		assert.Equal(t, error2.NewEpisodeAlreadyExistsError(episode.Title), err)
+	})
+
+	t.Run("should save only one of concurrent episodes with the same title", func(t *testing.T) {
+		show := saveShow(t, repositories, "concurrent "+uuid.NewString())
+		title := "concurrent " + uuid.NewString()
+		errs := make([]error, 8)
+
+		var group sync.WaitGroup
+		for i := range errs {
+			group.Go(func() {
+				errs[i] = episodes.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: title})
+			})
+		}
+		group.Wait()
+
+		var saved int
+		for _, err := range errs {
+			if err == nil {
+				saved++
+			} else {
+				assert.Equal(t, error2.NewEpisodeAlreadyExistsError(title), err)
+			}
+		}
+		assert.Equal(t, 1, saved)
+		ofShow, _ := episodes.GetEpisodesOfShow(t.Context(), show.Id)
+		assert.Len(t, ofShow, 1)
+	})
+
+	t.Run("should not update an episode to title of another episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "unique update "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		other := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		err := episodes.UpdateEpisode(t.Context(), &model.Episode{Id: episode.Id, ShowId: show.Id, Title: other.Title})
+
+		assert.Equal(t, error2.NewEpisodeAlreadyExistsError(other.Title), err)
+	})
+
+	t.Run("should relate episodes to their show", func(t *testing.T) {
+		show := saveShow(t, repositories, "related "+uuid.NewString())
+		first := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		second := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		foundShow, _ := repositories.Shows.GetShowOrNil(t.Context(), show.Id)
+		ofShow, err := episodes.GetEpisodesOfShow(t.Context(), show.Id)
+
+		assert.ElementsMatch(t, []string{first.Id, second.Id}, foundShow.Episodes)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []*model.Episode{first, second}, ofShow)
+	})
+
+	t.Run("should get episodes of a show latest published first", func(t *testing.T) {
+		show := saveShow(t, repositories, "ordered "+uuid.NewString())
+		undated := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		older := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, PublishedAt: publishedAt})
+		newer := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, PublishedAt: publishedAt.Add(time.Hour)})
+		sameDate := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, PublishedAt: publishedAt})
+		firstOfDate, secondOfDate := older, sameDate
+		if sameDate.Id < older.Id {
+			firstOfDate, secondOfDate = sameDate, older
+		}
+
+		ofShow, err := episodes.GetEpisodesOfShow(t.Context(), show.Id)
+
+		assert.Nil(t, err)
+		var ids []string
+		for _, episode := range ofShow {
+			ids = append(ids, episode.Id)
+		}
+		assert.Equal(t, []string{newer.Id, firstOfDate.Id, secondOfDate.Id, undated.Id}, ids)
+	})
+
+	t.Run("should return no episodes of a show without episodes", func(t *testing.T) {
+		ofShow, err := episodes.GetEpisodesOfShow(t.Context(), uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.NotNil(t, ofShow)
+		assert.Empty(t, ofShow)
+	})
+
+	t.Run("should tell whether an episode with title exists", func(t *testing.T) {
+		show := saveShow(t, repositories, "titles "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		other := saveShow(t, repositories, "other titles "+uuid.NewString())
+
+		assert.Equal(t, true, existence(episodes.ExistsByTitle(t.Context(), show.Id, episode.Title)))
+		assert.Equal(t, false, existence(episodes.ExistsByTitle(t.Context(), show.Id, "other "+uuid.NewString())))
+		assert.Equal(t, false, existence(episodes.ExistsByTitle(t.Context(), other.Id, episode.Title)))
+		assert.Equal(t, false, existence(episodes.ExistsOtherByTitle(t.Context(), show.Id, episode.Id, episode.Title)))
+		assert.Equal(t, true, existence(episodes.ExistsOtherByTitle(t.Context(), show.Id, uuid.NewString(), episode.Title)))
+		assert.Equal(t, false, existence(episodes.ExistsOtherByTitle(t.Context(), other.Id, uuid.NewString(), episode.Title)))
+	})
+
+	t.Run("should save media of an episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "media "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		media := &model.Media{
+			Key:        episode.Id + "/episode.mp3",
+			FileName:   "episode.mp3",
+			MimeType:   "audio/mpeg",
+			Size:       1024,
+			Duration:   90*time.Second + 250*time.Microsecond,
+			Bitrate:    128000,
+			SampleRate: 44100,
+			Channels:   2,
+			Title:      "some media title",
+			Artwork:    &model.Artwork{Key: episode.Id + "/artwork.png", MimeType: "image/png"},
+			Chapters:   []model.MediaChapter{{Start: 0, Title: "Intro"}, {Start: 45 * time.Second, Title: "Topic"}},
+		}
+
+		require.Nil(t, episodes.SaveEpisodeMedia(t.Context(), episode.Id, media))
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+
+		media.Duration = 90 * time.Second
+		assert.Equal(t, media, found.Media)
+	})
+
+	t.Run("should update an episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "update "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{
+			ShowId:      show.Id,
+			Status:      model.EpisodePublished,
+			PublishedAt: publishedAt,
+			Chapters:    &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		})
+		media := &model.Media{Key: episode.Id + "/episode.mp3", FileName: "episode.mp3", MimeType: "audio/mpeg", Size: 1024}
+		require.Nil(t, episodes.SaveEpisodeMedia(t.Context(), episode.Id, media))
+		update := &model.Episode{
+			Id:            episode.Id,
+			ShowId:        show.Id,
+			Title:         "updated " + uuid.NewString(),
+			Description:   "updated show notes",
+			Season:        2,
+			EpisodeNumber: 3,
+			Status:        model.EpisodeDraft,
+			Persons:       []model.Person{{Name: "some guest"}},
+		}
+
+		require.Nil(t, episodes.UpdateEpisode(t.Context(), update))
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+
+		update.Status, update.PublishedAt, update.Media = episode.Status, episode.PublishedAt, media
+		assert.Equal(t, update, found)
+	})
+
+	t.Run("should delete an episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "delete "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+		other := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id})
+
+		require.Nil(t, episodes.DeleteEpisode(t.Context(), episode.Id))
+
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, found)
+		foundShow, _ := repositories.Shows.GetShowOrNil(t.Context(), show.Id)
+		assert.Equal(t, []string{other.Id}, foundShow.Episodes)
+		err := episodes.DeleteEpisode(t.Context(), episode.Id)
+		assert.True(t, error2.IsRepositoryFailure(err, error2.NotFound), err)
+	})
+
+	t.Run("should report changes of missing episode as not found", func(t *testing.T) {
+		missing := &model.Episode{Id: uuid.NewString(), ShowId: uuid.NewString(), Title: "missing " + uuid.NewString()}
+
+		updateErr := episodes.UpdateEpisode(t.Context(), missing)
+		mediaErr := episodes.SaveEpisodeMedia(t.Context(), missing.Id, &model.Media{Key: missing.Id + "/episode.mp3"})
+
+		assert.True(t, error2.IsRepositoryFailure(updateErr, error2.NotFound), updateErr)
+		assert.True(t, error2.IsRepositoryFailure(mediaErr, error2.NotFound), mediaErr)
+	})
+
+	t.Run("should change the status of an episode", func(t *testing.T) {
+		show := saveShow(t, repositories, "status "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodeDraft})
+
+		episode.Status, episode.PublishedAt = model.EpisodeScheduled, publishedAt
+		require.Nil(t, episodes.ChangeEpisodeStatus(t.Context(), episode, model.EpisodeDraft))
+
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Equal(t, model.EpisodeScheduled, found.Status)
+		assert.True(t, publishedAt.Equal(found.PublishedAt), found.PublishedAt)
+	})
+
+	t.Run("should not change the status of an episode which changed concurrently", func(t *testing.T) {
+		show := saveShow(t, repositories, "stale status "+uuid.NewString())
+		episode := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodePublished, PublishedAt: publishedAt})
+
+		stale := *episode
+		stale.Status, stale.PublishedAt = model.EpisodeScheduled, publishedAt.Add(time.Hour)
+		err := episodes.ChangeEpisodeStatus(t.Context(), &stale, model.EpisodeDraft)
+		missingErr := episodes.ChangeEpisodeStatus(t.Context(), &model.Episode{Id: uuid.NewString(), Status: model.EpisodePublished}, model.EpisodeDraft)
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.SerializationFailure), err)
+		assert.True(t, error2.IsRepositoryFailure(missingErr, error2.NotFound), missingErr)
+		found, _ := episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Equal(t, model.EpisodePublished, found.Status)
+		assert.True(t, publishedAt.Equal(found.PublishedAt), found.PublishedAt)
+	})
+
+	t.Run("should publish only scheduled episodes which are due", func(t *testing.T) {
+		show := saveShow(t, repositories, "due "+uuid.NewString())
+		due := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodeScheduled, PublishedAt: publishedAt.Add(-time.Hour)})
+		dueNow := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodeScheduled, PublishedAt: publishedAt})
+		later := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodeScheduled, PublishedAt: publishedAt.Add(time.Hour)})
+		draft := saveEpisode(t, repositories, &model.Episode{ShowId: show.Id, Status: model.EpisodeDraft, PublishedAt: publishedAt.Add(-time.Hour)})
+
+		published, err := episodes.PublishDueEpisodes(t.Context(), publishedAt)
+
+		assert.Nil(t, err)
+		// other tests share the database, so it may publish more than the episodes of this show
+		assert.Contains(t, published, due.Id)
+		assert.Contains(t, published, dueNow.Id)
+		assert.NotContains(t, published, later.Id)
+		assert.NotContains(t, published, draft.Id)
+		foundDue, _ := episodes.GetEpisodeOrNil(t.Context(), due.Id)
+		foundLater, _ := episodes.GetEpisodeOrNil(t.Context(), later.Id)
+		assert.Equal(t, model.EpisodePublished, foundDue.Status)
+		assert.True(t, due.PublishedAt.Equal(foundDue.PublishedAt), foundDue.PublishedAt)
+		assert.Equal(t, model.EpisodeScheduled, foundLater.Status)
+	})
+
+	t.Run("should list episodes of a show", func(t *testing.T) {
+		show := saveShow(t, repositories, "list "+uuid.NewString())
+		other := saveShow(t, repositories, "list other "+uuid.NewString())
+		_ = saveEpisode(t, repositories, &model.Episode{ShowId: other.Id, Status: model.EpisodePublished})
+		var saved []*model.Episode
+		for i, values := range []struct {
+			season, number int
+			status         model.EpisodeStatus
+			publishedAt    time.Time
+		}{
+			{1, 2, model.EpisodePublished, publishedAt},
+			{1, 1, model.EpisodePublished, publishedAt.Add(-time.Hour)},
+			{2, 1, model.EpisodePublished, publishedAt.Add(time.Hour)},
+			{0, 0, model.EpisodePublished, publishedAt},
+			{0, 0, model.EpisodeDraft, time.Time{}},
+			{2, 2, model.EpisodeDraft, time.Time{}},
+		} {
+			saved = append(saved, saveEpisode(t, repositories, &model.Episode{
+				ShowId:        show.Id,
+				Title:         "list " + string(rune('a'+i)) + " " + uuid.NewString(),
+				Season:        values.season,
+				EpisodeNumber: values.number,
+				Status:        values.status,
+				PublishedAt:   values.publishedAt,
+			}))
+		}
+
+		for _, sort := range []model.EpisodeSort{model.EpisodeSortPublishedAt, model.EpisodeSortNumber} {
+			for _, order := range []model.SortOrder{model.Ascending, model.Descending} {
+				t.Run(string(sort)+" "+string(order), func(t *testing.T) {
+					query := &model.EpisodeQuery{ShowId: show.Id, Sort: sort, Order: order, Limit: 2}
+					listed := listAllEpisodes(t, repositories, query)
+
+					expected := slices.Clone(saved)
+					slices.SortFunc(expected, func(a *model.Episode, b *model.Episode) int {
+						return compareEpisodes(sort, order, a, b)
+					})
+					assert.Equal(t, expected, listed)
+				})
+			}
+		}
+
+		t.Run("should list episodes of a status", func(t *testing.T) {
+			query := &model.EpisodeQuery{ShowId: show.Id, Status: model.EpisodeDraft, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 10}
+			listed, err := episodes.ListEpisodes(t.Context(), query)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []*model.Episode{saved[4], saved[5]}, listed)
+		})
+	})
+
+	t.Run("should list no episodes of a show without episodes", func(t *testing.T) {
+		listed, err := episodes.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: uuid.NewString(), Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 10})
+
+		assert.Nil(t, err)
+		assert.Empty(t, listed)
+	})
+
+	t.Run("should not list episodes of unknown sort", func(t *testing.T) {
+		_, err := episodes.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: uuid.NewString(), Sort: "unknown", Order: model.Ascending, Limit: 10})
+
+		assert.NotNil(t, err)
+	})
+}
+
+// listAllEpisodes pages through all episodes of a query.
+func listAllEpisodes(t *testing.T, repositories *repository.Repositories, query *model.EpisodeQuery) []*model.Episode {
+	var listed []*model.Episode
+	for {
+		page, err := repositories.Episodes.ListEpisodes(t.Context(), query)
+		require.Nil(t, err)
+		require.LessOrEqual(t, len(page), query.Limit)
+		listed = append(listed, page...)
+		if len(page) < query.Limit {
+			return listed
+		}
+		last := page[len(page)-1]
+		query.After = &model.EpisodeKey{Id: last.Id, PublishedAt: last.PublishedAt, Season: last.Season, EpisodeNumber: last.EpisodeNumber}
+	}
+}
+
+func compareEpisodes(sort model.EpisodeSort, order model.SortOrder, a *model.Episode, b *model.Episode) int {
+	var result int
+	if sort == model.EpisodeSortPublishedAt {
+		result = a.PublishedAt.Compare(b.PublishedAt)
+	} else if result = a.Season - b.Season; result == 0 {
+		result = a.EpisodeNumber - b.EpisodeNumber
+	}
+	if result == 0 {
+		result = strings.Compare(a.Id, b.Id)
+	}
+	if order == model.Descending {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,238 @@
+package contract
+
+import (
+	"podGopher/adapter/outbound/repository"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enqueueJob queues a pending job, whose type is unique unless given, so claims of other tests never take it.
+func enqueueJob(t *testing.T, repositories *repository.Repositories, job *model.Job) *model.Job {
+	job.Id = uuid.NewString()
+	if job.Type == "" {
+		job.Type = "contract " + uuid.NewString()
+	}
+	if job.Payload == nil {
+		job.Payload = []byte(`{"some":"payload"}`)
+	}
+	if job.Status == "" {
+		job.Status = model.JobPending
+	}
+	if job.MaxAttempts == 0 {
+		job.MaxAttempts = 3
+	}
+	queued, err := repositories.Jobs.EnqueueJob(t.Context(), job)
+	require.Nil(t, err)
+	require.True(t, queued)
+	return job
+}
+
+func runJobContract(t *testing.T, repositories *repository.Repositories) {
+	jobs := repositories.Jobs
+	now := time.Date(2024, 5, 1, 12, 30, 15, 123456000, time.UTC)
+	lease := time.Minute
+
+	t.Run("should enqueue and retrieve a job", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now, Payload: []byte(`{"episodeId":"some-episode-id"}`)})
+
+		found, err := jobs.GetJobOrNil(t.Context(), job.Id)
+
+		assert.Nil(t, err)
+		require.NotNil(t, found)
+		assert.JSONEq(t, `{"episodeId":"some-episode-id"}`, string(found.Payload))
+		found.Payload = job.Payload
+		assert.Equal(t, job, found)
+	})
+
+	t.Run("should return nil for missing job", func(t *testing.T) {
+		found, err := jobs.GetJobOrNil(t.Context(), uuid.NewString())
+		malformed, malformedErr := jobs.GetJobOrNil(t.Context(), "not-a-uuid")
+
+		assert.Nil(t, err)
+		assert.Nil(t, found)
+		assert.Nil(t, malformedErr)
+		assert.Nil(t, malformed)
+	})
+
+	t.Run("should not enqueue a job twice", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+
+		queued, err := jobs.EnqueueJob(t.Context(), job)
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+		assert.False(t, queued)
+	})
+
+	t.Run("should not enqueue a job with the unique key of a waiting or running job", func(t *testing.T) {
+		uniqueKey := "contract " + uuid.NewString()
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now, UniqueKey: uniqueKey})
+		duplicate := &model.Job{Id: uuid.NewString(), Type: job.Type, UniqueKey: uniqueKey, Payload: job.Payload,
+			Status: model.JobPending, MaxAttempts: 3, RunAt: now}
+
+		waiting, err := jobs.EnqueueJob(t.Context(), duplicate)
+		assert.Nil(t, err)
+		assert.False(t, waiting)
+		_, _ = jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+		running, err := jobs.EnqueueJob(t.Context(), duplicate)
+		assert.Nil(t, err)
+		assert.False(t, running)
+
+		found, _ := jobs.GetJobOrNil(t.Context(), duplicate.Id)
+		assert.Nil(t, found)
+		stored, _ := jobs.GetJobOrNil(t.Context(), job.Id)
+		assert.Equal(t, uniqueKey, stored.UniqueKey)
+	})
+
+	t.Run("should enqueue a job with the unique key of a finished job", func(t *testing.T) {
+		uniqueKey := "contract " + uuid.NewString()
+		completed := enqueueJob(t, repositories, &model.Job{RunAt: now, UniqueKey: uniqueKey})
+		claimed, _ := jobs.ClaimJob(t.Context(), []string{completed.Type}, now, lease)
+		require.Nil(t, jobs.CompleteJob(t.Context(), claimed))
+		buried := enqueueJob(t, repositories, &model.Job{Type: completed.Type, RunAt: now, UniqueKey: uniqueKey})
+		claimed, _ = jobs.ClaimJob(t.Context(), []string{buried.Type}, now, lease)
+		require.Nil(t, jobs.BuryJob(t.Context(), claimed))
+
+		enqueueJob(t, repositories, &model.Job{Type: completed.Type, RunAt: now, UniqueKey: uniqueKey})
+	})
+
+	t.Run("should claim a due job for the lease", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+
+		claimed, err := jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+
+		assert.Nil(t, err)
+		require.NotNil(t, claimed)
+		assert.Equal(t, job.Id, claimed.Id)
+		assert.Equal(t, model.JobRunning, claimed.Status)
+		assert.Equal(t, 1, claimed.Attempts)
+		assert.Equal(t, now.Add(lease), claimed.RunAt)
+		again, err := jobs.ClaimJob(t.Context(), []string{job.Type}, now.Add(lease-time.Second), lease)
+		assert.Nil(t, err)
+		assert.Nil(t, again)
+	})
+
+	t.Run("should claim a running job again once its lease ended", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+		first, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+
+		second, err := jobs.ClaimJob(t.Context(), []string{job.Type}, now.Add(lease), lease)
+
+		assert.Nil(t, err)
+		require.NotNil(t, second)
+		assert.Equal(t, 2, second.Attempts)
+		err = jobs.CompleteJob(t.Context(), first)
+		assert.True(t, error2.IsRepositoryFailure(err, error2.SerializationFailure), err)
+	})
+
+	t.Run("should claim the job which is due longest", func(t *testing.T) {
+		jobType := "contract " + uuid.NewString()
+		later := enqueueJob(t, repositories, &model.Job{Type: jobType, RunAt: now.Add(-time.Second)})
+		earlier := enqueueJob(t, repositories, &model.Job{Type: jobType, RunAt: now.Add(-time.Hour)})
+
+		first, _ := jobs.ClaimJob(t.Context(), []string{jobType}, now, lease)
+		second, _ := jobs.ClaimJob(t.Context(), []string{jobType}, now, lease)
+
+		require.NotNil(t, first)
+		require.NotNil(t, second)
+		assert.Equal(t, []string{earlier.Id, later.Id}, []string{first.Id, second.Id})
+	})
+
+	t.Run("should not claim jobs which are not due or of other types", func(t *testing.T) {
+		future := enqueueJob(t, repositories, &model.Job{RunAt: now.Add(time.Second)})
+		other := enqueueJob(t, repositories, &model.Job{RunAt: now})
+
+		claimed, err := jobs.ClaimJob(t.Context(), []string{future.Type}, now, lease)
+
+		assert.Nil(t, err)
+		assert.Nil(t, claimed)
+		found, _ := jobs.GetJobOrNil(t.Context(), other.Id)
+		assert.Equal(t, model.JobPending, found.Status)
+	})
+
+	t.Run("should remove a completed job", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+		claimed, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+
+		require.Nil(t, jobs.CompleteJob(t.Context(), claimed))
+
+		found, err := jobs.GetJobOrNil(t.Context(), job.Id)
+		assert.Nil(t, err)
+		assert.Nil(t, found)
+		err = jobs.CompleteJob(t.Context(), claimed)
+		assert.True(t, error2.IsRepositoryFailure(err, error2.SerializationFailure), err)
+	})
+
+	t.Run("should retry a failed job at its new date", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+		claimed, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+		claimed.RunAt, claimed.LastError = now.Add(time.Hour), "some error"
+
+		require.Nil(t, jobs.RetryJob(t.Context(), claimed))
+
+		found, _ := jobs.GetJobOrNil(t.Context(), job.Id)
+		assert.Equal(t, model.JobPending, found.Status)
+		assert.Equal(t, 1, found.Attempts)
+		assert.Equal(t, now.Add(time.Hour), found.RunAt)
+		assert.Equal(t, "some error", found.LastError)
+		early, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now.Add(time.Hour-time.Second), lease)
+		assert.Nil(t, early)
+		retried, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now.Add(time.Hour), lease)
+		require.NotNil(t, retried)
+		assert.Equal(t, 2, retried.Attempts)
+	})
+
+	t.Run("should keep a buried job as dead letter", func(t *testing.T) {
+		job := enqueueJob(t, repositories, &model.Job{RunAt: now})
+		claimed, _ := jobs.ClaimJob(t.Context(), []string{job.Type}, now, lease)
+		claimed.LastError = "some error"
+
+		require.Nil(t, jobs.BuryJob(t.Context(), claimed))
+
+		found, _ := jobs.GetJobOrNil(t.Context(), job.Id)
+		assert.Equal(t, model.JobDead, found.Status)
+		assert.Equal(t, "some error", found.LastError)
+		again, err := jobs.ClaimJob(t.Context(), []string{job.Type}, now.Add(24*time.Hour), lease)
+		assert.Nil(t, err)
+		assert.Nil(t, again)
+	})
+
+	t.Run("should hand every job to a single one of concurrent workers", func(t *testing.T) {
+		jobType := "contract " + uuid.NewString()
+		enqueued := map[string]bool{}
+		for range 8 {
+			enqueued[enqueueJob(t, repositories, &model.Job{Type: jobType, RunAt: now}).Id] = true
+		}
+		claims := make([][]string, 4)
+
+		var group sync.WaitGroup
+		for i := range claims {
+			group.Go(func() {
+				for {
+					claimed, err := jobs.ClaimJob(t.Context(), []string{jobType}, now, lease)
+					if err != nil || claimed == nil {
+						assert.Nil(t, err)
+						return
+					}
+					claims[i] = append(claims[i], claimed.Id)
+				}
+			})
+		}
+		group.Wait()
+
+		claimed := map[string]bool{}
+		for _, ids := range claims {
+			for _, id := range ids {
+				assert.False(t, claimed[id], "job %s claimed twice", id)
+				claimed[id] = true
+			}
+		}
+		assert.Equal(t, enqueued, claimed)
+	})
+}
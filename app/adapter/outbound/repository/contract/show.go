@@ -0,0 +1,470 @@
+package contract
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	show2 "podGopher/core/domain/service/show"
+	"podGopher/core/port/inbound"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newShow(title string) *model.Show {
+	return &model.Show{Id: uuid.NewString(), Title: title, Slug: title + "-slug"}
+}
+
+// newShowWithId is a show with a slug of its own, so only the title may collide with other shows.
+func newShowWithId(id string, title string) *model.Show {
+	return &model.Show{Id: id, Title: title, Slug: "slug-" + uuid.NewString()}
+}
+
+func saveShow(t *testing.T, repositories *repository.Repositories, title string) *model.Show {
+	show := newShow(title)
+	require.Nil(t, repositories.Shows.SaveShow(t.Context(), show))
+	return show
+}
+
+func runShowContract(t *testing.T, repositories *repository.Repositories) {
+	shows := repositories.Shows
+
+	t.Run("should save and retrieve a show", func(t *testing.T) {
+		show := &model.Show{
+			Id:                          uuid.NewString(),
+			Title:                       "saved " + uuid.NewString(),
+			Slug:                        "saved-" + uuid.NewString(),
+			Guid:                        uuid.NewString(),
+			Locked:                      true,
+			AllowDuplicateEpisodeTitles: true,
+			Funding:                     []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+			Persons:                     []model.Person{{Name: "some host", Role: "host", Href: "https://example.com"}},
+			Description:                 "some description",
+			Language:                    "en-US",
+			Author:                      "some author",
+			ImageUrl:                    "https://example.com/cover.jpg",
+			Category:                    "Technology",
+			Subcategory:                 "Tech News",
+			Explicit:                    true,
+		}
+
+		require.Nil(t, shows.SaveShow(t.Context(), show))
+		found, err := shows.GetShowOrNil(t.Context(), show.Id)
+
+		assert.Nil(t, err)
+		assert.WithinDuration(t, time.Now(), found.CreatedAt, time.Minute)
+		assert.Equal(t, time.UTC, found.CreatedAt.Location())
+		found.CreatedAt = time.Time{}
+		assert.Equal(t, show, found)
+	})
+
+	t.Run("should return nil for missing show", func(t *testing.T) {
+		found, err := shows.GetShowOrNil(t.Context(), uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("should not find shows by ids of another format", func(t *testing.T) {
+		show := saveShow(t, repositories, "malformed "+uuid.NewString())
+		malformed := &model.Show{Id: "not-a-uuid", Title: "malformed " + uuid.NewString(), Slug: "malformed-" + uuid.NewString()}
+
+		found, getErr := shows.GetShowOrNil(t.Context(), malformed.Id)
+		updateErr := shows.UpdateShow(t.Context(), malformed)
+		deleteErr := shows.DeleteShow(t.Context(), malformed.Id)
+
+		assert.Nil(t, getErr)
+		assert.Nil(t, found)
+		assert.True(t, error2.IsRepositoryFailure(updateErr, error2.NotFound), updateErr)
+		assert.True(t, error2.IsRepositoryFailure(deleteErr, error2.NotFound), deleteErr)
+		assert.Equal(t, true, existence(shows.ExistsOtherByTitleOrSlug(t.Context(), malformed.Id, show.Title, show.Slug)))
+	})
+
+	t.Run("should return empty lists as nil", func(t *testing.T) {
+		show := newShow("empty " + uuid.NewString())
+		show.Funding, show.Persons = []model.Funding{}, []model.Person{}
+		require.Nil(t, shows.SaveShow(t.Context(), show))
+
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+
+		assert.Nil(t, found.Funding)
+		assert.Nil(t, found.Persons)
+		assert.Nil(t, found.Episodes)
+	})
+
+	t.Run("should not save a show twice", func(t *testing.T) {
+		show := saveShow(t, repositories, "twice "+uuid.NewString())
+
+		err := shows.SaveShow(t.Context(), newShowWithId(show.Id, "twice "+uuid.NewString()))
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+	})
+
+	t.Run("should not save a show with title or slug of another show", func(t *testing.T) {
+		show := saveShow(t, repositories, "unique "+uuid.NewString())
+		sameTitle := &model.Show{Id: uuid.NewString(), Title: show.Title, Slug: "unique-" + uuid.NewString()}
+		sameSlug := &model.Show{Id: uuid.NewString(), Title: "unique " + uuid.NewString(), Slug: show.Slug}
+
+		assert.Equal(t, error2.NewShowAlreadyExistsError(show.Title), shows.SaveShow(t.Context(), sameTitle))
+		assert.Equal(t, error2.NewShowAlreadyExistsError(sameSlug.Title), shows.SaveShow(t.Context(), sameSlug))
+	})
+
+	t.Run("should save only one of concurrent shows with the same title", func(t *testing.T) {
+		title := "concurrent " + uuid.NewString()
+		errs := make([]error, 8)
+
+		var group sync.WaitGroup
+		for i := range errs {
+			group.Go(func() {
+				errs[i] = shows.SaveShow(t.Context(), newShowWithId(uuid.NewString(), title))
+			})
+		}
+		group.Wait()
+
+		var saved int
+		for _, err := range errs {
+			if err == nil {
+				saved++
+			} else {
+				assert.Equal(t, error2.NewShowAlreadyExistsError(title), err)
+			}
+		}
+		assert.Equal(t, 1, saved)
+	})
+
+	t.Run("should refuse to work for a cancelled request", func(t *testing.T) {
+		cancelled, cancel := context.WithCancel(t.Context())
+		cancel()
+		show := newShow("cancelled " + uuid.NewString())
+
+		saveErr := shows.SaveShow(cancelled, show)
+		found, getErr := shows.GetShowOrNil(cancelled, show.Id)
+
+		assert.ErrorIs(t, saveErr, context.Canceled)
+		assert.ErrorIs(t, getErr, context.Canceled)
+		assert.Nil(t, found)
+		assert.Equal(t, false, existence(shows.ExistsByTitleOrSlug(t.Context(), show.Title, show.Slug)))
+		_, existsErr := shows.ExistsByTitleOrSlug(cancelled, show.Title, show.Slug)
+		assert.ErrorIs(t, existsErr, context.Canceled)
+	})
+
+	t.Run("should not be changed through a retrieved show", func(t *testing.T) {
+		show := saveShow(t, repositories, "copied "+uuid.NewString())
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+		found.Title = "changed"
+
+		foundAgain, _ := shows.GetShowOrNil(t.Context(), show.Id)
+
+		assert.Equal(t, show.Title, foundAgain.Title)
+	})
+
+	t.Run("should tell whether a show with title or slug exists", func(t *testing.T) {
+		show := saveShow(t, repositories, "exists "+uuid.NewString())
+
+		assert.Equal(t, true, existence(shows.ExistsByTitleOrSlug(t.Context(), show.Title, "other-slug")))
+		assert.Equal(t, true, existence(shows.ExistsByTitleOrSlug(t.Context(), "other title", show.Slug)))
+		assert.Equal(t, false, existence(shows.ExistsByTitleOrSlug(t.Context(), "other "+uuid.NewString(), "other-"+uuid.NewString())))
+		assert.Equal(t, false, existence(shows.ExistsOtherByTitleOrSlug(t.Context(), show.Id, show.Title, show.Slug)))
+		assert.Equal(t, true, existence(shows.ExistsOtherByTitleOrSlug(t.Context(), uuid.NewString(), show.Title, "other-slug")))
+	})
+
+	t.Run("should set guid of shows without guid", func(t *testing.T) {
+		withoutGuid := saveShow(t, repositories, "without guid "+uuid.NewString())
+		withGuid := newShow("with guid " + uuid.NewString())
+		withGuid.Guid = uuid.NewString()
+		require.Nil(t, shows.SaveShow(t.Context(), withGuid))
+
+		showIds, err := shows.GetShowIdsWithoutGuid(t.Context())
+
+		assert.Nil(t, err)
+		assert.Contains(t, showIds, withoutGuid.Id)
+		assert.NotContains(t, showIds, withGuid.Id)
+
+		assert.Nil(t, shows.SetShowGuidIfMissing(t.Context(), withoutGuid.Id, "backfilled-guid"))
+		assert.Nil(t, shows.SetShowGuidIfMissing(t.Context(), withGuid.Id, "backfilled-guid"))
+		assert.Nil(t, shows.SetShowGuidIfMissing(t.Context(), uuid.NewString(), "backfilled-guid"))
+		found, _ := shows.GetShowOrNil(t.Context(), withoutGuid.Id)
+		assert.Equal(t, "backfilled-guid", found.Guid)
+		found, _ = shows.GetShowOrNil(t.Context(), withGuid.Id)
+		assert.Equal(t, withGuid.Guid, found.Guid)
+		showIds, _ = shows.GetShowIdsWithoutGuid(t.Context())
+		assert.NotContains(t, showIds, withoutGuid.Id)
+	})
+
+	t.Run("should update a show", func(t *testing.T) {
+		show := saveShow(t, repositories, "update "+uuid.NewString())
+		saved, _ := shows.GetShowOrNil(t.Context(), show.Id)
+		update := &model.Show{
+			Id:                          show.Id,
+			Title:                       "updated " + uuid.NewString(),
+			Slug:                        "updated-" + uuid.NewString(),
+			Guid:                        uuid.NewString(),
+			Locked:                      true,
+			AllowDuplicateEpisodeTitles: true,
+			Persons:                     []model.Person{{Name: "some host"}},
+			Description:                 "updated description",
+			Language:                    "de",
+			Author:                      "other author",
+			ImageUrl:                    "https://example.com/other.jpg",
+			Category:                    "News",
+			Explicit:                    true,
+		}
+
+		require.Nil(t, shows.UpdateShow(t.Context(), update))
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+
+		update.CreatedAt = saved.CreatedAt
+		assert.Equal(t, update, found)
+	})
+
+	t.Run("should report update of missing show as not found", func(t *testing.T) {
+		show := newShow("missing " + uuid.NewString())
+
+		err := shows.UpdateShow(t.Context(), show)
+
+		assert.True(t, error2.IsRepositoryFailure(err, error2.NotFound), err)
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+		assert.Nil(t, found)
+	})
+
+	t.Run("should not update a show to title or slug of another show", func(t *testing.T) {
+		show := saveShow(t, repositories, "unique update "+uuid.NewString())
+		other := saveShow(t, repositories, "unique update "+uuid.NewString())
+
+		err := shows.UpdateShow(t.Context(), &model.Show{Id: show.Id, Title: show.Title, Slug: other.Slug})
+
+		assert.Equal(t, error2.NewShowAlreadyExistsError(show.Title), err)
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+		assert.Equal(t, show.Slug, found.Slug)
+	})
+
+	t.Run("should not save or update a show to a former slug of another show", func(t *testing.T) {
+		show := saveShow(t, repositories, "alias owner "+uuid.NewString())
+		formerSlug := show.Slug
+		show.Slug = "current-" + uuid.NewString()
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+		other := saveShow(t, repositories, "alias other "+uuid.NewString())
+		newcomer := newShow("alias newcomer " + uuid.NewString())
+		newcomer.Slug = formerSlug
+
+		saveErr := shows.SaveShow(t.Context(), newcomer)
+		updateErr := shows.UpdateShow(t.Context(), &model.Show{Id: other.Id, Title: other.Title, Slug: formerSlug})
+
+		assert.Equal(t, error2.NewShowAlreadyExistsError(newcomer.Title), saveErr)
+		assert.Equal(t, error2.NewShowAlreadyExistsError(other.Title), updateErr)
+		found, _ := shows.GetShowBySlugOrNil(t.Context(), formerSlug)
+		assert.Equal(t, show.Id, found.Id)
+		foundOther, _ := shows.GetShowOrNil(t.Context(), other.Id)
+		assert.Equal(t, other.Slug, foundOther.Slug)
+	})
+
+	t.Run("should generate another slug than a former slug taken concurrently", func(t *testing.T) {
+		suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+		title := "Renamed " + suffix
+		show := newShow("rename " + suffix)
+		show.Slug = model.NewSlug(title)
+		require.Nil(t, shows.SaveShow(t.Context(), show))
+		show.Slug = "current-" + suffix
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+		service := show2.NewCreateShowService(&renamedConcurrently{ShowRepository: shows}, func(showId string) string {
+			return "https://example.com/feeds/" + showId
+		})
+
+		response, err := service.CreateShow(t.Context(), &inbound.CreateShowCommand{Title: title})
+
+		require.Nil(t, err)
+		assert.Equal(t, model.NewSlug(title)+"-2", response.Slug)
+		found, _ := shows.GetShowBySlugOrNil(t.Context(), model.NewSlug(title))
+		assert.Equal(t, show.Id, found.Id)
+	})
+
+	t.Run("should find a show by slug", func(t *testing.T) {
+		show := saveShow(t, repositories, "by slug "+uuid.NewString())
+
+		found, err := shows.GetShowBySlugOrNil(t.Context(), show.Slug)
+
+		assert.Nil(t, err)
+		assert.Equal(t, show.Id, found.Id)
+		missing, err := shows.GetShowBySlugOrNil(t.Context(), "missing-"+uuid.NewString())
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("should keep former slugs of a show", func(t *testing.T) {
+		show := saveShow(t, repositories, "former slug "+uuid.NewString())
+		formerSlug := show.Slug
+		show.Slug = "current-" + uuid.NewString()
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+
+		found, err := shows.GetShowBySlugOrNil(t.Context(), formerSlug)
+
+		assert.Nil(t, err)
+		assert.Equal(t, show.Id, found.Id)
+		assert.Equal(t, show.Slug, found.Slug)
+		assert.Equal(t, true, existence(shows.ExistsBySlug(t.Context(), formerSlug)))
+		assert.Equal(t, true, existence(shows.ExistsByTitleOrSlug(t.Context(), "other "+uuid.NewString(), formerSlug)))
+		assert.Equal(t, true, existence(shows.ExistsOtherByTitleOrSlug(t.Context(), uuid.NewString(), "other "+uuid.NewString(), formerSlug)))
+		assert.Equal(t, false, existence(shows.ExistsOtherByTitleOrSlug(t.Context(), show.Id, show.Title, formerSlug)))
+	})
+
+	t.Run("should let a show return to a former slug", func(t *testing.T) {
+		show := saveShow(t, repositories, "return slug "+uuid.NewString())
+		formerSlug := show.Slug
+		show.Slug = "interim-" + uuid.NewString()
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+		interimSlug := show.Slug
+		show.Slug = formerSlug
+
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+
+		found, _ := shows.GetShowBySlugOrNil(t.Context(), formerSlug)
+		assert.Equal(t, formerSlug, found.Slug)
+		foundByInterim, _ := shows.GetShowBySlugOrNil(t.Context(), interimSlug)
+		assert.Equal(t, show.Id, foundByInterim.Id)
+	})
+
+	t.Run("should tell whether a slug is taken", func(t *testing.T) {
+		show := saveShow(t, repositories, "slug taken "+uuid.NewString())
+
+		assert.Equal(t, true, existence(shows.ExistsBySlug(t.Context(), show.Slug)))
+		assert.Equal(t, false, existence(shows.ExistsBySlug(t.Context(), "free-"+uuid.NewString())))
+	})
+
+	t.Run("should delete a show with its episodes", func(t *testing.T) {
+		show := saveShow(t, repositories, "delete "+uuid.NewString())
+		episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "delete " + uuid.NewString()}
+		require.Nil(t, repositories.Episodes.SaveEpisode(t.Context(), episode))
+
+		require.Nil(t, shows.DeleteShow(t.Context(), show.Id))
+
+		found, _ := shows.GetShowOrNil(t.Context(), show.Id)
+		assert.Nil(t, found)
+		foundEpisode, _ := repositories.Episodes.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, foundEpisode)
+		err := shows.DeleteShow(t.Context(), show.Id)
+		assert.True(t, error2.IsRepositoryFailure(err, error2.NotFound), err)
+	})
+
+	t.Run("should release former slugs of a deleted show", func(t *testing.T) {
+		show := saveShow(t, repositories, "delete slug "+uuid.NewString())
+		formerSlug := show.Slug
+		show.Slug = "current-" + uuid.NewString()
+		require.Nil(t, shows.UpdateShow(t.Context(), show))
+
+		require.Nil(t, shows.DeleteShow(t.Context(), show.Id))
+
+		assert.Equal(t, false, existence(shows.ExistsBySlug(t.Context(), formerSlug)))
+		found, _ := shows.GetShowBySlugOrNil(t.Context(), formerSlug)
+		assert.Nil(t, found)
+	})
+
+	t.Run("should list shows", func(t *testing.T) {
+		marker := uuid.NewString()
+		var saved []*model.Show
+		for _, title := range []string{"delta", "alpha", "charlie", "bravo", "echo"} {
+			saved = append(saved, saveShow(t, repositories, marker+" "+title))
+		}
+		_ = saveShow(t, repositories, "other "+uuid.NewString())
+
+		for _, sort := range []model.ShowSort{model.ShowSortTitle, model.ShowSortCreatedAt} {
+			for _, order := range []model.SortOrder{model.Ascending, model.Descending} {
+				t.Run(string(sort)+" "+string(order), func(t *testing.T) {
+					listed := listAllShows(t, repositories, &model.ShowQuery{Title: strings.ToUpper(marker), Sort: sort, Order: order, Limit: 2})
+
+					assert.Len(t, listed, len(saved))
+					assert.True(t, slices.IsSortedFunc(listed, func(a *model.Show, b *model.Show) int {
+						return compareShows(sort, order, a, b)
+					}))
+				})
+			}
+		}
+
+		t.Run("should list shows by title", func(t *testing.T) {
+			listed, err := shows.ListShows(t.Context(), &model.ShowQuery{Title: marker, Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 3})
+
+			assert.Nil(t, err)
+			var titles []string
+			for _, show := range listed {
+				titles = append(titles, strings.TrimPrefix(show.Title, marker+" "))
+			}
+			assert.Equal(t, []string{"alpha", "bravo", "charlie"}, titles)
+		})
+	})
+
+	t.Run("should escape title query", func(t *testing.T) {
+		marker := uuid.NewString()
+		_ = saveShow(t, repositories, marker+" 100%")
+		_ = saveShow(t, repositories, marker+" 1000")
+
+		listed, err := shows.ListShows(t.Context(), &model.ShowQuery{Title: marker + " 100%", Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 10})
+
+		assert.Nil(t, err)
+		assert.Len(t, listed, 1)
+	})
+
+	t.Run("should not list shows of unknown sort", func(t *testing.T) {
+		_, err := shows.ListShows(t.Context(), &model.ShowQuery{Sort: "unknown", Order: model.Ascending, Limit: 10})
+
+		assert.NotNil(t, err)
+	})
+}
+
+// listAllShows pages through all shows of a query.
+// renamedConcurrently misses a slug on the first exists queries, as if another show was renamed from it right
+// after the queries, so only the repository can refuse the slug on saving.
+type renamedConcurrently struct {
+	repository.ShowRepository
+	missedBySlug, missedByTitleOrSlug bool
+}
+
+func (shows *renamedConcurrently) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	if !shows.missedBySlug {
+		shows.missedBySlug = true
+		return false, nil
+	}
+	return shows.ShowRepository.ExistsBySlug(ctx, slug)
+}
+
+func (shows *renamedConcurrently) ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (bool, error) {
+	if !shows.missedByTitleOrSlug {
+		shows.missedByTitleOrSlug = true
+		return false, nil
+	}
+	return shows.ShowRepository.ExistsByTitleOrSlug(ctx, title, slug)
+}
+
+func listAllShows(t *testing.T, repositories *repository.Repositories, query *model.ShowQuery) []*model.Show {
+	var listed []*model.Show
+	for {
+		page, err := repositories.Shows.ListShows(t.Context(), query)
+		require.Nil(t, err)
+		require.LessOrEqual(t, len(page), query.Limit)
+		listed = append(listed, page...)
+		if len(page) < query.Limit {
+			return listed
+		}
+		last := page[len(page)-1]
+		query.After = &model.ShowKey{Id: last.Id, Title: last.Title, CreatedAt: last.CreatedAt}
+	}
+}
+
+func compareShows(sort model.ShowSort, order model.SortOrder, a *model.Show, b *model.Show) int {
+	result := strings.Compare(a.Title, b.Title)
+	if sort == model.ShowSortCreatedAt {
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if result == 0 {
+		result = strings.Compare(a.Id, b.Id)
+	}
+	if order == model.Descending {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4/source"
+)
+
+// DatabaseHealthCheck reports the database as down if it does not answer a ping.
+type DatabaseHealthCheck struct {
+	db *sql.DB
+}
+
+func NewDatabaseHealthCheck(db *sql.DB) *DatabaseHealthCheck {
+	return &DatabaseHealthCheck{db}
+}
+
+func (check *DatabaseHealthCheck) ComponentName() string {
+	return "database"
+}
+
+// CheckHealth does not report the error of the ping, since it names the host of the database.
+func (check *DatabaseHealthCheck) CheckHealth(ctx context.Context) (map[string]any, error) {
+	stats := check.db.Stats()
+	details := map[string]any{"openConnections": stats.OpenConnections, "inUse": stats.InUse}
+	if err := check.db.PingContext(ctx); err != nil {
+		return details, errors.New("database does not answer")
+	}
+	return details, nil
+}
+
+// MigrationStatus is implemented by the migrations of each database.
+type MigrationStatus interface {
+	// Version is the version of the last applied migration, or 0 without any. Dirty reports a failed migration.
+	Version() (version uint, dirty bool, err error)
+	// LatestVersion is the version of the last migration the app carries.
+	LatestVersion() uint
+}
+
+// MigrationHealthCheck reports the database as not ready unless it is migrated to the latest version the app
+// carries, as statements of the app may need the schema of any of its migrations.
+type MigrationHealthCheck struct {
+	migration MigrationStatus
+}
+
+func NewMigrationHealthCheck(migration MigrationStatus) *MigrationHealthCheck {
+	return &MigrationHealthCheck{migration}
+}
+
+func (check *MigrationHealthCheck) ComponentName() string {
+	return "migration"
+}
+
+func (check *MigrationHealthCheck) CheckHealth(_ context.Context) (map[string]any, error) {
+	expected := check.migration.LatestVersion()
+	version, dirty, err := check.migration.Version()
+	if err != nil {
+		return map[string]any{"expectedVersion": expected}, errors.New("migration version is unknown")
+	}
+	details := map[string]any{"version": version, "dirty": dirty, "expectedVersion": expected}
+	if dirty {
+		return details, fmt.Errorf("migration %d failed", version)
+	}
+	if version != expected {
+		return details, fmt.Errorf("database is at migration %d instead of %d", version, expected)
+	}
+	return details, nil
+}
+
+// LatestMigrationVersion is the version of the last migration of source.
+func LatestMigrationVersion(source source.Driver) (uint, error) {
+	version, err := source.First()
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	for err == nil {
+		var next uint
+		if next, err = source.Next(version); err == nil {
+			version = next
+		}
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+	return version, nil
+}
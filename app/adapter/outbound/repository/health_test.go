@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+	"podGopher/core/port/outbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type migrationTestStatus struct {
+	version uint
+	dirty   bool
+	err     error
+}
+
+func (status *migrationTestStatus) Version() (uint, bool, error) {
+	return status.version, status.dirty, status.err
+}
+
+func (status *migrationTestStatus) LatestVersion() uint {
+	return 6
+}
+
+func Test_should_implement_health_check_port(t *testing.T) {
+	assert.Implements(t, (*outbound.HealthCheckPort)(nil), NewDatabaseHealthCheck(nil))
+	assert.Implements(t, (*outbound.HealthCheckPort)(nil), NewMigrationHealthCheck(nil))
+}
+
+func Test_should_check_migration_version(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		status  *migrationTestStatus
+		details map[string]any
+		err     string
+	}{
+		{"should be up at latest version", &migrationTestStatus{version: 6},
+			map[string]any{"version": uint(6), "dirty": false, "expectedVersion": uint(6)}, ""},
+		{"should be down at older version", &migrationTestStatus{version: 5},
+			map[string]any{"version": uint(5), "dirty": false, "expectedVersion": uint(6)}, "database is at migration 5 instead of 6"},
+		{"should be down if dirty", &migrationTestStatus{version: 6, dirty: true},
+			map[string]any{"version": uint(6), "dirty": true, "expectedVersion": uint(6)}, "migration 6 failed"},
+		{"should be down without version", &migrationTestStatus{err: errors.New("some error")},
+			map[string]any{"expectedVersion": uint(6)}, "migration version is unknown"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			details, err := NewMigrationHealthCheck(test.status).CheckHealth(t.Context())
+
+			assert.Equal(t, test.details, details)
+			if test.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/contract"
+	"podGopher/core/domain/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRepositories() *repository.Repositories {
+	store := NewStore()
+	return &repository.Repositories{
+		Shows:     NewMemoryShowRepository(store),
+		Episodes:  NewMemoryEpisodeRepository(store),
+		Downloads: NewMemoryDownloadRepository(store),
+		Jobs:      NewMemoryJobRepository(store),
+	}
+}
+
+func Test_memory_repositories_should_implement_ports(t *testing.T) {
+	store := NewStore()
+
+	assert.Implements(t, (*repository.ShowRepository)(nil), NewMemoryShowRepository(store))
+	assert.Implements(t, (*repository.EpisodeRepository)(nil), NewMemoryEpisodeRepository(store))
+	assert.Implements(t, (*repository.DownloadRepository)(nil), NewMemoryDownloadRepository(store))
+	assert.Implements(t, (*repository.JobRepository)(nil), NewMemoryJobRepository(store))
+}
+
+func Test_memory_repositories_should_fulfill_contract(t *testing.T) {
+	contract.Run(t, newRepositories())
+}
+
+func Test_memory_repositories_should_not_share_data_across_stores(t *testing.T) {
+	repositories := newRepositories()
+	show := &model.Show{Id: uuid.NewString(), Title: "some title", Slug: "some-slug"}
+	assert.Nil(t, repositories.Shows.SaveShow(t.Context(), show))
+
+	found, err := newRepositories().Shows.GetShowOrNil(t.Context(), show.Id)
+
+	assert.Nil(t, err)
+	assert.Nil(t, found)
+}
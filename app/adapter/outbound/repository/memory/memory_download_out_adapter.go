@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"slices"
+	"time"
+)
+
+type MemoryDownloadOutAdapter struct {
+	store *Store
+}
+
+func (adapter *MemoryDownloadOutAdapter) RecordDownload(ctx context.Context, event *model.DownloadEvent) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored := *event
+	stored.RequestedAt = storedTime(event.RequestedAt)
+	adapter.store.downloads = append(adapter.store.downloads, &stored)
+	return nil
+}
+
+// GetDownloadsOfShow returns the downloads requested in [from, to).
+func (adapter *MemoryDownloadOutAdapter) GetDownloadsOfShow(ctx context.Context, showId string, from time.Time, to time.Time) ([]*model.DownloadEvent, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	var downloads []*model.DownloadEvent
+	for _, stored := range adapter.store.downloads {
+		if stored.ShowId == showId && !stored.RequestedAt.Before(from) && stored.RequestedAt.Before(to) {
+			event := *stored
+			downloads = append(downloads, &event)
+		}
+	}
+	slices.SortStableFunc(downloads, func(a *model.DownloadEvent, b *model.DownloadEvent) int {
+		return a.RequestedAt.Compare(b.RequestedAt)
+	})
+	return downloads, nil
+}
+
+func NewMemoryDownloadRepository(store *Store) *MemoryDownloadOutAdapter {
+	return &MemoryDownloadOutAdapter{store: store}
+}
@@ -0,0 +1,239 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"slices"
+	"strings"
+	"time"
+)
+
+type MemoryEpisodeOutAdapter struct {
+	store *Store
+}
+
+// SaveEpisode stores an episode and relates it to its show, which has to exist.
+func (adapter *MemoryEpisodeOutAdapter) SaveEpisode(ctx context.Context, episode *model.Episode) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	if _, exists := adapter.store.shows[episode.ShowId]; !exists {
+		return errConstraintViolation("show '%s' of episode '%s' does not exist", episode.ShowId, episode.Id)
+	}
+	if _, exists := adapter.store.episodes[episode.Id]; exists {
+		return errConstraintViolation("episode '%s' is already stored", episode.Id)
+	}
+	if !adapter.duplicatesAllowed(episode.ShowId) && adapter.titleTaken(episode.ShowId, episode.Id, episode.Title) {
+		return error2.NewEpisodeAlreadyExistsError(episode.Title)
+	}
+	adapter.store.episodes[episode.Id] = copyEpisode(episode)
+	adapter.store.showEpisodes[episode.ShowId] = append(adapter.store.showEpisodes[episode.ShowId], episode.Id)
+	return nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) ExistsByTitle(ctx context.Context, showId string, title string) (bool, error) {
+	return adapter.ExistsOtherByTitle(ctx, showId, "", title)
+}
+
+func (adapter *MemoryEpisodeOutAdapter) ExistsOtherByTitle(ctx context.Context, showId string, id string, title string) (bool, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return false, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	return adapter.titleTaken(showId, id, title), nil
+}
+
+// titleTaken tells whether another episode of the show than the one of the id has the title, like the unique
+// index of the database. The caller holds the lock of the store.
+func (adapter *MemoryEpisodeOutAdapter) titleTaken(showId string, id string, title string) bool {
+	for _, episodeId := range adapter.store.showEpisodes[showId] {
+		if episodeId != id && adapter.store.episodes[episodeId].Title == title {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicatesAllowed tells whether episodes of the show may share a title. The caller holds the lock of the store.
+func (adapter *MemoryEpisodeOutAdapter) duplicatesAllowed(showId string) bool {
+	show, exists := adapter.store.shows[showId]
+	return exists && show.AllowDuplicateEpisodeTitles
+}
+
+func (adapter *MemoryEpisodeOutAdapter) GetEpisodeOrNil(ctx context.Context, id string) (*model.Episode, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	if stored, exists := adapter.store.episodes[id]; exists {
+		return copyEpisode(stored), nil
+	}
+	return nil, nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) GetEpisodesOfShow(ctx context.Context, showId string) ([]*model.Episode, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	episodes := []*model.Episode{}
+	for _, id := range adapter.store.showEpisodes[showId] {
+		episodes = append(episodes, copyEpisode(adapter.store.episodes[id]))
+	}
+	slices.SortFunc(episodes, func(a *model.Episode, b *model.Episode) int {
+		return cmp.Or(b.PublishedAt.Compare(a.PublishedAt), strings.Compare(a.Id, b.Id))
+	})
+	return episodes, nil
+}
+
+// ListEpisodes pages through the episodes of a show by keyset like the Postgres adapter: the page continues
+// after the sort values of the last episode of the previous page.
+func (adapter *MemoryEpisodeOutAdapter) ListEpisodes(ctx context.Context, query *model.EpisodeQuery) ([]*model.Episode, error) {
+	if query.Sort != model.EpisodeSortPublishedAt && query.Sort != model.EpisodeSortNumber {
+		return nil, fmt.Errorf("unknown sort '%s'", query.Sort)
+	}
+	compare := func(a *model.EpisodeKey, b *model.EpisodeKey) int {
+		var result int
+		if query.Sort == model.EpisodeSortPublishedAt {
+			result = a.PublishedAt.Compare(b.PublishedAt)
+		} else {
+			result = cmp.Or(cmp.Compare(a.Season, b.Season), cmp.Compare(a.EpisodeNumber, b.EpisodeNumber))
+		}
+		result = cmp.Or(result, strings.Compare(a.Id, b.Id))
+		if query.Order == model.Descending {
+			return -result
+		}
+		return result
+	}
+
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	var episodes []*model.Episode
+	for _, id := range adapter.store.showEpisodes[query.ShowId] {
+		stored := adapter.store.episodes[id]
+		if query.Status != "" && stored.Status != query.Status {
+			continue
+		}
+		if query.After != nil && compare(episodeKeyOf(stored), query.After) <= 0 {
+			continue
+		}
+		episodes = append(episodes, copyEpisode(stored))
+	}
+	slices.SortFunc(episodes, func(a *model.Episode, b *model.Episode) int {
+		return compare(episodeKeyOf(a), episodeKeyOf(b))
+	})
+	return episodes[:min(len(episodes), query.Limit)], nil
+}
+
+func episodeKeyOf(episode *model.Episode) *model.EpisodeKey {
+	return &model.EpisodeKey{
+		Id:            episode.Id,
+		PublishedAt:   episode.PublishedAt,
+		Season:        episode.Season,
+		EpisodeNumber: episode.EpisodeNumber,
+	}
+}
+
+// UpdateEpisode changes the descriptive values of an episode. Status, publish date and media keep their values.
+func (adapter *MemoryEpisodeOutAdapter) UpdateEpisode(ctx context.Context, episode *model.Episode) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.episodes[episode.Id]
+	if !exists {
+		return repository.NotStoredError("episode", episode.Id)
+	}
+	if !adapter.duplicatesAllowed(stored.ShowId) && adapter.titleTaken(stored.ShowId, episode.Id, episode.Title) {
+		return error2.NewEpisodeAlreadyExistsError(episode.Title)
+	}
+	updated := copyEpisode(episode)
+	updated.ShowId = stored.ShowId
+	updated.Status = stored.Status
+	updated.PublishedAt = stored.PublishedAt
+	updated.Media = stored.Media
+	adapter.store.episodes[episode.Id] = updated
+	return nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) ChangeEpisodeStatus(ctx context.Context, episode *model.Episode, from model.EpisodeStatus) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.episodes[episode.Id]
+	if !exists {
+		return repository.NotStoredError("episode", episode.Id)
+	}
+	if stored.Status != from {
+		return repository.StatusChangedError("episode", episode.Id)
+	}
+	stored.Status = episode.Status
+	stored.PublishedAt = storedTime(episode.PublishedAt)
+	return nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) PublishDueEpisodes(ctx context.Context, now time.Time) ([]string, error) {
+	if err := adapter.store.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	var episodeIds []string
+	for id, stored := range adapter.store.episodes {
+		if stored.Status == model.EpisodeScheduled && !stored.PublishedAt.After(now) {
+			stored.Status = model.EpisodePublished
+			episodeIds = append(episodeIds, id)
+		}
+	}
+	return episodeIds, nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) SaveEpisodeMedia(ctx context.Context, episodeId string, media *model.Media) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.episodes[episodeId]
+	if !exists {
+		return repository.NotStoredError("episode", episodeId)
+	}
+	stored.Media = copyMedia(media)
+	return nil
+}
+
+func (adapter *MemoryEpisodeOutAdapter) DeleteEpisode(ctx context.Context, id string) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.episodes[id]
+	if !exists {
+		return repository.NotStoredError("episode", id)
+	}
+	adapter.store.showEpisodes[stored.ShowId] = slices.DeleteFunc(adapter.store.showEpisodes[stored.ShowId], func(episodeId string) bool {
+		return episodeId == id
+	})
+	delete(adapter.store.episodes, id)
+	return nil
+}
+
+func NewMemoryEpisodeRepository(store *Store) *MemoryEpisodeOutAdapter {
+	return &MemoryEpisodeOutAdapter{store: store}
+}
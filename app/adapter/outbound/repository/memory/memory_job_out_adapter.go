@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"slices"
+	"strings"
+	"time"
+)
+
+type MemoryJobOutAdapter struct {
+	store *Store
+}
+
+func (adapter *MemoryJobOutAdapter) EnqueueJob(ctx context.Context, job *model.Job) (bool, error) {
+	if err := adapter.store.lock(ctx); err != nil {
+		return false, err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	if _, exists := adapter.store.jobs[job.Id]; exists {
+		return false, errConstraintViolation("job '%s' already exists", job.Id)
+	}
+	if adapter.uniqueKeyTaken(job.UniqueKey) {
+		return false, nil
+	}
+	adapter.store.jobs[job.Id] = copyJob(job)
+	return true, nil
+}
+
+// uniqueKeyTaken tells whether a job with the key waits or runs, the caller holds the lock.
+func (adapter *MemoryJobOutAdapter) uniqueKeyTaken(uniqueKey string) bool {
+	if uniqueKey == "" {
+		return false
+	}
+	for _, stored := range adapter.store.jobs {
+		if stored.UniqueKey == uniqueKey && stored.Status != model.JobDead {
+			return true
+		}
+	}
+	return false
+}
+
+func (adapter *MemoryJobOutAdapter) GetJobOrNil(ctx context.Context, id string) (*model.Job, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	if stored, exists := adapter.store.jobs[id]; exists {
+		return copyJob(stored), nil
+	}
+	return nil, nil
+}
+
+func (adapter *MemoryJobOutAdapter) ClaimJob(ctx context.Context, types []string, now time.Time, lease time.Duration) (*model.Job, error) {
+	if err := adapter.store.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	var claimed *model.Job
+	for _, stored := range adapter.store.jobs {
+		if stored.Status == model.JobDead || stored.RunAt.After(now) || !slices.Contains(types, stored.Type) {
+			continue
+		}
+		if claimed == nil || cmp.Or(stored.RunAt.Compare(claimed.RunAt), strings.Compare(stored.Id, claimed.Id)) < 0 {
+			claimed = stored
+		}
+	}
+	if claimed == nil {
+		return nil, nil
+	}
+	claimed.Status = model.JobRunning
+	claimed.Attempts++
+	claimed.RunAt = storedTime(now.Add(lease))
+	return copyJob(claimed), nil
+}
+
+func (adapter *MemoryJobOutAdapter) CompleteJob(ctx context.Context, job *model.Job) error {
+	return adapter.finish(ctx, job, func() {
+		delete(adapter.store.jobs, job.Id)
+	})
+}
+
+func (adapter *MemoryJobOutAdapter) RetryJob(ctx context.Context, job *model.Job) error {
+	return adapter.finish(ctx, job, func() {
+		stored := adapter.store.jobs[job.Id]
+		stored.Status = model.JobPending
+		stored.RunAt = storedTime(job.RunAt)
+		stored.LastError = job.LastError
+	})
+}
+
+func (adapter *MemoryJobOutAdapter) BuryJob(ctx context.Context, job *model.Job) error {
+	return adapter.finish(ctx, job, func() {
+		stored := adapter.store.jobs[job.Id]
+		stored.Status = model.JobDead
+		stored.LastError = job.LastError
+	})
+}
+
+// finish changes a job which still runs with the attempts of the caller.
+func (adapter *MemoryJobOutAdapter) finish(ctx context.Context, job *model.Job, change func()) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.jobs[job.Id]
+	if !exists || stored.Status != model.JobRunning || stored.Attempts != job.Attempts {
+		return repository.StatusChangedError("job", job.Id)
+	}
+	change()
+	return nil
+}
+
+func NewMemoryJobRepository(store *Store) *MemoryJobOutAdapter {
+	return &MemoryJobOutAdapter{store: store}
+}
@@ -0,0 +1,268 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"slices"
+	"strings"
+	"time"
+)
+
+type MemoryShowOutAdapter struct {
+	store *Store
+}
+
+func (adapter *MemoryShowOutAdapter) SaveShow(ctx context.Context, show *model.Show) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	if _, exists := adapter.store.shows[show.Id]; exists {
+		return errConstraintViolation("show '%s' is already stored", show.Id)
+	}
+	if adapter.titleOrSlugTaken(show.Id, show.Title, show.Slug) || adapter.aliasTaken(show.Id, show.Slug) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	stored := copyShow(show)
+	stored.CreatedAt = storedTime(time.Now())
+	adapter.store.shows[show.Id] = stored
+	return nil
+}
+
+func (adapter *MemoryShowOutAdapter) ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (bool, error) {
+	return adapter.ExistsOtherByTitleOrSlug(ctx, "", title, slug)
+}
+
+func (adapter *MemoryShowOutAdapter) ExistsOtherByTitleOrSlug(ctx context.Context, id string, title string, slug string) (bool, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return false, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	return adapter.titleOrSlugTaken(id, title, slug) || adapter.aliasTaken(id, slug), nil
+}
+
+func (adapter *MemoryShowOutAdapter) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return false, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	for _, show := range adapter.store.shows {
+		if show.Slug == slug {
+			return true, nil
+		}
+	}
+	return adapter.aliasTaken("", slug), nil
+}
+
+// aliasTaken tells whether another show than the one of the id had the slug. The caller holds the lock of the store.
+func (adapter *MemoryShowOutAdapter) aliasTaken(id string, slug string) bool {
+	showId, exists := adapter.store.slugAliases[slug]
+	return exists && showId != id
+}
+
+// titleOrSlugTaken tells whether another show than the one of the id has the title or slug, like the unique
+// indexes of the database. The caller holds the lock of the store.
+func (adapter *MemoryShowOutAdapter) titleOrSlugTaken(id string, title string, slug string) bool {
+	for _, show := range adapter.store.shows {
+		if show.Id != id && (show.Title == title || show.Slug == slug) {
+			return true
+		}
+	}
+	return false
+}
+
+// episodeTitlesShared tells whether episodes of the show have the same title, which the unique index of the
+// database refuses for shows without duplicate titles. The caller holds the lock of the store.
+func (adapter *MemoryShowOutAdapter) episodeTitlesShared(id string) bool {
+	titles := map[string]bool{}
+	for _, episodeId := range adapter.store.showEpisodes[id] {
+		title := adapter.store.episodes[episodeId].Title
+		if titles[title] {
+			return true
+		}
+		titles[title] = true
+	}
+	return false
+}
+
+func (adapter *MemoryShowOutAdapter) GetShowOrNil(ctx context.Context, id string) (*model.Show, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	return adapter.showOrNil(id), nil
+}
+
+// showOrNil is a copy of the show with its episodes. The caller holds the lock of the store.
+func (adapter *MemoryShowOutAdapter) showOrNil(id string) *model.Show {
+	stored, exists := adapter.store.shows[id]
+	if !exists {
+		return nil
+	}
+	show := copyShow(stored)
+	show.Episodes = storedList(adapter.store.showEpisodes[id])
+	return show
+}
+
+// GetShowBySlugOrNil finds a show by its slug, or else by a former slug of it.
+func (adapter *MemoryShowOutAdapter) GetShowBySlugOrNil(ctx context.Context, slug string) (*model.Show, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	for _, show := range adapter.store.shows {
+		if show.Slug == slug {
+			return adapter.showOrNil(show.Id), nil
+		}
+	}
+	return adapter.showOrNil(adapter.store.slugAliases[slug]), nil
+}
+
+// ListShows pages through shows by keyset like the Postgres adapter: the page continues after the sort
+// value and id of the last show of the previous page.
+func (adapter *MemoryShowOutAdapter) ListShows(ctx context.Context, query *model.ShowQuery) ([]*model.Show, error) {
+	if query.Sort != model.ShowSortTitle && query.Sort != model.ShowSortCreatedAt {
+		return nil, fmt.Errorf("unknown sort '%s'", query.Sort)
+	}
+	compare := func(a *model.ShowKey, b *model.ShowKey) int {
+		var result int
+		if query.Sort == model.ShowSortTitle {
+			result = strings.Compare(a.Title, b.Title)
+		} else {
+			result = a.CreatedAt.Compare(b.CreatedAt)
+		}
+		if result == 0 {
+			result = strings.Compare(a.Id, b.Id)
+		}
+		if query.Order == model.Descending {
+			return -result
+		}
+		return result
+	}
+
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	title := strings.ToLower(query.Title)
+	var shows []*model.Show
+	for _, stored := range adapter.store.shows {
+		if !strings.Contains(strings.ToLower(stored.Title), title) {
+			continue
+		}
+		if query.After != nil && compare(showKeyOf(stored), query.After) <= 0 {
+			continue
+		}
+		shows = append(shows, &model.Show{
+			Id:        stored.Id,
+			Title:     stored.Title,
+			Slug:      stored.Slug,
+			Guid:      stored.Guid,
+			Locked:    stored.Locked,
+			CreatedAt: stored.CreatedAt,
+		})
+	}
+	slices.SortFunc(shows, func(a *model.Show, b *model.Show) int {
+		return compare(showKeyOf(a), showKeyOf(b))
+	})
+	return shows[:min(len(shows), query.Limit)], nil
+}
+
+func showKeyOf(show *model.Show) *model.ShowKey {
+	return &model.ShowKey{Id: show.Id, Title: show.Title, CreatedAt: show.CreatedAt}
+}
+
+func (adapter *MemoryShowOutAdapter) UpdateShow(ctx context.Context, show *model.Show) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	stored, exists := adapter.store.shows[show.Id]
+	if !exists {
+		return repository.NotStoredError("show", show.Id)
+	}
+	if adapter.titleOrSlugTaken(show.Id, show.Title, show.Slug) || adapter.aliasTaken(show.Id, show.Slug) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	if !show.AllowDuplicateEpisodeTitles && adapter.episodeTitlesShared(show.Id) {
+		return errConstraintViolation("episodes of show '%s' share a title", show.Id)
+	}
+	updated := copyShow(show)
+	updated.CreatedAt = stored.CreatedAt
+	adapter.store.shows[show.Id] = updated
+	if stored.Slug != show.Slug {
+		adapter.store.slugAliases[stored.Slug] = show.Id
+	}
+	if adapter.store.slugAliases[show.Slug] == show.Id {
+		delete(adapter.store.slugAliases, show.Slug)
+	}
+	return nil
+}
+
+// DeleteShow deletes a show together with its episodes. Downloads are kept for analytics.
+func (adapter *MemoryShowOutAdapter) DeleteShow(ctx context.Context, id string) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	if _, exists := adapter.store.shows[id]; !exists {
+		return repository.NotStoredError("show", id)
+	}
+	for episodeId, episode := range adapter.store.episodes {
+		if episode.ShowId == id {
+			delete(adapter.store.episodes, episodeId)
+		}
+	}
+	for slug, showId := range adapter.store.slugAliases {
+		if showId == id {
+			delete(adapter.store.slugAliases, slug)
+		}
+	}
+	delete(adapter.store.showEpisodes, id)
+	delete(adapter.store.shows, id)
+	return nil
+}
+
+func (adapter *MemoryShowOutAdapter) GetShowIdsWithoutGuid(ctx context.Context) ([]string, error) {
+	if err := adapter.store.rLock(ctx); err != nil {
+		return nil, err
+	}
+	defer adapter.store.mutex.RUnlock()
+
+	var showIds []string
+	for id, show := range adapter.store.shows {
+		if show.Guid == "" {
+			showIds = append(showIds, id)
+		}
+	}
+	slices.Sort(showIds)
+	return showIds, nil
+}
+
+// SetShowGuidIfMissing leaves shows which were deleted or got a guid in the meantime unchanged.
+func (adapter *MemoryShowOutAdapter) SetShowGuidIfMissing(ctx context.Context, id string, guid string) error {
+	if err := adapter.store.lock(ctx); err != nil {
+		return err
+	}
+	defer adapter.store.mutex.Unlock()
+
+	if show, exists := adapter.store.shows[id]; exists && show.Guid == "" {
+		show.Guid = guid
+	}
+	return nil
+}
+
+func NewMemoryShowRepository(store *Store) *MemoryShowOutAdapter {
+	return &MemoryShowOutAdapter{store: store}
+}
@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Store holds the data of the in-memory repositories. Repositories sharing a store see the same shows,
+// episodes, downloads and jobs, like the Postgres repositories sharing a database.
+//
+// Entities are copied on the way in and out, and normalized the way the database columns would store them,
+// so callers can neither change stored entities nor tell both implementations apart.
+type Store struct {
+	mutex        sync.RWMutex
+	shows        map[string]*model.Show
+	episodes     map[string]*model.Episode
+	showEpisodes map[string][]string
+	slugAliases  map[string]string
+	downloads    []*model.DownloadEvent
+	jobs         map[string]*model.Job
+}
+
+func NewStore() *Store {
+	return &Store{
+		shows:        map[string]*model.Show{},
+		episodes:     map[string]*model.Episode{},
+		showEpisodes: map[string][]string{},
+		slugAliases:  map[string]string{},
+		jobs:         map[string]*model.Job{},
+	}
+}
+
+// lock acquires the store for writing, unless the context is already done, like a database refuses the queries
+// of a cancelled request.
+func (store *Store) lock(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	store.mutex.Lock()
+	return nil
+}
+
+// rLock acquires the store for reading, unless the context is already done.
+func (store *Store) rLock(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	store.mutex.RLock()
+	return nil
+}
+
+// errConstraintViolation reports data which a database would refuse by a constraint.
+func errConstraintViolation(format string, args ...any) error {
+	return error2.NewRepositoryError(error2.ConstraintViolation, fmt.Errorf(format, args...))
+}
+
+// storedTime keeps the precision of a timestamptz column, which rounds to microseconds.
+func storedTime(value time.Time) time.Time {
+	if value.IsZero() {
+		return time.Time{}
+	}
+	return value.UTC().Round(time.Microsecond)
+}
+
+// storedList is a copy of a list as a jsonb column returns it, so an empty list results in nil.
+func storedList[T any](list []T) []T {
+	if len(list) == 0 {
+		return nil
+	}
+	return slices.Clone(list)
+}
+
+func copyShow(show *model.Show) *model.Show {
+	return &model.Show{
+		Id:                          show.Id,
+		Title:                       show.Title,
+		Slug:                        show.Slug,
+		Guid:                        show.Guid,
+		Locked:                      show.Locked,
+		AllowDuplicateEpisodeTitles: show.AllowDuplicateEpisodeTitles,
+		Funding:                     storedList(show.Funding),
+		Persons:                     storedList(show.Persons),
+		Description:                 show.Description,
+		Language:                    show.Language,
+		Author:                      show.Author,
+		ImageUrl:                    show.ImageUrl,
+		Category:                    show.Category,
+		Subcategory:                 show.Subcategory,
+		Explicit:                    show.Explicit,
+		CreatedAt:                   show.CreatedAt,
+	}
+}
+
+func copyEpisode(episode *model.Episode) *model.Episode {
+	return &model.Episode{
+		Id:            episode.Id,
+		ShowId:        episode.ShowId,
+		Title:         episode.Title,
+		Description:   episode.Description,
+		Season:        episode.Season,
+		EpisodeNumber: episode.EpisodeNumber,
+		Status:        episode.Status,
+		PublishedAt:   storedTime(episode.PublishedAt),
+		Transcripts:   storedList(episode.Transcripts),
+		Chapters:      copyChapters(episode.Chapters),
+		Persons:       storedList(episode.Persons),
+		Media:         copyMedia(episode.Media),
+	}
+}
+
+// copyChapters drops chapters without url, since the url column decides whether an episode has chapters.
+func copyChapters(chapters *model.Chapters) *model.Chapters {
+	if chapters == nil || chapters.Url == "" {
+		return nil
+	}
+	return &model.Chapters{Url: chapters.Url, Type: chapters.Type}
+}
+
+func copyMedia(media *model.Media) *model.Media {
+	if media == nil {
+		return nil
+	}
+	stored := *media
+	stored.Duration = media.Duration.Truncate(time.Millisecond)
+	stored.Chapters = storedList(media.Chapters)
+	stored.Artwork = nil
+	if media.Artwork != nil && media.Artwork.Key != "" {
+		stored.Artwork = &model.Artwork{Key: media.Artwork.Key, MimeType: media.Artwork.MimeType}
+	}
+	return &stored
+}
+
+func copyJob(job *model.Job) *model.Job {
+	stored := *job
+	stored.Payload = slices.Clone(job.Payload)
+	stored.RunAt = storedTime(job.RunAt)
+	return &stored
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type downloadRepository struct {
+	next    repository.DownloadRepository
+	metrics *RepositoryMetrics
+}
+
+// RecordDownload counts the request as served even if recording it fails, since the media is delivered anyway.
+// The requests are counted by method, so HEAD requests and probes are told apart from downloads.
+func (r *downloadRepository) RecordDownload(ctx context.Context, event *model.DownloadEvent) error {
+	defer r.metrics.observe("RecordDownloadPort", "RecordDownload", time.Now())
+	r.metrics.mediaRequests.WithLabelValues(event.Method).Inc()
+	return r.next.RecordDownload(ctx, event)
+}
+
+func (r *downloadRepository) GetDownloadsOfShow(ctx context.Context, showId string, from time.Time, to time.Time) ([]*model.DownloadEvent, error) {
+	defer r.metrics.observe("GetDownloadsPort", "GetDownloadsOfShow", time.Now())
+	return r.next.GetDownloadsOfShow(ctx, showId, from, to)
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type episodeRepository struct {
+	next    repository.EpisodeRepository
+	metrics *RepositoryMetrics
+}
+
+func (r *episodeRepository) SaveEpisode(ctx context.Context, episode *model.Episode) error {
+	defer r.metrics.observe("SaveEpisodePort", "SaveEpisode", time.Now())
+	if err := r.next.SaveEpisode(ctx, episode); err != nil {
+		return err
+	}
+	r.metrics.episodesCreated.Inc()
+	return nil
+}
+
+func (r *episodeRepository) ExistsByTitle(ctx context.Context, showId string, title string) (bool, error) {
+	defer r.metrics.observe("SaveEpisodePort", "ExistsByTitle", time.Now())
+	return r.next.ExistsByTitle(ctx, showId, title)
+}
+
+func (r *episodeRepository) GetEpisodeOrNil(ctx context.Context, id string) (*model.Episode, error) {
+	defer r.metrics.observe("GetEpisodePort", "GetEpisodeOrNil", time.Now())
+	return r.next.GetEpisodeOrNil(ctx, id)
+}
+
+func (r *episodeRepository) GetEpisodesOfShow(ctx context.Context, showId string) ([]*model.Episode, error) {
+	defer r.metrics.observe("GetShowEpisodesPort", "GetEpisodesOfShow", time.Now())
+	return r.next.GetEpisodesOfShow(ctx, showId)
+}
+
+func (r *episodeRepository) ListEpisodes(ctx context.Context, query *model.EpisodeQuery) ([]*model.Episode, error) {
+	defer r.metrics.observe("ListEpisodesPort", "ListEpisodes", time.Now())
+	return r.next.ListEpisodes(ctx, query)
+}
+
+func (r *episodeRepository) SaveEpisodeMedia(ctx context.Context, episodeId string, media *model.Media) error {
+	defer r.metrics.observe("SaveEpisodeMediaPort", "SaveEpisodeMedia", time.Now())
+	return r.next.SaveEpisodeMedia(ctx, episodeId, media)
+}
+
+func (r *episodeRepository) UpdateEpisode(ctx context.Context, episode *model.Episode) error {
+	defer r.metrics.observe("UpdateEpisodePort", "UpdateEpisode", time.Now())
+	return r.next.UpdateEpisode(ctx, episode)
+}
+
+func (r *episodeRepository) ExistsOtherByTitle(ctx context.Context, showId string, id string, title string) (bool, error) {
+	defer r.metrics.observe("UpdateEpisodePort", "ExistsOtherByTitle", time.Now())
+	return r.next.ExistsOtherByTitle(ctx, showId, id, title)
+}
+
+func (r *episodeRepository) DeleteEpisode(ctx context.Context, id string) error {
+	defer r.metrics.observe("DeleteEpisodePort", "DeleteEpisode", time.Now())
+	return r.next.DeleteEpisode(ctx, id)
+}
+
+func (r *episodeRepository) ChangeEpisodeStatus(ctx context.Context, episode *model.Episode, from model.EpisodeStatus) error {
+	defer r.metrics.observe("ChangeEpisodeStatusPort", "ChangeEpisodeStatus", time.Now())
+	return r.next.ChangeEpisodeStatus(ctx, episode, from)
+}
+
+func (r *episodeRepository) PublishDueEpisodes(ctx context.Context, now time.Time) ([]string, error) {
+	defer r.metrics.observe("ChangeEpisodeStatusPort", "PublishDueEpisodes", time.Now())
+	return r.next.PublishDueEpisodes(ctx, now)
+}
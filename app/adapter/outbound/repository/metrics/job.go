@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type jobRepository struct {
+	next    repository.JobRepository
+	metrics *RepositoryMetrics
+}
+
+func (r *jobRepository) EnqueueJob(ctx context.Context, job *model.Job) (bool, error) {
+	defer r.metrics.observe("EnqueueJobPort", "EnqueueJob", time.Now())
+	return r.next.EnqueueJob(ctx, job)
+}
+
+func (r *jobRepository) GetJobOrNil(ctx context.Context, id string) (*model.Job, error) {
+	defer r.metrics.observe("GetJobPort", "GetJobOrNil", time.Now())
+	return r.next.GetJobOrNil(ctx, id)
+}
+
+func (r *jobRepository) ClaimJob(ctx context.Context, types []string, now time.Time, lease time.Duration) (*model.Job, error) {
+	defer r.metrics.observe("ClaimJobPort", "ClaimJob", time.Now())
+	return r.next.ClaimJob(ctx, types, now, lease)
+}
+
+func (r *jobRepository) CompleteJob(ctx context.Context, job *model.Job) error {
+	defer r.metrics.observe("FinishJobPort", "CompleteJob", time.Now())
+	return r.next.CompleteJob(ctx, job)
+}
+
+func (r *jobRepository) RetryJob(ctx context.Context, job *model.Job) error {
+	defer r.metrics.observe("FinishJobPort", "RetryJob", time.Now())
+	return r.next.RetryJob(ctx, job)
+}
+
+func (r *jobRepository) BuryJob(ctx context.Context, job *model.Job) error {
+	defer r.metrics.observe("FinishJobPort", "BuryJob", time.Now())
+	return r.next.BuryJob(ctx, job)
+}
@@ -0,0 +1,57 @@
+// Package metrics decorates the repositories with Prometheus metrics, so the core stays unaware of them.
+package metrics
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RepositoryMetrics measures the latency of each method of the outbound ports, and counts the entities the
+// repositories stored.
+type RepositoryMetrics struct {
+	calls           *prometheus.HistogramVec
+	showsCreated    prometheus.Counter
+	episodesCreated prometheus.Counter
+	mediaRequests   *prometheus.CounterVec
+}
+
+func NewRepositoryMetrics(registerer prometheus.Registerer) *RepositoryMetrics {
+	metrics := &RepositoryMetrics{
+		calls: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "podgopher_repository_call_duration_seconds",
+			Help:    "Latency of calls of the outbound ports of the repositories, including failed calls.",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"port", "method"}),
+		showsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "podgopher_shows_created_total",
+			Help: "Shows stored since the start.",
+		}),
+		episodesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "podgopher_episodes_created_total",
+			Help: "Episodes stored since the start.",
+		}),
+		mediaRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "podgopher_media_requests_total",
+			Help: "Requests of episode media served since the start by method, one per requested range, before downloads are counted by IAB rules.",
+		}, []string{"method"}),
+	}
+	registerer.MustRegister(metrics.calls, metrics.showsCreated, metrics.episodesCreated, metrics.mediaRequests)
+	return metrics
+}
+
+// Instrument decorates all repositories, which still share their storage.
+func (metrics *RepositoryMetrics) Instrument(repositories *repository.Repositories) *repository.Repositories {
+	return &repository.Repositories{
+		Shows:     &showRepository{repositories.Shows, metrics},
+		Episodes:  &episodeRepository{repositories.Episodes, metrics},
+		Downloads: &downloadRepository{repositories.Downloads, metrics},
+		Jobs:      &jobRepository{repositories.Jobs, metrics},
+	}
+}
+
+// observe is deferred with the start of a call, so failed calls are measured as well.
+func (metrics *RepositoryMetrics) observe(port string, method string, start time.Time) {
+	metrics.calls.WithLabelValues(port, method).Observe(time.Since(start).Seconds())
+}
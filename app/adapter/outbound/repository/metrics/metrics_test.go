@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/contract"
+	"podGopher/adapter/outbound/repository/memory"
+	"podGopher/core/domain/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newInstrumentedRepositories() (*repository.Repositories, *RepositoryMetrics) {
+	store := memory.NewStore()
+	metrics := NewRepositoryMetrics(prometheus.NewRegistry())
+	return metrics.Instrument(&repository.Repositories{
+		Shows:     memory.NewMemoryShowRepository(store),
+		Episodes:  memory.NewMemoryEpisodeRepository(store),
+		Downloads: memory.NewMemoryDownloadRepository(store),
+		Jobs:      memory.NewMemoryJobRepository(store),
+	}), metrics
+}
+
+func Test_instrumented_repositories_should_fulfill_contract(t *testing.T) {
+	repositories, _ := newInstrumentedRepositories()
+
+	contract.Run(t, repositories)
+}
+
+func Test_should_measure_calls_per_port_method(t *testing.T) {
+	repositories, metrics := newInstrumentedRepositories()
+
+	_, _ = repositories.Shows.GetShowOrNil(t.Context(), "some-show-id")
+	_, _ = repositories.Shows.GetShowOrNil(t.Context(), "other-show-id")
+	_, _ = repositories.Episodes.ListEpisodes(t.Context(), &model.EpisodeQuery{})
+
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.calls))
+	assert.Equal(t, uint64(2), sampleCount(t, metrics.calls.WithLabelValues("GetShowPort", "GetShowOrNil")))
+	assert.Equal(t, uint64(1), sampleCount(t, metrics.calls.WithLabelValues("ListEpisodesPort", "ListEpisodes")))
+}
+
+func Test_should_count_created_shows_and_episodes(t *testing.T) {
+	repositories, metrics := newInstrumentedRepositories()
+	show := &model.Show{Id: uuid.NewString(), Title: "some title", Slug: "some-slug"}
+	episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "some title", Status: model.EpisodeDraft}
+
+	assert.Nil(t, repositories.Shows.SaveShow(t.Context(), show))
+	assert.NotNil(t, repositories.Shows.SaveShow(t.Context(), show))
+	assert.Nil(t, repositories.Episodes.SaveEpisode(t.Context(), episode))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.showsCreated))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.episodesCreated))
+}
+
+func Test_should_count_served_media_requests_which_failed_to_record(t *testing.T) {
+	metrics := NewRepositoryMetrics(prometheus.NewRegistry())
+	downloads := &downloadRepository{failingDownloadRepository{}, metrics}
+
+	assert.NotNil(t, downloads.RecordDownload(t.Context(), &model.DownloadEvent{Method: http.MethodGet}))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.mediaRequests.WithLabelValues(http.MethodGet)))
+}
+
+func Test_should_count_media_requests_by_method(t *testing.T) {
+	repositories, metrics := newInstrumentedRepositories()
+
+	_ = repositories.Downloads.RecordDownload(t.Context(), &model.DownloadEvent{Method: http.MethodGet})
+	_ = repositories.Downloads.RecordDownload(t.Context(), &model.DownloadEvent{Method: http.MethodHead})
+	_ = repositories.Downloads.RecordDownload(t.Context(), &model.DownloadEvent{Method: http.MethodHead})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.mediaRequests.WithLabelValues(http.MethodGet)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.mediaRequests.WithLabelValues(http.MethodHead)))
+}
+
+type failingDownloadRepository struct {
+	repository.DownloadRepository
+}
+
+func (failingDownloadRepository) RecordDownload(context.Context, *model.DownloadEvent) error {
+	return errors.New("some error")
+}
+
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	var metric dto.Metric
+	assert.Nil(t, observer.(prometheus.Metric).Write(&metric))
+	return metric.GetHistogram().GetSampleCount()
+}
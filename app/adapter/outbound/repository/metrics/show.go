@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type showRepository struct {
+	next    repository.ShowRepository
+	metrics *RepositoryMetrics
+}
+
+func (r *showRepository) SaveShow(ctx context.Context, show *model.Show) error {
+	defer r.metrics.observe("SaveShowPort", "SaveShow", time.Now())
+	if err := r.next.SaveShow(ctx, show); err != nil {
+		return err
+	}
+	r.metrics.showsCreated.Inc()
+	return nil
+}
+
+func (r *showRepository) ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (bool, error) {
+	defer r.metrics.observe("SaveShowPort", "ExistsByTitleOrSlug", time.Now())
+	return r.next.ExistsByTitleOrSlug(ctx, title, slug)
+}
+
+func (r *showRepository) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	defer r.metrics.observe("SaveShowPort", "ExistsBySlug", time.Now())
+	return r.next.ExistsBySlug(ctx, slug)
+}
+
+func (r *showRepository) GetShowOrNil(ctx context.Context, id string) (*model.Show, error) {
+	defer r.metrics.observe("GetShowPort", "GetShowOrNil", time.Now())
+	return r.next.GetShowOrNil(ctx, id)
+}
+
+func (r *showRepository) GetShowBySlugOrNil(ctx context.Context, slug string) (*model.Show, error) {
+	defer r.metrics.observe("GetShowBySlugPort", "GetShowBySlugOrNil", time.Now())
+	return r.next.GetShowBySlugOrNil(ctx, slug)
+}
+
+func (r *showRepository) ListShows(ctx context.Context, query *model.ShowQuery) ([]*model.Show, error) {
+	defer r.metrics.observe("ListShowsPort", "ListShows", time.Now())
+	return r.next.ListShows(ctx, query)
+}
+
+func (r *showRepository) UpdateShow(ctx context.Context, show *model.Show) error {
+	defer r.metrics.observe("UpdateShowPort", "UpdateShow", time.Now())
+	return r.next.UpdateShow(ctx, show)
+}
+
+func (r *showRepository) ExistsOtherByTitleOrSlug(ctx context.Context, id string, title string, slug string) (bool, error) {
+	defer r.metrics.observe("UpdateShowPort", "ExistsOtherByTitleOrSlug", time.Now())
+	return r.next.ExistsOtherByTitleOrSlug(ctx, id, title, slug)
+}
+
+func (r *showRepository) DeleteShow(ctx context.Context, id string) error {
+	defer r.metrics.observe("DeleteShowPort", "DeleteShow", time.Now())
+	return r.next.DeleteShow(ctx, id)
+}
+
+func (r *showRepository) GetShowIdsWithoutGuid(ctx context.Context) ([]string, error) {
+	defer r.metrics.observe("BackfillShowGuidsPort", "GetShowIdsWithoutGuid", time.Now())
+	return r.next.GetShowIdsWithoutGuid(ctx)
+}
+
+func (r *showRepository) SetShowGuidIfMissing(ctx context.Context, id string, guid string) error {
+	defer r.metrics.observe("BackfillShowGuidsPort", "SetShowGuidIfMissing", time.Now())
+	return r.next.SetShowGuidIfMissing(ctx, id, guid)
+}
@@ -0,0 +1,24 @@
+package postgres_test
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/contract"
+	"podGopher/adapter/outbound/repository/postgres/download"
+	"podGopher/adapter/outbound/repository/postgres/episode"
+	"podGopher/adapter/outbound/repository/postgres/job"
+	"podGopher/adapter/outbound/repository/postgres/postgresTestSetup"
+	"podGopher/adapter/outbound/repository/postgres/show"
+	"testing"
+)
+
+func Test_postgres_repositories_should_fulfill_contract(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "postgresTestSetup/")
+	defer postgresTestSetup.Teardown(t, db)
+
+	contract.Run(t, &repository.Repositories{
+		Shows:     show.NewPostgresShowRepository(db),
+		Episodes:  episode.NewPostgresEpisodeRepository(db),
+		Downloads: download.NewPostgresDownloadRepository(db),
+		Jobs:      job.NewPostgresJobRepository(db),
+	})
+}
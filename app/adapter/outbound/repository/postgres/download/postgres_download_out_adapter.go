@@ -0,0 +1,59 @@
+package download
+
+import (
+	"context"
+	"database/sql"
+	"podGopher/adapter/outbound/repository/postgres"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+const downloadColumns = "show_id, episode_id, media_key, method, ip_hash, user_agent, referrer, range_start, range_end, requested_at"
+
+type PostgresDownloadOutAdapter struct {
+	db *sql.DB
+}
+
+func (adapter *PostgresDownloadOutAdapter) RecordDownload(ctx context.Context, event *model.DownloadEvent) (err error) {
+	defer postgres.TranslateError(&err)
+	var stmt *sql.Stmt
+
+	if stmt, err = adapter.db.PrepareContext(ctx, "INSERT INTO download ("+downloadColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);"); err != nil {
+		return err
+	}
+	defer func(stmt *sql.Stmt) {
+		_ = stmt.Close()
+	}(stmt)
+
+	_, err = stmt.ExecContext(ctx, event.ShowId, event.EpisodeId, event.MediaKey, event.Method, event.IpHash, event.UserAgent,
+		event.Referrer, event.RangeStart, event.RangeEnd, event.RequestedAt)
+	return err
+}
+
+// GetDownloadsOfShow returns the downloads requested in [from, to).
+func (adapter *PostgresDownloadOutAdapter) GetDownloadsOfShow(ctx context.Context, showId string, from time.Time, to time.Time) (downloads []*model.DownloadEvent, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT " + downloadColumns + " FROM download WHERE show_id = $1 AND requested_at >= $2 AND requested_at < $3 ORDER BY requested_at"
+	rows, err := adapter.db.QueryContext(ctx, query, postgres.Id(showId), from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		event := &model.DownloadEvent{}
+		if err = rows.Scan(&event.ShowId, &event.EpisodeId, &event.MediaKey, &event.Method, &event.IpHash, &event.UserAgent,
+			&event.Referrer, &event.RangeStart, &event.RangeEnd, &event.RequestedAt); err != nil {
+			return nil, err
+		}
+		event.RequestedAt = event.RequestedAt.UTC()
+		downloads = append(downloads, event)
+	}
+	return downloads, rows.Err()
+}
+
+func NewPostgresDownloadRepository(db *sql.DB) *PostgresDownloadOutAdapter {
+	return &PostgresDownloadOutAdapter{db: db}
+}
@@ -0,0 +1,63 @@
+package download
+
+import (
+	"podGopher/adapter/outbound/repository/postgres/postgresTestSetup"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/outbound"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_download_repository_should_implement_port(t *testing.T) {
+	repository := NewPostgresDownloadRepository(nil)
+
+	assert.NotNil(t, repository)
+	assert.Implements(t, (*outbound.RecordDownloadPort)(nil), repository)
+	assert.Implements(t, (*outbound.GetDownloadsPort)(nil), repository)
+}
+
+func Test_should_record_and_get_downloads_of_a_show(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+	defer postgresTestSetup.Teardown(t, db)
+
+	repository := NewPostgresDownloadRepository(db)
+	showId, episodeId := uuid.NewString(), uuid.NewString()
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	newEvent := func(showId string, requestedAt time.Time) *model.DownloadEvent {
+		return &model.DownloadEvent{
+			EpisodeId:   episodeId,
+			ShowId:      showId,
+			MediaKey:    episodeId + "/episode.mp3",
+			Method:      "GET",
+			IpHash:      "some-ip-hash",
+			UserAgent:   "AppleCoreMedia/1.0.0",
+			Referrer:    "https://example.com",
+			RangeStart:  0,
+			RangeEnd:    1024,
+			RequestedAt: requestedAt,
+		}
+	}
+	within := newEvent(showId, day.Add(12*time.Hour))
+	events := []*model.DownloadEvent{
+		newEvent(showId, day.Add(-time.Second)),
+		within,
+		newEvent(showId, day.Add(24*time.Hour)),
+		newEvent(uuid.NewString(), day.Add(12*time.Hour)),
+	}
+
+	t.Run("should record downloads", func(t *testing.T) {
+		for _, event := range events {
+			assert.Nil(t, repository.RecordDownload(t.Context(), event))
+		}
+	})
+
+	t.Run("should get downloads of show within period", func(t *testing.T) {
+		downloads, err := repository.GetDownloadsOfShow(t.Context(), showId, day, day.Add(24*time.Hour))
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.DownloadEvent{within}, downloads)
+	})
+}
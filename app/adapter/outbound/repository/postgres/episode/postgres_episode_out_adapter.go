@@ -1,87 +1,429 @@
 package episode
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/postgres"
+	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"strings"
+	"time"
 )
 
+const episodeColumns = "id, show_id, title, season, episode_number, transcripts, chapters_url, chapters_type, persons, status, published_at, description"
+
+const episodeMediaColumns = "media_key, media_file_name, media_type, media_size, media_duration_ms, media_bitrate, " +
+	"media_sample_rate, media_channels, media_title, media_artwork_key, media_artwork_type, media_chapters"
+
+// sortKeys are the sort values of episodes, completed by their id. Missing values sort as the zero value,
+// so the values of a key always compare.
+var sortKeys = map[model.EpisodeSort][]string{
+	model.EpisodeSortPublishedAt: {"COALESCE(published_at, '0001-01-01 00:00:00+00')", "id"},
+	model.EpisodeSortNumber:      {"COALESCE(season, 0)", "COALESCE(episode_number, 0)", "id"},
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
 type PostgresEpisodeOutAdapter struct {
 	db *sql.DB
 }
 
-func (adapter *PostgresEpisodeOutAdapter) SaveEpisode(episode *model.Episode) (err error) {
-	transaction, _ := adapter.db.Begin()
+func (adapter *PostgresEpisodeOutAdapter) SaveEpisode(ctx context.Context, episode *model.Episode) (err error) {
+	defer postgres.TranslateError(&err)
+	transaction, err := adapter.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
 	defer func(transaction *sql.Tx) {
 		_ = transaction.Rollback()
 	}(transaction)
 
-	if err = adapter.createEpisodeEntry(episode, transaction); err != nil {
+	if err = adapter.createEpisodeEntry(ctx, episode, transaction); err != nil {
 		return err
 	}
-	if err = adapter.createShowEpisodeMappingEntry(episode, transaction); err != nil {
+	if err = adapter.createShowEpisodeMappingEntry(ctx, episode, transaction); err != nil {
 		return err
 	}
-	_ = transaction.Commit()
-	return nil
+	return transaction.Commit()
 }
 
-func (adapter *PostgresEpisodeOutAdapter) createShowEpisodeMappingEntry(episode *model.Episode, transaction *sql.Tx) (err error) {
+func (adapter *PostgresEpisodeOutAdapter) createShowEpisodeMappingEntry(ctx context.Context, episode *model.Episode, transaction *sql.Tx) (err error) {
 	var stmt *sql.Stmt
 
-	if stmt, err = transaction.Prepare("INSERT INTO show_episodes (show_id, episode_id) VALUES ($1, $2);"); err != nil {
+	if stmt, err = transaction.PrepareContext(ctx, "INSERT INTO show_episodes (show_id, episode_id) VALUES ($1, $2);"); err != nil {
 		return err
 	}
 	defer func(stmt *sql.Stmt) {
 		_ = stmt.Close()
 	}(stmt)
 
-	if _, err = stmt.Exec(episode.ShowId, episode.Id); err != nil {
+	if _, err = stmt.ExecContext(ctx, episode.ShowId, episode.Id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (adapter *PostgresEpisodeOutAdapter) createEpisodeEntry(episode *model.Episode, transaction *sql.Tx) (err error) {
+func (adapter *PostgresEpisodeOutAdapter) createEpisodeEntry(ctx context.Context, episode *model.Episode, transaction *sql.Tx) (err error) {
 	var stmt *sql.Stmt
+	var transcripts, persons string
+	var chaptersUrl, chaptersType sql.NullString
 
-	if stmt, err = transaction.Prepare("INSERT INTO episode (id, show_id, title) VALUES ($1, $2, $3);"); err != nil {
+	if transcripts, err = column.MarshalList(episode.Transcripts); err != nil {
+		return err
+	}
+	if persons, err = column.MarshalList(episode.Persons); err != nil {
+		return err
+	}
+	if episode.Chapters != nil {
+		chaptersUrl = column.NullString(episode.Chapters.Url)
+		chaptersType = column.NullString(episode.Chapters.Type)
+	}
+
+	// the episode takes the setting of its show, a missing show leaves it null and fails the insert
+	if stmt, err = transaction.PrepareContext(ctx, "INSERT INTO episode ("+episodeColumns+", unique_title) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, "+
+		"(SELECT NOT allow_duplicate_episode_titles FROM show WHERE id = $2));"); err != nil {
 		return err
 	}
 	defer func(stmt *sql.Stmt) {
 		_ = stmt.Close()
 	}(stmt)
 
-	if _, err = stmt.Exec(episode.Id, episode.ShowId, episode.Title); err != nil {
+	_, err = stmt.ExecContext(ctx, episode.Id, episode.ShowId, episode.Title,
+		column.NullInt(episode.Season), column.NullInt(episode.EpisodeNumber),
+		transcripts, chaptersUrl, chaptersType, persons,
+		episode.Status, column.NullTime(episode.PublishedAt), episode.Description)
+	if postgres.IsUniqueViolation(err, "episode_show_id_title_unique") {
+		return error2.NewEpisodeAlreadyExistsError(episode.Title)
+	}
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (adapter *PostgresEpisodeOutAdapter) ExistsByTitle(title string) bool {
-	query := "SELECT EXISTS(SELECT 1 FROM episode where title = $1)"
-	row := adapter.db.QueryRow(query, title)
+func (adapter *PostgresEpisodeOutAdapter) ExistsByTitle(ctx context.Context, showId string, title string) (exists bool, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT EXISTS(SELECT 1 FROM episode where show_id = $1 and title = $2)"
+	err = adapter.db.QueryRowContext(ctx, query, postgres.Id(showId), title).Scan(&exists)
+	return exists, err
+}
+
+func (adapter *PostgresEpisodeOutAdapter) UpdateEpisode(ctx context.Context, episode *model.Episode) (err error) {
+	defer postgres.TranslateError(&err)
+	var stmt *sql.Stmt
+	var result sql.Result
+	var transcripts, persons string
+	var chaptersUrl, chaptersType sql.NullString
+
+	if transcripts, err = column.MarshalList(episode.Transcripts); err != nil {
+		return err
+	}
+	if persons, err = column.MarshalList(episode.Persons); err != nil {
+		return err
+	}
+	if episode.Chapters != nil {
+		chaptersUrl = column.NullString(episode.Chapters.Url)
+		chaptersType = column.NullString(episode.Chapters.Type)
+	}
+
+	if stmt, err = adapter.db.PrepareContext(ctx, "UPDATE episode SET title = $2, season = $3, episode_number = $4, transcripts = $5, "+
+		"chapters_url = $6, chapters_type = $7, persons = $8, description = $9 WHERE id = $1;"); err != nil {
+		return err
+	}
+	defer func(stmt *sql.Stmt) {
+		_ = stmt.Close()
+	}(stmt)
+
+	result, err = stmt.ExecContext(ctx, postgres.Id(episode.Id), episode.Title,
+		column.NullInt(episode.Season), column.NullInt(episode.EpisodeNumber),
+		transcripts, chaptersUrl, chaptersType, persons, episode.Description)
+	if postgres.IsUniqueViolation(err, "episode_show_id_title_unique") {
+		return error2.NewEpisodeAlreadyExistsError(episode.Title)
+	}
+	if err != nil {
+		return err
+	}
+	return repository.RequireAffectedRows(result, "episode", episode.Id)
+}
+
+// ChangeEpisodeStatus changes status and publish date of an episode, UpdateEpisode changes the other values.
+func (adapter *PostgresEpisodeOutAdapter) ChangeEpisodeStatus(ctx context.Context, episode *model.Episode, from model.EpisodeStatus) (err error) {
+	defer postgres.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "UPDATE episode SET status = $2, published_at = $3 WHERE id = $1 AND status = $4;",
+		postgres.Id(episode.Id), episode.Status, column.NullTime(episode.PublishedAt), from)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected > 0 {
+		return err
+	}
 
 	var exists bool
-	err := row.Scan(&exists)
+	if err = adapter.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM episode WHERE id = $1)", postgres.Id(episode.Id)).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return repository.StatusChangedError("episode", episode.Id)
+	}
+	return repository.NotStoredError("episode", episode.Id)
+}
+
+func (adapter *PostgresEpisodeOutAdapter) PublishDueEpisodes(ctx context.Context, now time.Time) (episodeIds []string, err error) {
+	defer postgres.TranslateError(&err)
+	var rows *sql.Rows
+	if rows, err = adapter.db.QueryContext(ctx, "UPDATE episode SET status = $1 WHERE status = $2 AND published_at <= $3 RETURNING id;",
+		model.EpisodePublished, model.EpisodeScheduled, now); err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		episodeIds = append(episodeIds, id)
+	}
+	return episodeIds, rows.Err()
+}
+
+func (adapter *PostgresEpisodeOutAdapter) ExistsOtherByTitle(ctx context.Context, showId string, id string, title string) (exists bool, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT EXISTS(SELECT 1 FROM episode where show_id = $1 and id <> $2 and title = $3)"
+	err = adapter.db.QueryRowContext(ctx, query, postgres.Id(showId), postgres.Id(id), title).Scan(&exists)
+	return exists, err
+}
+
+func (adapter *PostgresEpisodeOutAdapter) DeleteEpisode(ctx context.Context, id string) (err error) {
+	defer postgres.TranslateError(&err)
+	transaction, err := adapter.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(transaction *sql.Tx) {
+		_ = transaction.Rollback()
+	}(transaction)
+
+	if _, err = transaction.ExecContext(ctx, "DELETE FROM show_episodes WHERE episode_id = $1;", postgres.Id(id)); err != nil {
+		return err
+	}
+	result, err := transaction.ExecContext(ctx, "DELETE FROM episode WHERE id = $1;", postgres.Id(id))
 	if err != nil {
-		return false
+		return err
+	}
+	if err = repository.RequireAffectedRows(result, "episode", id); err != nil {
+		return err
 	}
-	return exists
+	return transaction.Commit()
 }
 
-func (adapter *PostgresEpisodeOutAdapter) GetEpisodeOrNil(id string) (episode *model.Episode, err error) {
-	query := "SELECT * FROM episode where id = $1"
-	row := adapter.db.QueryRow(query, id)
+func (adapter *PostgresEpisodeOutAdapter) GetEpisodeOrNil(ctx context.Context, id string) (episode *model.Episode, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT " + episodeColumns + ", " + episodeMediaColumns + " FROM episode where id = $1"
+	row := adapter.db.QueryRowContext(ctx, query, postgres.Id(id))
 
-	episode = &model.Episode{}
-	if err = row.Scan(&episode.Id, &episode.ShowId, &episode.Title); err != nil {
+	if episode, err = scanEpisode(row); errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
+	if err != nil {
+		return nil, err
+	}
+	return episode, nil
+}
+
+func (adapter *PostgresEpisodeOutAdapter) GetEpisodesOfShow(ctx context.Context, showId string) (episodes []*model.Episode, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT " + episodeColumns + ", " + episodeMediaColumns + " FROM episode WHERE show_id = $1" +
+		" ORDER BY " + sortKeys[model.EpisodeSortPublishedAt][0] + " DESC, id"
+	var rows *sql.Rows
+	if rows, err = adapter.db.QueryContext(ctx, query, postgres.Id(showId)); err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	episodes = []*model.Episode{}
+	for rows.Next() {
+		var episode *model.Episode
+		if episode, err = scanEpisode(rows); err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+	return episodes, rows.Err()
+}
+
+// ListEpisodes pages through the episodes of a show by keyset: the query continues after the sort values
+// of the last episode of the previous page.
+func (adapter *PostgresEpisodeOutAdapter) ListEpisodes(ctx context.Context, query *model.EpisodeQuery) (episodes []*model.Episode, err error) {
+	defer postgres.TranslateError(&err)
+	sortKey, known := sortKeys[query.Sort]
+	if !known {
+		return nil, fmt.Errorf("unknown sort '%s'", query.Sort)
+	}
+	direction, comparison := "ASC", ">"
+	if query.Order == model.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	conditions := []string{"show_id = $1"}
+	args := []any{postgres.Id(query.ShowId)}
+	if query.Status != "" {
+		args = append(args, query.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if query.After != nil {
+		var afterValues []any
+		if query.Sort == model.EpisodeSortPublishedAt {
+			afterValues = []any{query.After.PublishedAt, postgres.Id(query.After.Id)}
+		} else {
+			afterValues = []any{query.After.Season, query.After.EpisodeNumber, postgres.Id(query.After.Id)}
+		}
+		var placeholders []string
+		for _, value := range afterValues {
+			args = append(args, value)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s) %s (%s)", strings.Join(sortKey, ", "), comparison, strings.Join(placeholders, ", ")))
+	}
+	args = append(args, query.Limit)
+
+	var order []string
+	for _, key := range sortKey {
+		order = append(order, key+" "+direction)
+	}
+	statement := fmt.Sprintf("SELECT %s, %s FROM episode WHERE %s ORDER BY %s LIMIT $%d", episodeColumns, episodeMediaColumns,
+		strings.Join(conditions, " AND "), strings.Join(order, ", "), len(args))
+
+	var rows *sql.Rows
+	if rows, err = adapter.db.QueryContext(ctx, statement, args...); err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var episode *model.Episode
+		if episode, err = scanEpisode(rows); err != nil {
+			return nil, err
+		}
+		episodes = append(episodes, episode)
+	}
+	return episodes, rows.Err()
+}
+
+func scanEpisode(row rowScanner) (*model.Episode, error) {
+	var (
+		episode       = &model.Episode{}
+		season        sql.NullInt64
+		episodeNumber sql.NullInt64
+		transcripts   []byte
+		chaptersUrl   sql.NullString
+		chaptersType  sql.NullString
+		persons       []byte
+		status        string
+		publishedAt   sql.NullTime
+		mediaKey      sql.NullString
+		mediaFileName sql.NullString
+		mediaType     sql.NullString
+		mediaSize     sql.NullInt64
+		duration      sql.NullInt64
+		bitrate       sql.NullInt64
+		sampleRate    sql.NullInt64
+		channels      sql.NullInt64
+		mediaTitle    sql.NullString
+		artworkKey    sql.NullString
+		artworkType   sql.NullString
+		chapters      []byte
+	)
+
+	if err := row.Scan(&episode.Id, &episode.ShowId, &episode.Title, &season, &episodeNumber,
+		&transcripts, &chaptersUrl, &chaptersType, &persons, &status, &publishedAt, &episode.Description,
+		&mediaKey, &mediaFileName, &mediaType, &mediaSize, &duration, &bitrate,
+		&sampleRate, &channels, &mediaTitle, &artworkKey, &artworkType, &chapters); err != nil {
+		return nil, err
+	}
+
+	episode.Season = int(season.Int64)
+	episode.EpisodeNumber = int(episodeNumber.Int64)
+	episode.Status = model.EpisodeStatus(status)
+	if publishedAt.Valid {
+		episode.PublishedAt = publishedAt.Time.UTC()
+	}
+	if chaptersUrl.Valid {
+		episode.Chapters = &model.Chapters{Url: chaptersUrl.String, Type: chaptersType.String}
+	}
+	if mediaKey.Valid {
+		episode.Media = &model.Media{
+			Key:        mediaKey.String,
+			FileName:   mediaFileName.String,
+			MimeType:   mediaType.String,
+			Size:       mediaSize.Int64,
+			Duration:   time.Duration(duration.Int64) * time.Millisecond,
+			Bitrate:    int(bitrate.Int64),
+			SampleRate: int(sampleRate.Int64),
+			Channels:   int(channels.Int64),
+			Title:      mediaTitle.String,
+		}
+		if artworkKey.Valid {
+			episode.Media.Artwork = &model.Artwork{Key: artworkKey.String, MimeType: artworkType.String}
+		}
+		if err := column.UnmarshalList(chapters, &episode.Media.Chapters); err != nil {
+			return nil, err
+		}
+	}
+	if err := column.UnmarshalList(transcripts, &episode.Transcripts); err != nil {
+		return nil, err
+	}
+	if err := column.UnmarshalList(persons, &episode.Persons); err != nil {
+		return nil, err
+	}
 	return episode, nil
 }
 
+func (adapter *PostgresEpisodeOutAdapter) SaveEpisodeMedia(ctx context.Context, episodeId string, media *model.Media) (err error) {
+	defer postgres.TranslateError(&err)
+	var stmt *sql.Stmt
+	var result sql.Result
+	var chapters string
+	var artworkKey, artworkType sql.NullString
+
+	if chapters, err = column.MarshalList(media.Chapters); err != nil {
+		return err
+	}
+	if media.Artwork != nil {
+		artworkKey, artworkType = column.NullString(media.Artwork.Key), column.NullString(media.Artwork.MimeType)
+	}
+
+	if stmt, err = adapter.db.PrepareContext(ctx, "UPDATE episode SET media_key = $2, media_file_name = $3, media_type = $4, media_size = $5, "+
+		"media_duration_ms = $6, media_bitrate = $7, media_sample_rate = $8, media_channels = $9, media_title = $10, "+
+		"media_artwork_key = $11, media_artwork_type = $12, media_chapters = $13 WHERE id = $1;"); err != nil {
+		return err
+	}
+	defer func(stmt *sql.Stmt) {
+		_ = stmt.Close()
+	}(stmt)
+
+	if result, err = stmt.ExecContext(ctx, postgres.Id(episodeId), media.Key, media.FileName, media.MimeType, media.Size,
+		media.Duration.Milliseconds(), media.Bitrate, media.SampleRate, media.Channels, column.NullString(media.Title),
+		artworkKey, artworkType, chapters); err != nil {
+		return err
+	}
+	return repository.RequireAffectedRows(result, "episode", episodeId)
+}
+
 func NewPostgresEpisodeRepository(db *sql.DB) *PostgresEpisodeOutAdapter {
 	return &PostgresEpisodeOutAdapter{db: db}
 }
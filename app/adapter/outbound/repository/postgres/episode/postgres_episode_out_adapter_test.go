@@ -6,6 +6,7 @@ import (
 	"podGopher/core/domain/model"
 	"podGopher/core/port/outbound"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,11 @@ func Test_episode_repository_should_implement_port(t *testing.T) {
 
 	assert.NotNil(t, repository)
 	assert.Implements(t, (*outbound.SaveEpisodePort)(nil), repository)
+	assert.Implements(t, (*outbound.GetShowEpisodesPort)(nil), repository)
+	assert.Implements(t, (*outbound.SaveEpisodeMediaPort)(nil), repository)
+	assert.Implements(t, (*outbound.ListEpisodesPort)(nil), repository)
+	assert.Implements(t, (*outbound.UpdateEpisodePort)(nil), repository)
+	assert.Implements(t, (*outbound.DeleteEpisodePort)(nil), repository)
 }
 
 func Test_should_not_save_episode_if_show_does_not_exist(t *testing.T) {
@@ -30,7 +36,7 @@ func Test_should_not_save_episode_if_show_does_not_exist(t *testing.T) {
 		ShowId: nonExistingShowId,
 		Title:  "test-Title",
 	}
-	err := repository.SaveEpisode(episode)
+	err := repository.SaveEpisode(t.Context(), episode)
 	assert.NotNil(t, err)
 }
 
@@ -49,22 +55,24 @@ func Test_should_save_an_episode(t *testing.T) {
 		Title:  episodeTitle,
 	}
 
-	if err := showRepository.SaveShow(&model.Show{Id: showUuid, Title: "test-show", Slug: "test-slug"}); err != nil {
+	if err := showRepository.SaveShow(t.Context(), &model.Show{Id: showUuid, Title: "test-show", Slug: "test-slug"}); err != nil {
 		t.Fatal(err)
 	}
 
 	t.Run("should return false if episode with title does not exist", func(t *testing.T) {
-		exists := repository.ExistsByTitle(episodeTitle)
+		exists, err := repository.ExistsByTitle(t.Context(), showUuid, episodeTitle)
+		assert.Nil(t, err)
 		assert.False(t, exists)
 	})
 
 	t.Run("should save an episode", func(t *testing.T) {
-		err := repository.SaveEpisode(episode)
+		err := repository.SaveEpisode(t.Context(), episode)
 		assert.Nil(t, err)
 	})
 
 	t.Run("should return true if episode with title exists", func(t *testing.T) {
-		exists := repository.ExistsByTitle(episodeTitle)
+		exists, err := repository.ExistsByTitle(t.Context(), showUuid, episodeTitle)
+		assert.Nil(t, err)
 		assert.True(t, exists)
 	})
 
@@ -72,7 +80,7 @@ func Test_should_save_an_episode(t *testing.T) {
 		var id string
 		var title string
 		var showId string
-		err := db.QueryRow("SELECT * FROM episode WHERE id = $1", episode.Id).
+		err := db.QueryRow("SELECT id, show_id, title FROM episode WHERE id = $1", episode.Id).
 			Scan(&id, &showId, &title)
 		if err != nil {
 			t.Fatal(err)
@@ -99,25 +107,30 @@ func Test_should_retrieve_an_episode(t *testing.T) {
 		Slug:  "Some-Slug",
 	}
 	episode := &model.Episode{
-		Id:     uuid.NewString(),
-		ShowId: showUuid,
-		Title:  "Some title",
+		Id:            uuid.NewString(),
+		ShowId:        showUuid,
+		Title:         "Some title",
+		Season:        1,
+		EpisodeNumber: 2,
+		Transcripts:   []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt", Language: "en"}},
+		Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Persons:       []model.Person{{Name: "some guest", Role: "guest"}},
 	}
 
-	err := showRepository.SaveShow(show)
+	err := showRepository.SaveShow(t.Context(), show)
 	assert.Nil(t, err)
 
-	err = repository.SaveEpisode(episode)
+	err = repository.SaveEpisode(t.Context(), episode)
 	assert.Nil(t, err)
 
 	t.Run("should return nil if episode does not exist", func(t *testing.T) {
-		foundEpisode, err := repository.GetEpisodeOrNil(uuid.NewString())
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), uuid.NewString())
 		assert.Nil(t, err)
 		assert.Nil(t, foundEpisode)
 	})
 
 	t.Run("should retrieve an episode", func(t *testing.T) {
-		foundEpisode, err := repository.GetEpisodeOrNil(episode.Id)
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), episode.Id)
 		assert.Nil(t, err)
 		assert.NotNil(t, foundEpisode)
 		assert.Equal(t, show.Id, foundEpisode.ShowId)
@@ -125,4 +138,204 @@ func Test_should_retrieve_an_episode(t *testing.T) {
 		assert.Equal(t, episode.Title, foundEpisode.Title)
 	})
 
+	t.Run("should retrieve podcast namespace values of an episode", func(t *testing.T) {
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, episode, foundEpisode)
+	})
+}
+
+func Test_should_retrieve_all_episodes_of_a_show(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	showRepository := repositoryShow.NewPostgresShowRepository(db)
+	repository := NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	otherShow := &model.Show{Id: uuid.NewString(), Title: "Other title", Slug: "Other-Slug"}
+	episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Some episode"}
+	otherEpisode := &model.Episode{Id: uuid.NewString(), ShowId: otherShow.Id, Title: "Other episode"}
+
+	assert.Nil(t, showRepository.SaveShow(t.Context(), show))
+	assert.Nil(t, showRepository.SaveShow(t.Context(), otherShow))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), episode))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), otherEpisode))
+
+	t.Run("should return empty list if show does not exist", func(t *testing.T) {
+		foundEpisodes, err := repository.GetEpisodesOfShow(t.Context(), uuid.NewString())
+		assert.Nil(t, err)
+		assert.Empty(t, foundEpisodes)
+	})
+
+	t.Run("should retrieve only episodes of the show", func(t *testing.T) {
+		foundEpisodes, err := repository.GetEpisodesOfShow(t.Context(), show.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{episode}, foundEpisodes)
+	})
+}
+
+func Test_should_save_media_of_an_episode(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	showRepository := repositoryShow.NewPostgresShowRepository(db)
+	repository := NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Some episode"}
+	media := &model.Media{
+		Key:        episode.Id + "/episode.mp3",
+		FileName:   "episode.mp3",
+		MimeType:   "audio/mpeg",
+		Size:       1024,
+		Duration:   90 * time.Second,
+		Bitrate:    128000,
+		SampleRate: 44100,
+		Channels:   2,
+		Title:      "Some media title",
+		Artwork:    &model.Artwork{Key: episode.Id + "/artwork.png", MimeType: "image/png"},
+		Chapters:   []model.MediaChapter{{Start: 0, Title: "Intro"}, {Start: 45 * time.Second, Title: "Topic"}},
+	}
+
+	assert.Nil(t, showRepository.SaveShow(t.Context(), show))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), episode))
+
+	t.Run("should retrieve an episode without media", func(t *testing.T) {
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, err)
+		assert.Nil(t, foundEpisode.Media)
+	})
+
+	t.Run("should save media", func(t *testing.T) {
+		err := repository.SaveEpisodeMedia(t.Context(), episode.Id, media)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should retrieve an episode with media", func(t *testing.T) {
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, media, foundEpisode.Media)
+	})
+}
+
+func Test_should_list_episodes_of_a_show(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	showRepository := repositoryShow.NewPostgresShowRepository(db)
+	repository := NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	otherShow := &model.Show{Id: uuid.NewString(), Title: "Other title", Slug: "Other-Slug"}
+	publishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	first := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "First", Season: 1, EpisodeNumber: 1,
+		Status: model.EpisodePublished, PublishedAt: publishedAt}
+	second := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Second", Season: 1, EpisodeNumber: 2,
+		Status: model.EpisodePublished, PublishedAt: publishedAt.Add(time.Hour)}
+	draft := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Draft", Season: 2, EpisodeNumber: 1,
+		Status: model.EpisodeDraft}
+	other := &model.Episode{Id: uuid.NewString(), ShowId: otherShow.Id, Title: "Other", Status: model.EpisodePublished, PublishedAt: publishedAt}
+
+	assert.Nil(t, showRepository.SaveShow(t.Context(), show))
+	assert.Nil(t, showRepository.SaveShow(t.Context(), otherShow))
+	for _, episode := range []*model.Episode{first, second, draft, other} {
+		assert.Nil(t, repository.SaveEpisode(t.Context(), episode))
+	}
+
+	t.Run("should list episodes by publish date", func(t *testing.T) {
+		found, err := repository.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: show.Id, Sort: model.EpisodeSortPublishedAt, Order: model.Descending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{second, first, draft}, found)
+	})
+
+	t.Run("should list episodes by number", func(t *testing.T) {
+		found, err := repository.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: show.Id, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{first, second}, found)
+	})
+
+	t.Run("should filter episodes by status", func(t *testing.T) {
+		found, err := repository.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: show.Id, Status: model.EpisodeDraft, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{draft}, found)
+	})
+
+	t.Run("should continue after key", func(t *testing.T) {
+		found, err := repository.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: show.Id, Sort: model.EpisodeSortPublishedAt, Order: model.Descending, Limit: 10,
+			After: &model.EpisodeKey{Id: second.Id, PublishedAt: second.PublishedAt}})
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{first, draft}, found)
+
+		found, err = repository.ListEpisodes(t.Context(), &model.EpisodeQuery{ShowId: show.Id, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 10,
+			After: &model.EpisodeKey{Id: second.Id, Season: second.Season, EpisodeNumber: second.EpisodeNumber}})
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Episode{draft}, found)
+	})
+}
+
+func Test_should_update_an_episode(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	showRepository := repositoryShow.NewPostgresShowRepository(db)
+	repository := NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	episode := &model.Episode{
+		Id:       uuid.NewString(),
+		ShowId:   show.Id,
+		Title:    "Some episode",
+		Chapters: &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+	}
+	otherEpisode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Other episode"}
+	assert.Nil(t, showRepository.SaveShow(t.Context(), show))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), episode))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), otherEpisode))
+
+	t.Run("should not count the episode itself as existing", func(t *testing.T) {
+		exists, err := repository.ExistsOtherByTitle(t.Context(), show.Id, episode.Id, episode.Title)
+		assert.Nil(t, err)
+		assert.False(t, exists)
+
+		exists, err = repository.ExistsOtherByTitle(t.Context(), show.Id, episode.Id, otherEpisode.Title)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should update an episode", func(t *testing.T) {
+		episode.Title = "Changed episode"
+		episode.Season = 2
+		episode.Chapters = nil
+
+		err := repository.UpdateEpisode(t.Context(), episode)
+		assert.Nil(t, err)
+
+		foundEpisode, err := repository.GetEpisodeOrNil(t.Context(), episode.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, "Changed episode", foundEpisode.Title)
+		assert.Equal(t, 2, foundEpisode.Season)
+		assert.Nil(t, foundEpisode.Chapters)
+	})
+}
+
+func Test_should_delete_an_episode(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	showRepository := repositoryShow.NewPostgresShowRepository(db)
+	repository := NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Some episode"}
+	assert.Nil(t, showRepository.SaveShow(t.Context(), show))
+	assert.Nil(t, repository.SaveEpisode(t.Context(), episode))
+
+	err := repository.DeleteEpisode(t.Context(), episode.Id)
+	assert.Nil(t, err)
+
+	foundEpisode, _ := repository.GetEpisodeOrNil(t.Context(), episode.Id)
+	assert.Nil(t, foundEpisode)
+	foundShow, _ := showRepository.GetShowOrNil(t.Context(), show.Id)
+	assert.Empty(t, foundShow.Episodes)
 }
@@ -0,0 +1,113 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/postgres"
+	"podGopher/core/domain/model"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const jobColumns = "id, type, unique_key, payload, status, attempts, max_attempts, run_at, last_error"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type PostgresJobOutAdapter struct {
+	db *sql.DB
+}
+
+// EnqueueJob only skips a job whose unique key is taken, a taken id still fails with a constraint violation.
+func (adapter *PostgresJobOutAdapter) EnqueueJob(ctx context.Context, job *model.Job) (queued bool, err error) {
+	defer postgres.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "INSERT INTO job ("+jobColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) "+
+		"ON CONFLICT (unique_key) WHERE status IN ('pending', 'running') DO NOTHING;",
+		job.Id, job.Type, column.NullString(job.UniqueKey), string(job.Payload), job.Status, job.Attempts, job.MaxAttempts, job.RunAt, job.LastError)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func (adapter *PostgresJobOutAdapter) GetJobOrNil(ctx context.Context, id string) (job *model.Job, err error) {
+	defer postgres.TranslateError(&err)
+	row := adapter.db.QueryRowContext(ctx, "SELECT "+jobColumns+" FROM job WHERE id = $1", postgres.Id(id))
+
+	if job, err = scanJob(row); errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return job, err
+}
+
+// ClaimJob skips the jobs which concurrent claims locked, so workers neither wait for nor claim the same job.
+func (adapter *PostgresJobOutAdapter) ClaimJob(ctx context.Context, types []string, now time.Time, lease time.Duration) (job *model.Job, err error) {
+	defer postgres.TranslateError(&err)
+	query := `UPDATE job SET status = $1, attempts = attempts + 1, run_at = $2
+		WHERE id = (
+			SELECT id FROM job WHERE status <> $3 AND run_at <= $4 AND type = ANY($5)
+			ORDER BY run_at, id LIMIT 1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + jobColumns
+	row := adapter.db.QueryRowContext(ctx, query, model.JobRunning, now.Add(lease), model.JobDead, now, pq.Array(types))
+
+	if job, err = scanJob(row); errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return job, err
+}
+
+func (adapter *PostgresJobOutAdapter) CompleteJob(ctx context.Context, job *model.Job) (err error) {
+	defer postgres.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "DELETE FROM job WHERE id = $1 AND status = $2 AND attempts = $3;",
+		job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func (adapter *PostgresJobOutAdapter) RetryJob(ctx context.Context, job *model.Job) (err error) {
+	defer postgres.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "UPDATE job SET status = $1, run_at = $2, last_error = $3 WHERE id = $4 AND status = $5 AND attempts = $6;",
+		model.JobPending, job.RunAt, job.LastError, job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func (adapter *PostgresJobOutAdapter) BuryJob(ctx context.Context, job *model.Job) (err error) {
+	defer postgres.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "UPDATE job SET status = $1, last_error = $2 WHERE id = $3 AND status = $4 AND attempts = $5;",
+		model.JobDead, job.LastError, job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func scanJob(row rowScanner) (*model.Job, error) {
+	var (
+		job       = &model.Job{}
+		uniqueKey sql.NullString
+		payload   []byte
+	)
+	if err := row.Scan(&job.Id, &job.Type, &uniqueKey, &payload, &job.Status, &job.Attempts, &job.MaxAttempts, &job.RunAt, &job.LastError); err != nil {
+		return nil, err
+	}
+	job.UniqueKey = uniqueKey.String
+	job.Payload = payload
+	job.RunAt = job.RunAt.UTC()
+	return job, nil
+}
+
+func NewPostgresJobRepository(db *sql.DB) *PostgresJobOutAdapter {
+	return &PostgresJobOutAdapter{db: db}
+}
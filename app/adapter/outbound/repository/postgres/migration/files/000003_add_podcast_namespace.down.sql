@@ -0,0 +1,13 @@
+ALTER TABLE episode
+    DROP COLUMN IF EXISTS persons,
+    DROP COLUMN IF EXISTS chapters_type,
+    DROP COLUMN IF EXISTS chapters_url,
+    DROP COLUMN IF EXISTS transcripts,
+    DROP COLUMN IF EXISTS episode_number,
+    DROP COLUMN IF EXISTS season;
+
+ALTER TABLE show
+    DROP COLUMN IF EXISTS persons,
+    DROP COLUMN IF EXISTS funding,
+    DROP COLUMN IF EXISTS locked,
+    DROP COLUMN IF EXISTS guid;
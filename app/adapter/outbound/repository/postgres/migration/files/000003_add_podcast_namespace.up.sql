@@ -0,0 +1,13 @@
+ALTER TABLE show
+    ADD COLUMN IF NOT EXISTS guid    varchar(255),
+    ADD COLUMN IF NOT EXISTS locked  boolean not null default false,
+    ADD COLUMN IF NOT EXISTS funding jsonb   not null default '[]',
+    ADD COLUMN IF NOT EXISTS persons jsonb   not null default '[]';
+
+ALTER TABLE episode
+    ADD COLUMN IF NOT EXISTS season         integer,
+    ADD COLUMN IF NOT EXISTS episode_number integer,
+    ADD COLUMN IF NOT EXISTS transcripts    jsonb not null default '[]',
+    ADD COLUMN IF NOT EXISTS chapters_url   varchar(2048),
+    ADD COLUMN IF NOT EXISTS chapters_type  varchar(255),
+    ADD COLUMN IF NOT EXISTS persons        jsonb not null default '[]';
@@ -0,0 +1,5 @@
+ALTER TABLE episode
+    DROP COLUMN IF EXISTS media_size,
+    DROP COLUMN IF EXISTS media_type,
+    DROP COLUMN IF EXISTS media_file_name,
+    DROP COLUMN IF EXISTS media_key;
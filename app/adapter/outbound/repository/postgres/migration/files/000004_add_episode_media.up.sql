@@ -0,0 +1,5 @@
+ALTER TABLE episode
+    ADD COLUMN IF NOT EXISTS media_key       varchar(1024),
+    ADD COLUMN IF NOT EXISTS media_file_name varchar(255),
+    ADD COLUMN IF NOT EXISTS media_type      varchar(255),
+    ADD COLUMN IF NOT EXISTS media_size      bigint;
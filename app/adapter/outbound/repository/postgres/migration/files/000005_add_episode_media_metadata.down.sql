@@ -0,0 +1,9 @@
+ALTER TABLE episode
+    DROP COLUMN IF EXISTS media_chapters,
+    DROP COLUMN IF EXISTS media_artwork_type,
+    DROP COLUMN IF EXISTS media_artwork_key,
+    DROP COLUMN IF EXISTS media_title,
+    DROP COLUMN IF EXISTS media_channels,
+    DROP COLUMN IF EXISTS media_sample_rate,
+    DROP COLUMN IF EXISTS media_bitrate,
+    DROP COLUMN IF EXISTS media_duration_ms;
@@ -0,0 +1,9 @@
+ALTER TABLE episode
+    ADD COLUMN IF NOT EXISTS media_duration_ms  bigint,
+    ADD COLUMN IF NOT EXISTS media_bitrate      integer,
+    ADD COLUMN IF NOT EXISTS media_sample_rate  integer,
+    ADD COLUMN IF NOT EXISTS media_channels     integer,
+    ADD COLUMN IF NOT EXISTS media_title        varchar(1024),
+    ADD COLUMN IF NOT EXISTS media_artwork_key  varchar(1024),
+    ADD COLUMN IF NOT EXISTS media_artwork_type varchar(255),
+    ADD COLUMN IF NOT EXISTS media_chapters     jsonb NOT NULL DEFAULT '[]';
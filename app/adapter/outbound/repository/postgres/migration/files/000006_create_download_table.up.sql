@@ -0,0 +1,17 @@
+-- raw requests of episode media, kept independent of shows and episodes to preserve the history
+CREATE TABLE IF NOT EXISTS download
+(
+    id           bigserial primary key not null,
+    show_id      uuid                  not null,
+    episode_id   uuid                  not null,
+    media_key    varchar(1024)         not null,
+    method       varchar(16)           not null,
+    ip_hash      varchar(64)           not null,
+    user_agent   text                  not null,
+    referrer     text                  not null,
+    range_start  bigint                not null,
+    range_end    bigint                not null,
+    requested_at timestamptz           not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_show_id_requested_at on download (show_id, requested_at);
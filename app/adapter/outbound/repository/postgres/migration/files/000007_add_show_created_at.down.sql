@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_show_created_at_id;
+DROP INDEX IF EXISTS idx_show_title_id;
+
+ALTER TABLE show DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,5 @@
+ALTER TABLE show ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now();
+
+-- keyset pagination of shows in both sort orders
+CREATE INDEX IF NOT EXISTS idx_show_title_id on show (title, id);
+CREATE INDEX IF NOT EXISTS idx_show_created_at_id on show (created_at, id);
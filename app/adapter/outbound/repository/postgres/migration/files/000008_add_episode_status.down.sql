@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_episode_show_id_published_at;
+
+ALTER TABLE episode
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS status;
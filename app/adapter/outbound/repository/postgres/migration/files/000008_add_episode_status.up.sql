@@ -0,0 +1,8 @@
+-- episodes created so far went live immediately
+ALTER TABLE episode
+    ADD COLUMN IF NOT EXISTS status       varchar(32) not null default 'published',
+    ADD COLUMN IF NOT EXISTS published_at timestamptz;
+
+UPDATE episode SET published_at = now() WHERE published_at IS NULL AND status = 'published';
+
+CREATE INDEX IF NOT EXISTS idx_episode_show_id_published_at on episode (show_id, published_at, id);
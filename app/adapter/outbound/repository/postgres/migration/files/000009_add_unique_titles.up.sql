@@ -0,0 +1,22 @@
+-- the database decides on uniqueness, so concurrent requests can not both pass the check of the services
+
+-- rows which duplicate an older one get its id appended, which keeps them apart and within 255 characters
+UPDATE show
+SET title = left(title, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY created_at, id) AS occurrence FROM show) AS titles
+             WHERE occurrence > 1);
+UPDATE show
+SET slug = rtrim(left(slug, 218), '-') || '-' || id
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY slug ORDER BY created_at, id) AS occurrence FROM show) AS slugs
+             WHERE occurrence > 1);
+UPDATE episode
+SET title = left(title, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY id) AS occurrence FROM episode) AS titles
+             WHERE occurrence > 1);
+
+CREATE UNIQUE INDEX IF NOT EXISTS show_title_unique on show (title);
+CREATE UNIQUE INDEX IF NOT EXISTS show_slug_unique on show (slug);
+CREATE UNIQUE INDEX IF NOT EXISTS episode_title_unique on episode (title);
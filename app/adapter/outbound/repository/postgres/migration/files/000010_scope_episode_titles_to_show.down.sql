@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS episode_show_id_title_unique;
+
+-- titles are unique across shows again, the index of 000009 refuses the duplicates which shows may have by now,
+-- so they get their id appended like in 000009
+UPDATE episode
+SET title = left(title, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY id) AS occurrence FROM episode) AS titles
+             WHERE occurrence > 1);
+CREATE UNIQUE INDEX IF NOT EXISTS episode_title_unique on episode (title);
+
+ALTER TABLE episode DROP COLUMN IF EXISTS unique_title;
+ALTER TABLE show DROP COLUMN IF EXISTS allow_duplicate_episode_titles;
@@ -0,0 +1,7 @@
+-- episode titles are unique within their show, unless the show allows duplicates. The partial index only covers
+-- episodes of shows which disallow them, so each episode carries the flag of its show.
+ALTER TABLE show ADD COLUMN IF NOT EXISTS allow_duplicate_episode_titles boolean not null default false;
+ALTER TABLE episode ADD COLUMN IF NOT EXISTS unique_title boolean not null default true;
+
+DROP INDEX IF EXISTS episode_title_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS episode_show_id_title_unique on episode (show_id, title) WHERE unique_title;
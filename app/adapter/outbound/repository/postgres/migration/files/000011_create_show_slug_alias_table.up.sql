@@ -0,0 +1,8 @@
+-- former slugs of shows, so public urls with them redirect to the current slug
+CREATE TABLE IF NOT EXISTS show_slug_alias
+(
+    slug    varchar(255) primary key not null,
+    show_id uuid                     not null references show (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_show_slug_alias_show_id on show_slug_alias (show_id);
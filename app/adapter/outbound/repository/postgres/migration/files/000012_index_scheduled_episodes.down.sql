@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_episode_scheduled_published_at;
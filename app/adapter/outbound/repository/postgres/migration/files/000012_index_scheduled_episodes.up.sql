@@ -0,0 +1,2 @@
+-- the scheduler looks for scheduled episodes whose publish date has come
+CREATE INDEX IF NOT EXISTS idx_episode_scheduled_published_at on episode (published_at) WHERE status = 'scheduled';
@@ -0,0 +1,15 @@
+-- background jobs, run_at is the due date of pending jobs and the end of the lease of running ones
+CREATE TABLE IF NOT EXISTS job
+(
+    id           uuid primary key not null,
+    type         varchar(256)     not null,
+    payload      jsonb            not null,
+    status       varchar(16)      not null,
+    attempts     integer          not null default 0,
+    max_attempts integer          not null,
+    run_at       timestamptz      not null,
+    last_error   text             not null default ''
+);
+
+-- workers claim the job which is due longest, dead letters are never claimed
+CREATE INDEX IF NOT EXISTS idx_job_due_run_at on job (run_at) WHERE status <> 'dead';
@@ -0,0 +1 @@
+ALTER TABLE episode DROP COLUMN IF EXISTS description;
@@ -0,0 +1,2 @@
+-- the show notes of an episode, plain text
+ALTER TABLE episode ADD COLUMN IF NOT EXISTS description text not null default '';
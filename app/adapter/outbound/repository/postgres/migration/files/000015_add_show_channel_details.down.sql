@@ -0,0 +1,7 @@
+ALTER TABLE show DROP COLUMN IF EXISTS explicit;
+ALTER TABLE show DROP COLUMN IF EXISTS subcategory;
+ALTER TABLE show DROP COLUMN IF EXISTS category;
+ALTER TABLE show DROP COLUMN IF EXISTS image_url;
+ALTER TABLE show DROP COLUMN IF EXISTS author;
+ALTER TABLE show DROP COLUMN IF EXISTS language;
+ALTER TABLE show DROP COLUMN IF EXISTS description;
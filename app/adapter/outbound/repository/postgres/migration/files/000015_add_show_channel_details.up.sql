@@ -0,0 +1,8 @@
+-- the channel details podcast directories require, plain text
+ALTER TABLE show ADD COLUMN IF NOT EXISTS description text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS language text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS author text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS image_url text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS category text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS subcategory text not null default '';
+ALTER TABLE show ADD COLUMN IF NOT EXISTS explicit boolean not null default false;
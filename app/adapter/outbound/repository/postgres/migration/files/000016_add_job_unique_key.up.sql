@@ -0,0 +1,4 @@
+-- a job with a unique key is queued only once while it waits or runs, like the publishing queued on every tick
+ALTER TABLE job ADD COLUMN IF NOT EXISTS unique_key varchar(256);
+
+CREATE UNIQUE INDEX IF NOT EXISTS job_unique_key_unique on job (unique_key) WHERE status IN ('pending', 'running');
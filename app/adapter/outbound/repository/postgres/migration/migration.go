@@ -1,27 +1,47 @@
 package migration
 
 import (
+	"errors"
 	"fmt"
+	"podGopher/adapter/outbound/repository"
 	"podGopher/env"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 )
 
+// Migration holds its own connection to the database until it is closed.
 type Migration struct {
-	migrate *migrate.Migrate
+	migrate       *migrate.Migrate
+	latestVersion uint
 }
 
 func NewMigration() (*Migration, error) {
 	dsn := GetPostgresConnectionString()
 	sourceUrl := fmt.Sprintf("file://%s", env.MigrationDir.GetValue())
 
+	latestVersion, err := readLatestVersion(sourceUrl)
+	if err != nil {
+		return nil, err
+	}
 	m, err := migrate.New(sourceUrl, dsn)
 	if err != nil {
 		return nil, err
 	}
-	return &Migration{m}, err
+	return &Migration{m, latestVersion}, err
+}
+
+func readLatestVersion(sourceUrl string) (uint, error) {
+	files, err := source.Open(sourceUrl)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = files.Close()
+	}()
+	return repository.LatestMigrationVersion(files)
 }
 
 func GetPostgresConnectionString() string {
@@ -36,10 +56,25 @@ func GetPostgresConnectionString() string {
 }
 
 func (m *Migration) Migrate() error {
-	defer func(migrate *migrate.Migrate) {
-		_, _ = migrate.Close()
-	}(m.migrate)
+	if err := m.migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+func (m *Migration) Version() (uint, bool, error) {
+	version, dirty, err := m.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+func (m *Migration) LatestVersion() uint {
+	return m.latestVersion
+}
 
-	err := m.migrate.Up()
-	return err
+func (m *Migration) Close() error {
+	sourceErr, dbErr := m.migrate.Close()
+	return errors.Join(sourceErr, dbErr)
 }
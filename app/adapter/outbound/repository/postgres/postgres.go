@@ -0,0 +1,89 @@
+// Package postgres stores shows, episodes and downloads in Postgres, the storage for production deployments.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"net/url"
+	error2 "podGopher/core/domain/error"
+	"slices"
+
+	"github.com/XSAM/otelsql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	postgresClient "gocloud.dev/postgres"
+)
+
+// Open opens the database of a connection string. Each statement is a span of the trace of its context, spans
+// of connection handling and of reading rows are left out as they only add noise.
+func Open(ctx context.Context, dsn string) (*sql.DB, error) {
+	dsnUrl, err := url.Parse(dsn)
+	if err != nil {
+		return nil, err
+	}
+	opener := &postgresClient.URLOpener{TraceOpts: []otelsql.Option{
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitConnPrepare: true, OmitRows: true}),
+	}}
+	return opener.OpenPostgresURL(ctx, dsnUrl)
+}
+
+// TranslateError replaces an error of the driver by a repository error, so callers can tell an outage from
+// a conflict without knowing Postgres. Errors without counterpart, like those of a cancelled context, stay as
+// they are. Adapters defer it with their named error result.
+func TranslateError(err *error) {
+	if *err == nil {
+		return
+	}
+	if failure, known := failureOf(*err); known {
+		*err = error2.NewRepositoryError(failure, *err)
+	}
+}
+
+// Id passes an id to a uuid column. An id of another format names no entity, as in the other repositories, so it
+// is passed as nil uuid, which no entity has, instead of failing the statement.
+func Id(id string) string {
+	if uuid.Validate(id) != nil {
+		return uuid.Nil.String()
+	}
+	return id
+}
+
+// IsUniqueViolation tells whether a statement failed on one of the given unique constraints or indexes.
+func IsUniqueViolation(err error, constraints ...string) bool {
+	var pqError *pq.Error
+	return errors.As(err, &pqError) && pqError.Code.Name() == "unique_violation" && slices.Contains(constraints, pqError.Constraint)
+}
+
+func failureOf(err error) (error2.RepositoryFailure, bool) {
+	var repositoryError *error2.RepositoryError
+	if errors.As(err, &repositoryError) {
+		return "", false
+	}
+
+	var pqError *pq.Error
+	if errors.As(err, &pqError) {
+		switch {
+		case pqError.Code.Class() == "23":
+			return error2.ConstraintViolation, true
+		case pqError.Code.Class() == "40":
+			return error2.SerializationFailure, true
+		case pqError.Code.Class() == "08",
+			pqError.Code.Name() == "admin_shutdown",
+			pqError.Code.Name() == "crash_shutdown",
+			pqError.Code.Name() == "cannot_connect_now":
+			return error2.ConnectionFailure, true
+		}
+		return "", false
+	}
+
+	var netError net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.As(err, &netError) {
+		return error2.ConnectionFailure, true
+	}
+	return "", false
+}
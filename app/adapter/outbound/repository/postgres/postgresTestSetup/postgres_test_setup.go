@@ -5,13 +5,13 @@ import (
 	"database/sql"
 	"os"
 	"path/filepath"
+	repositoryPostgres "podGopher/adapter/outbound/repository/postgres"
 	"podGopher/adapter/outbound/repository/postgres/migration"
 	"podGopher/env"
 	"testing"
 
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	postgresClient "gocloud.dev/postgres"
 )
 
 var postgresContainer *postgres.PostgresContainer
@@ -59,13 +59,16 @@ func executeMigration(t *testing.T, configDir string) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	defer func() {
+		_ = m.Close()
+	}()
 	if err := m.Migrate(); err != nil {
 		t.Fatal(err)
 	}
 }
 
 func createAndVerifyConnection(t *testing.T, ctx context.Context, dsn string) *sql.DB {
-	db, err := postgresClient.Open(ctx, dsn)
+	db, err := repositoryPostgres.Open(ctx, dsn)
 	if err != nil {
 		t.Fatalf("failed to open db: %v", err)
 	}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	error2 "podGopher/core/domain/error"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_translate_errors_of_driver(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected error2.RepositoryFailure
+	}{
+		"unique violation":      {&pq.Error{Code: "23505"}, error2.ConstraintViolation},
+		"foreign key violation": {&pq.Error{Code: "23503"}, error2.ConstraintViolation},
+		"serialization failure": {&pq.Error{Code: "40001"}, error2.SerializationFailure},
+		"deadlock":              {&pq.Error{Code: "40P01"}, error2.SerializationFailure},
+		"connection failure":    {&pq.Error{Code: "08006"}, error2.ConnectionFailure},
+		"shutdown":              {&pq.Error{Code: "57P01"}, error2.ConnectionFailure},
+		"bad connection":        {driver.ErrBadConn, error2.ConnectionFailure},
+		"wrapped":               {fmt.Errorf("insert: %w", &pq.Error{Code: "23505"}), error2.ConstraintViolation},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.err
+
+			TranslateError(&err)
+
+			assert.True(t, error2.IsRepositoryFailure(err, test.expected), err)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func Test_should_keep_errors_without_repository_failure(t *testing.T) {
+	for _, original := range []error{nil, context.Canceled, &pq.Error{Code: "57014"}, errors.New("some error")} {
+		err := original
+
+		TranslateError(&err)
+
+		assert.Equal(t, original, err)
+	}
+}
+
+func Test_should_not_translate_repository_error_twice(t *testing.T) {
+	original := error2.NewRepositoryError(error2.NotFound, errors.New("some error"))
+	var err error = original
+
+	TranslateError(&err)
+
+	assert.Same(t, original, err)
+}
+
+func Test_should_tell_unique_violation_of_given_constraints(t *testing.T) {
+	err := fmt.Errorf("insert: %w", &pq.Error{Code: "23505", Constraint: "show_title_unique"})
+
+	assert.True(t, IsUniqueViolation(err, "show_title_unique", "show_slug_unique"))
+	assert.False(t, IsUniqueViolation(err, "show_pkey"))
+	assert.False(t, IsUniqueViolation(&pq.Error{Code: "23503", Constraint: "show_title_unique"}, "show_title_unique"))
+	assert.False(t, IsUniqueViolation(nil, "show_title_unique"))
+}
+
+func Test_should_pass_ids_of_another_format_as_nil_uuid(t *testing.T) {
+	id := "4bf92f35-77b3-4da6-a3ce-929d0e0e4736"
+
+	assert.Equal(t, id, Id(id))
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", Id("not-a-uuid"))
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", Id(""))
+}
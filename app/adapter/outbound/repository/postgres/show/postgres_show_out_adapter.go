@@ -1,46 +1,192 @@
 package show
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/postgres"
+	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"strings"
+	"time"
 )
 
+var sortColumns = map[model.ShowSort]string{
+	model.ShowSortTitle:     "title",
+	model.ShowSortCreatedAt: "created_at",
+}
+
+var uniqueConstraints = []string{"show_title_unique", "show_slug_unique"}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 type PostgresShowOutAdapter struct {
 	db *sql.DB
 }
 
-func (adapter *PostgresShowOutAdapter) SaveShow(show *model.Show) (err error) {
-	var stmt *sql.Stmt
+// SaveShow refuses a slug which another show had before in the same statement, so a concurrent change of a slug
+// can not slip in between a check and the insert.
+func (adapter *PostgresShowOutAdapter) SaveShow(ctx context.Context, show *model.Show) (err error) {
+	defer postgres.TranslateError(&err)
+	var funding, persons string
 
-	if stmt, err = adapter.db.Prepare("INSERT INTO show (id, title, slug) VALUES ($1, $2, $3);"); err != nil {
+	if funding, err = column.MarshalList(show.Funding); err != nil {
 		return err
 	}
-	defer func(stmt *sql.Stmt) {
-		_ = stmt.Close()
-	}(stmt)
-
-	if _, err = stmt.Exec(show.Id, show.Title, show.Slug); err != nil {
+	if persons, err = column.MarshalList(show.Persons); err != nil {
 		return err
 	}
 
+	result, err := adapter.db.ExecContext(ctx, "INSERT INTO show (id, title, slug, guid, locked, allow_duplicate_episode_titles, funding, persons, "+
+		"description, language, author, image_url, category, subcategory, explicit) "+
+		"SELECT $1::uuid, $2, $3, $4, $5::boolean, $6::boolean, $7::jsonb, $8::jsonb, $9, $10, $11, $12, $13, $14, $15::boolean "+
+		"WHERE NOT EXISTS(SELECT 1 FROM show_slug_alias WHERE slug = $3);",
+		show.Id, show.Title, show.Slug, column.NullString(show.Guid), show.Locked, show.AllowDuplicateEpisodeTitles, funding, persons,
+		show.Description, show.Language, show.Author, show.ImageUrl, show.Category, show.Subcategory, show.Explicit)
+	if postgres.IsUniqueViolation(err, uniqueConstraints...) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
 	return nil
 }
 
-func (adapter *PostgresShowOutAdapter) ExistsByTitleOrSlug(title string, slug string) bool {
-	query := "SELECT EXISTS(SELECT 1 FROM show where title = $1 or slug = $2)"
-	row := adapter.db.QueryRow(query, title, slug)
+func (adapter *PostgresShowOutAdapter) ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (exists bool, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT EXISTS(SELECT 1 FROM show where title = $1 or slug = $2) OR EXISTS(SELECT 1 FROM show_slug_alias where slug = $2)"
+	err = adapter.db.QueryRowContext(ctx, query, title, slug).Scan(&exists)
+	return exists, err
+}
+
+func (adapter *PostgresShowOutAdapter) ExistsBySlug(ctx context.Context, slug string) (exists bool, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT EXISTS(SELECT 1 FROM show where slug = $1) OR EXISTS(SELECT 1 FROM show_slug_alias where slug = $1)"
+	err = adapter.db.QueryRowContext(ctx, query, slug).Scan(&exists)
+	return exists, err
+}
+
+// UpdateShow changes a show and hands its episodes the setting for duplicate titles. Disallowing duplicates
+// fails with a constraint violation while episodes of the show share a title. A changed slug stays as alias
+// of the show, the new slug stops being one. Like on saving, the update refuses a former slug of another show.
+func (adapter *PostgresShowOutAdapter) UpdateShow(ctx context.Context, show *model.Show) (err error) {
+	defer postgres.TranslateError(&err)
+	var funding, persons string
+
+	if funding, err = column.MarshalList(show.Funding); err != nil {
+		return err
+	}
+	if persons, err = column.MarshalList(show.Persons); err != nil {
+		return err
+	}
+
+	id := postgres.Id(show.Id)
+	transaction, err := adapter.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(transaction *sql.Tx) {
+		_ = transaction.Rollback()
+	}(transaction)
 
+	if _, err = transaction.ExecContext(ctx, "INSERT INTO show_slug_alias (slug, show_id) SELECT slug, id FROM show WHERE id = $1 AND slug <> $2 "+
+		"ON CONFLICT (slug) DO UPDATE SET show_id = excluded.show_id;", id, show.Slug); err != nil {
+		return err
+	}
+	result, err := transaction.ExecContext(ctx, "UPDATE show SET title = $2, slug = $3, guid = $4, locked = $5, "+
+		"allow_duplicate_episode_titles = $6, funding = $7, persons = $8, description = $9, language = $10, author = $11, "+
+		"image_url = $12, category = $13, subcategory = $14, explicit = $15 "+
+		"WHERE id = $1 AND NOT EXISTS(SELECT 1 FROM show_slug_alias WHERE slug = $3 AND show_id <> $1);",
+		id, show.Title, show.Slug, column.NullString(show.Guid), show.Locked, show.AllowDuplicateEpisodeTitles, funding, persons,
+		show.Description, show.Language, show.Author, show.ImageUrl, show.Category, show.Subcategory, show.Explicit)
+	if postgres.IsUniqueViolation(err, uniqueConstraints...) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	if err != nil {
+		return err
+	}
+	if err = repository.RequireAffectedRows(result, "show", show.Id); err != nil {
+		return slugOfOtherShowOr(ctx, transaction, id, show, err)
+	}
+	if _, err = transaction.ExecContext(ctx, "DELETE FROM show_slug_alias WHERE show_id = $1 AND slug = $2;", id, show.Slug); err != nil {
+		return err
+	}
+	if _, err = transaction.ExecContext(ctx, "UPDATE episode SET unique_title = $2 WHERE show_id = $1 AND unique_title <> $2;",
+		id, !show.AllowDuplicateEpisodeTitles); err != nil {
+		return err
+	}
+	return transaction.Commit()
+}
+
+// slugOfOtherShowOr tells an update which changed no row because of a former slug of another show from one of
+// a missing show, which fails with notStored.
+func slugOfOtherShowOr(ctx context.Context, transaction *sql.Tx, id string, show *model.Show, notStored error) error {
 	var exists bool
-	err := row.Scan(&exists)
+	if err := transaction.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM show WHERE id = $1)", id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	return notStored
+}
+
+func (adapter *PostgresShowOutAdapter) ExistsOtherByTitleOrSlug(ctx context.Context, id string, title string, slug string) (exists bool, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT EXISTS(SELECT 1 FROM show where id <> $1 and (title = $2 or slug = $3)) " +
+		"OR EXISTS(SELECT 1 FROM show_slug_alias where show_id <> $1 and slug = $3)"
+	err = adapter.db.QueryRowContext(ctx, query, postgres.Id(id), title, slug).Scan(&exists)
+	return exists, err
+}
+
+// DeleteShow deletes a show together with its episodes. Downloads are not referenced and stay for analytics.
+func (adapter *PostgresShowOutAdapter) DeleteShow(ctx context.Context, id string) (err error) {
+	defer postgres.TranslateError(&err)
+	transaction, err := adapter.db.BeginTx(ctx, nil)
 	if err != nil {
-		return false
+		return err
+	}
+	defer func(transaction *sql.Tx) {
+		_ = transaction.Rollback()
+	}(transaction)
+
+	for _, statement := range []string{
+		"DELETE FROM show_episodes WHERE show_id = $1;",
+		"DELETE FROM episode WHERE show_id = $1;",
+		"DELETE FROM show_slug_alias WHERE show_id = $1;",
+	} {
+		if _, err = transaction.ExecContext(ctx, statement, postgres.Id(id)); err != nil {
+			return err
+		}
 	}
-	return exists
+	result, err := transaction.ExecContext(ctx, "DELETE FROM show WHERE id = $1;", postgres.Id(id))
+	if err != nil {
+		return err
+	}
+	if err = repository.RequireAffectedRows(result, "show", id); err != nil {
+		return err
+	}
+	return transaction.Commit()
 }
 
-func (adapter *PostgresShowOutAdapter) GetShowOrNil(id string) (show *model.Show, err error) {
-	query := "SELECT s.*, se.episode_id FROM show s LEFT JOIN show_episodes se ON se.show_id = s.id WHERE s.id = $1;"
-	rows, _ := adapter.db.Query(query, id)
+func (adapter *PostgresShowOutAdapter) GetShowOrNil(ctx context.Context, id string) (show *model.Show, err error) {
+	defer postgres.TranslateError(&err)
+	query := "SELECT s.id, s.title, s.slug, s.guid, s.locked, s.allow_duplicate_episode_titles, s.funding, s.persons, " +
+		"s.description, s.language, s.author, s.image_url, s.category, s.subcategory, s.explicit, s.created_at, se.episode_id " +
+		"FROM show s LEFT JOIN show_episodes se ON se.show_id = s.id WHERE s.id = $1;"
+	rows, err := adapter.db.QueryContext(ctx, query, postgres.Id(id))
+	if err != nil {
+		return nil, err
+	}
 	defer func(rows *sql.Rows) {
 		_ = rows.Close()
 	}(rows)
@@ -52,26 +198,105 @@ func (adapter *PostgresShowOutAdapter) GetShowOrNil(id string) (show *model.Show
 		}
 	}
 
-	return show, nil
+	return show, rows.Err()
+}
+
+// ListShows pages through shows by keyset: the query continues after the sort value and id of the
+// last show of the previous page, so pages stay consistent while shows are added.
+func (adapter *PostgresShowOutAdapter) ListShows(ctx context.Context, query *model.ShowQuery) (shows []*model.Show, err error) {
+	defer postgres.TranslateError(&err)
+	sortColumn, known := sortColumns[query.Sort]
+	if !known {
+		return nil, fmt.Errorf("unknown sort '%s'", query.Sort)
+	}
+	direction, comparison := "ASC", ">"
+	if query.Order == model.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	conditions := []string{"title ILIKE $1"}
+	args := []any{"%" + likeEscaper.Replace(query.Title) + "%"}
+	if query.After != nil {
+		var afterValue any = query.After.Title
+		if query.Sort == model.ShowSortCreatedAt {
+			afterValue = query.After.CreatedAt
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s ($2, $3)", sortColumn, comparison))
+		args = append(args, afterValue, postgres.Id(query.After.Id))
+	}
+	args = append(args, query.Limit)
+
+	statement := fmt.Sprintf("SELECT id, title, slug, guid, locked, allow_duplicate_episode_titles, created_at FROM show WHERE %s ORDER BY %s %s, id %s LIMIT $%d",
+		strings.Join(conditions, " AND "), sortColumn, direction, direction, len(args))
+	rows, err := adapter.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var guid sql.NullString
+		show := &model.Show{}
+		if err = rows.Scan(&show.Id, &show.Title, &show.Slug, &guid, &show.Locked, &show.AllowDuplicateEpisodeTitles, &show.CreatedAt); err != nil {
+			return nil, err
+		}
+		show.Guid = guid.String
+		show.CreatedAt = show.CreatedAt.UTC()
+		shows = append(shows, show)
+	}
+	return shows, rows.Err()
 }
 
 func parseNextShow(rows *sql.Rows, show *model.Show) (*model.Show, error) {
 	var (
-		showId string
-		title  string
-		slug   string
-		eId    sql.NullString
+		showId      string
+		title       string
+		slug        string
+		guid        sql.NullString
+		locked      bool
+		allow       bool
+		funding     []byte
+		persons     []byte
+		description string
+		language    string
+		author      string
+		imageUrl    string
+		category    string
+		subcategory string
+		explicit    bool
+		created     time.Time
+		eId         sql.NullString
 	)
 
-	if err := rows.Scan(&showId, &title, &slug, &eId); err != nil {
+	if err := rows.Scan(&showId, &title, &slug, &guid, &locked, &allow, &funding, &persons, &description, &language,
+		&author, &imageUrl, &category, &subcategory, &explicit, &created, &eId); err != nil {
 		return nil, err
 	}
 
 	if show == nil {
 		show = &model.Show{
-			Id:    showId,
-			Title: title,
-			Slug:  slug,
+			Id:                          showId,
+			Title:                       title,
+			Slug:                        slug,
+			Guid:                        guid.String,
+			Locked:                      locked,
+			AllowDuplicateEpisodeTitles: allow,
+			Description:                 description,
+			Language:                    language,
+			Author:                      author,
+			ImageUrl:                    imageUrl,
+			Category:                    category,
+			Subcategory:                 subcategory,
+			Explicit:                    explicit,
+			CreatedAt:                   created.UTC(),
+		}
+		if err := column.UnmarshalList(funding, &show.Funding); err != nil {
+			return nil, err
+		}
+		if err := column.UnmarshalList(persons, &show.Persons); err != nil {
+			return nil, err
 		}
 	}
 
@@ -81,6 +306,44 @@ func parseNextShow(rows *sql.Rows, show *model.Show) (*model.Show, error) {
 	return show, nil
 }
 
+// GetShowBySlugOrNil finds a show by its slug, or else by a former slug of it.
+func (adapter *PostgresShowOutAdapter) GetShowBySlugOrNil(ctx context.Context, slug string) (show *model.Show, err error) {
+	defer postgres.TranslateError(&err)
+	var id sql.NullString
+	query := "SELECT COALESCE((SELECT id FROM show WHERE slug = $1), (SELECT show_id FROM show_slug_alias WHERE slug = $1))"
+	if err = adapter.db.QueryRowContext(ctx, query, slug).Scan(&id); err != nil || !id.Valid {
+		return nil, err
+	}
+	return adapter.GetShowOrNil(ctx, id.String)
+}
+
+func (adapter *PostgresShowOutAdapter) GetShowIdsWithoutGuid(ctx context.Context) (showIds []string, err error) {
+	defer postgres.TranslateError(&err)
+	rows, err := adapter.db.QueryContext(ctx, "SELECT id FROM show WHERE guid IS NULL OR guid = '' ORDER BY id;")
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var showId string
+		if err = rows.Scan(&showId); err != nil {
+			return nil, err
+		}
+		showIds = append(showIds, showId)
+	}
+	return showIds, rows.Err()
+}
+
+// SetShowGuidIfMissing leaves shows which were deleted or got a guid in the meantime unchanged.
+func (adapter *PostgresShowOutAdapter) SetShowGuidIfMissing(ctx context.Context, id string, guid string) (err error) {
+	defer postgres.TranslateError(&err)
+	_, err = adapter.db.ExecContext(ctx, "UPDATE show SET guid = $2 WHERE id = $1 AND (guid IS NULL OR guid = '');", postgres.Id(id), guid)
+	return err
+}
+
 func NewPostgresShowRepository(db *sql.DB) *PostgresShowOutAdapter {
 	return &PostgresShowOutAdapter{db: db}
 }
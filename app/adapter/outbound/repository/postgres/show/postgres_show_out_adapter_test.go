@@ -1,14 +1,18 @@
 package show
 
 import (
+	"context"
+	"database/sql"
 	repositoryEpisode "podGopher/adapter/outbound/repository/postgres/episode"
 	"podGopher/adapter/outbound/repository/postgres/postgresTestSetup"
 	"podGopher/core/domain/model"
 	"podGopher/core/port/outbound"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_should_implement_port(t *testing.T) {
@@ -16,6 +20,9 @@ func Test_should_implement_port(t *testing.T) {
 
 	assert.NotNil(t, repository)
 	assert.Implements(t, (*outbound.SaveShowPort)(nil), repository)
+	assert.Implements(t, (*outbound.ListShowsPort)(nil), repository)
+	assert.Implements(t, (*outbound.UpdateShowPort)(nil), repository)
+	assert.Implements(t, (*outbound.DeleteShowPort)(nil), repository)
 }
 
 func Test_should_save_a_show(t *testing.T) {
@@ -34,32 +41,37 @@ func Test_should_save_a_show(t *testing.T) {
 	}
 
 	t.Run("should return false if show with title or slug does not exist", func(t *testing.T) {
-		exists := repository.ExistsByTitleOrSlug(showTitle, showSlug)
+		exists, err := repository.ExistsByTitleOrSlug(t.Context(), showTitle, showSlug)
+		assert.Nil(t, err)
 		assert.False(t, exists)
 	})
 
 	t.Run("should save a show", func(t *testing.T) {
-		err := repository.SaveShow(show)
+		err := repository.SaveShow(t.Context(), show)
 		assert.Nil(t, err)
 	})
 
 	t.Run("should return true if show with title exists", func(t *testing.T) {
-		exists := repository.ExistsByTitleOrSlug(showTitle, "some-other-slug")
+		exists, err := repository.ExistsByTitleOrSlug(t.Context(), showTitle, "some-other-slug")
+		assert.Nil(t, err)
 		assert.True(t, exists)
 	})
 
 	t.Run("should return true if show with slug exists", func(t *testing.T) {
-		exists := repository.ExistsByTitleOrSlug("some-other-title", showSlug)
+		exists, err := repository.ExistsByTitleOrSlug(t.Context(), "some-other-title", showSlug)
+		assert.Nil(t, err)
 		assert.True(t, exists)
 	})
 
 	t.Run("should return true if show with title and slug exists", func(t *testing.T) {
-		exists := repository.ExistsByTitleOrSlug(showTitle, showSlug)
+		exists, err := repository.ExistsByTitleOrSlug(t.Context(), showTitle, showSlug)
+		assert.Nil(t, err)
 		assert.True(t, exists)
 	})
 
 	t.Run("should return false if show with title or slug does not exists", func(t *testing.T) {
-		exists := repository.ExistsByTitleOrSlug("some-other-title", "some-other-slug")
+		exists, err := repository.ExistsByTitleOrSlug(t.Context(), "some-other-title", "some-other-slug")
+		assert.Nil(t, err)
 		assert.False(t, exists)
 	})
 
@@ -67,7 +79,7 @@ func Test_should_save_a_show(t *testing.T) {
 		var id string
 		var title string
 		var slug string
-		err := db.QueryRow("SELECT * FROM show WHERE id = $1", show.Id).
+		err := db.QueryRow("SELECT id, title, slug FROM show WHERE id = $1", show.Id).
 			Scan(&id, &title, &slug)
 		if err != nil {
 			t.Fatal(err)
@@ -86,28 +98,41 @@ func Test_should_retrieve_a_show(t *testing.T) {
 
 	repository := NewPostgresShowRepository(db)
 	show := &model.Show{
-		Id:    uuid.NewString(),
-		Title: "Some title",
-		Slug:  ("Some title") + "-Slug",
+		Id:      uuid.NewString(),
+		Title:   "Some title",
+		Slug:    ("Some title") + "-Slug",
+		Guid:    uuid.NewString(),
+		Locked:  true,
+		Funding: []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons: []model.Person{{Name: "some host", Role: "host", Href: "https://example.com"}},
 	}
 
-	err := repository.SaveShow(show)
+	err := repository.SaveShow(t.Context(), show)
 	assert.Nil(t, err)
 
 	t.Run("should return nil if show does not exist", func(t *testing.T) {
-		foundShow, err := repository.GetShowOrNil(uuid.NewString())
+		foundShow, err := repository.GetShowOrNil(t.Context(), uuid.NewString())
 		assert.Nil(t, err)
 		assert.Nil(t, foundShow)
 	})
 
 	t.Run("should retrieve a show", func(t *testing.T) {
-		foundShow, err := repository.GetShowOrNil(show.Id)
+		foundShow, err := repository.GetShowOrNil(t.Context(), show.Id)
 		assert.Nil(t, err)
 		assert.NotNil(t, foundShow)
 		assert.Equal(t, show.Id, foundShow.Id)
 		assert.Empty(t, foundShow.Episodes)
 	})
 
+	t.Run("should retrieve podcast namespace values of a show", func(t *testing.T) {
+		foundShow, err := repository.GetShowOrNil(t.Context(), show.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, show.Guid, foundShow.Guid)
+		assert.True(t, foundShow.Locked)
+		assert.Equal(t, show.Funding, foundShow.Funding)
+		assert.Equal(t, show.Persons, foundShow.Persons)
+	})
+
 }
 
 func Test_should_reference_episodes(t *testing.T) {
@@ -129,28 +154,28 @@ func Test_should_reference_episodes(t *testing.T) {
 		Slug:  "show-Slug",
 	}
 
-	err := showRepository.SaveShow(showWithEpisodes)
+	err := showRepository.SaveShow(t.Context(), showWithEpisodes)
 	assert.Nil(t, err)
 
-	err = showRepository.SaveShow(showWithoutEpisodes)
+	err = showRepository.SaveShow(t.Context(), showWithoutEpisodes)
 	assert.Nil(t, err)
 
-	err = episodeRepository.SaveEpisode(&model.Episode{
+	err = episodeRepository.SaveEpisode(t.Context(), &model.Episode{
 		Id:     uuid.NewString(),
 		ShowId: showWithEpisodes.Id,
 		Title:  "first episode",
 	})
 	assert.Nil(t, err)
 
-	err = episodeRepository.SaveEpisode(&model.Episode{
+	err = episodeRepository.SaveEpisode(t.Context(), &model.Episode{
 		Id:     uuid.NewString(),
 		ShowId: showWithEpisodes.Id,
-		Title:  "first episode",
+		Title:  "second episode",
 	})
 	assert.Nil(t, err)
 
 	t.Run("should retrieve a show with episodes", func(t *testing.T) {
-		foundShow, err := showRepository.GetShowOrNil(showWithEpisodes.Id)
+		foundShow, err := showRepository.GetShowOrNil(t.Context(), showWithEpisodes.Id)
 
 		assert.Nil(t, err)
 		assert.NotNil(t, foundShow)
@@ -158,10 +183,158 @@ func Test_should_reference_episodes(t *testing.T) {
 	})
 
 	t.Run("should not retrieve non-referenced episodes", func(t *testing.T) {
-		foundShow, err := showRepository.GetShowOrNil(showWithoutEpisodes.Id)
+		foundShow, err := showRepository.GetShowOrNil(t.Context(), showWithoutEpisodes.Id)
 
 		assert.Nil(t, err)
 		assert.NotNil(t, foundShow)
 		assert.Len(t, foundShow.Episodes, 0)
 	})
 }
+
+func Test_should_list_shows(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	repository := NewPostgresShowRepository(db)
+	var shows []*model.Show
+	for _, title := range []string{"Beta", "Alpha", "Gamma 100%"} {
+		show := &model.Show{Id: uuid.NewString(), Title: title, Slug: title + "-Slug"}
+		assert.Nil(t, repository.SaveShow(t.Context(), show))
+		shows = append(shows, show)
+	}
+
+	titlesOf := func(shows []*model.Show) []string {
+		var titles []string
+		for _, show := range shows {
+			titles = append(titles, show.Title)
+		}
+		return titles
+	}
+
+	t.Run("should list shows by title", func(t *testing.T) {
+		found, err := repository.ListShows(t.Context(), &model.ShowQuery{Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Alpha", "Beta", "Gamma 100%"}, titlesOf(found))
+		assert.False(t, found[0].CreatedAt.IsZero())
+	})
+
+	t.Run("should list shows by creation time descending", func(t *testing.T) {
+		found, err := repository.ListShows(t.Context(), &model.ShowQuery{Sort: model.ShowSortCreatedAt, Order: model.Descending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Gamma 100%", "Alpha", "Beta"}, titlesOf(found))
+	})
+
+	t.Run("should continue after key", func(t *testing.T) {
+		found, err := repository.ListShows(t.Context(), &model.ShowQuery{Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 1,
+			After: &model.ShowKey{Id: shows[1].Id, Title: shows[1].Title}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Beta"}, titlesOf(found))
+	})
+
+	t.Run("should filter by title", func(t *testing.T) {
+		found, err := repository.ListShows(t.Context(), &model.ShowQuery{Title: "A", Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Alpha", "Beta", "Gamma 100%"}, titlesOf(found))
+
+		found, err = repository.ListShows(t.Context(), &model.ShowQuery{Title: "0%", Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Gamma 100%"}, titlesOf(found))
+
+		found, err = repository.ListShows(t.Context(), &model.ShowQuery{Title: "a_", Sort: model.ShowSortTitle, Order: model.Ascending, Limit: 10})
+		assert.Nil(t, err)
+		assert.Empty(t, found)
+	})
+}
+
+func Test_should_update_a_show(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	repository := NewPostgresShowRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	otherShow := &model.Show{Id: uuid.NewString(), Title: "Other title", Slug: "Other-Slug"}
+	assert.Nil(t, repository.SaveShow(t.Context(), show))
+	assert.Nil(t, repository.SaveShow(t.Context(), otherShow))
+
+	t.Run("should not count the show itself as existing", func(t *testing.T) {
+		exists, err := repository.ExistsOtherByTitleOrSlug(t.Context(), show.Id, show.Title, show.Slug)
+		assert.Nil(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("should return true if other show with title or slug exists", func(t *testing.T) {
+		exists, err := repository.ExistsOtherByTitleOrSlug(t.Context(), show.Id, otherShow.Title, "some-other-slug")
+		assert.Nil(t, err)
+		assert.True(t, exists)
+
+		exists, err = repository.ExistsOtherByTitleOrSlug(t.Context(), show.Id, "some-other-title", otherShow.Slug)
+		assert.Nil(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should update a show", func(t *testing.T) {
+		show.Title = "Changed title"
+		show.Locked = true
+		show.Persons = []model.Person{{Name: "some host", Role: "host"}}
+
+		err := repository.UpdateShow(t.Context(), show)
+		assert.Nil(t, err)
+
+		foundShow, err := repository.GetShowOrNil(t.Context(), show.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, "Changed title", foundShow.Title)
+		assert.True(t, foundShow.Locked)
+		assert.Equal(t, show.Persons, foundShow.Persons)
+	})
+}
+
+func Test_should_delete_a_show_with_its_episodes(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	repository := NewPostgresShowRepository(db)
+	episodeRepository := repositoryEpisode.NewPostgresEpisodeRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	episode := &model.Episode{Id: uuid.NewString(), ShowId: show.Id, Title: "Some episode"}
+	assert.Nil(t, repository.SaveShow(t.Context(), show))
+	assert.Nil(t, episodeRepository.SaveEpisode(t.Context(), episode))
+
+	err := repository.DeleteShow(t.Context(), show.Id)
+	assert.Nil(t, err)
+
+	foundShow, _ := repository.GetShowOrNil(t.Context(), show.Id)
+	assert.Nil(t, foundShow)
+	foundEpisode, _ := episodeRepository.GetEpisodeOrNil(t.Context(), episode.Id)
+	assert.Nil(t, foundEpisode)
+}
+
+func Test_should_abort_a_waiting_query_if_the_context_is_done(t *testing.T) {
+	db := postgresTestSetup.StartTestcontainersPostgres(t, "../postgresTestSetup/")
+
+	defer postgresTestSetup.Teardown(t, db)
+
+	repository := NewPostgresShowRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	require.Nil(t, repository.SaveShow(t.Context(), show))
+
+	// a concurrent transaction locks the table until the end of the test, so reading the show waits for it
+	locking, err := db.BeginTx(t.Context(), nil)
+	require.Nil(t, err)
+	defer func(locking *sql.Tx) {
+		_ = locking.Rollback()
+	}(locking)
+	_, err = locking.ExecContext(t.Context(), "LOCK TABLE show IN ACCESS EXCLUSIVE MODE;")
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 200*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	foundShow, err := repository.GetShowOrNil(ctx, show.Id)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, foundShow)
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
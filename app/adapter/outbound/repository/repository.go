@@ -0,0 +1,86 @@
+// Package repository groups the outbound ports which each storage of shows, episodes and downloads implements.
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/port/outbound"
+)
+
+type ShowRepository interface {
+	outbound.SaveShowPort
+	outbound.GetShowPort
+	outbound.GetShowBySlugPort
+	outbound.ListShowsPort
+	outbound.UpdateShowPort
+	outbound.DeleteShowPort
+	outbound.BackfillShowGuidsPort
+}
+
+type EpisodeRepository interface {
+	outbound.SaveEpisodePort
+	outbound.GetEpisodePort
+	outbound.GetShowEpisodesPort
+	outbound.ListEpisodesPort
+	outbound.SaveEpisodeMediaPort
+	outbound.UpdateEpisodePort
+	outbound.DeleteEpisodePort
+	outbound.ChangeEpisodeStatusPort
+}
+
+type DownloadRepository interface {
+	outbound.RecordDownloadPort
+	outbound.GetDownloadsPort
+}
+
+type JobRepository interface {
+	outbound.EnqueueJobPort
+	outbound.GetJobPort
+	outbound.ClaimJobPort
+	outbound.FinishJobPort
+}
+
+// Repositories share one storage, so episodes relate to the shows of the show repository.
+type Repositories struct {
+	Shows     ShowRepository
+	Episodes  EpisodeRepository
+	Downloads DownloadRepository
+	Jobs      JobRepository
+}
+
+// NotStoredError reports an entity which a repository was asked to change, but does not hold.
+func NotStoredError(entity string, id string) error {
+	return error2.NewRepositoryError(error2.NotFound, fmt.Errorf("%s '%s' is not stored", entity, id))
+}
+
+// StatusChangedError reports an entity whose status changed since the caller read it.
+func StatusChangedError(entity string, id string) error {
+	return error2.NewRepositoryError(error2.SerializationFailure, fmt.Errorf("status of %s '%s' changed concurrently", entity, id))
+}
+
+// RequireAffectedRows turns a statement which changed no row into a NotStoredError, so a concurrently deleted
+// entity does not pass for a successful change.
+func RequireAffectedRows(result sql.Result, entity string, id string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return NotStoredError(entity, id)
+	}
+	return nil
+}
+
+// RequireClaimedJob turns a statement on a claimed job which changed no row into a StatusChangedError, since
+// another worker took the job over once the lease of the caller ended.
+func RequireClaimedJob(result sql.Result, id string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return StatusChangedError("job", id)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package sqlite_test
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/contract"
+	"podGopher/adapter/outbound/repository/sqlite/download"
+	"podGopher/adapter/outbound/repository/sqlite/episode"
+	"podGopher/adapter/outbound/repository/sqlite/job"
+	"podGopher/adapter/outbound/repository/sqlite/show"
+	"podGopher/adapter/outbound/repository/sqlite/sqliteTestSetup"
+	"testing"
+)
+
+func Test_sqlite_repositories_should_fulfill_contract(t *testing.T) {
+	db := sqliteTestSetup.OpenTestDatabase(t)
+
+	contract.Run(t, &repository.Repositories{
+		Shows:     show.NewSqliteShowRepository(db),
+		Episodes:  episode.NewSqliteEpisodeRepository(db),
+		Downloads: download.NewSqliteDownloadRepository(db),
+		Jobs:      job.NewSqliteJobRepository(db),
+	})
+}
@@ -0,0 +1,55 @@
+package download
+
+import (
+	"context"
+	"database/sql"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/sqlite"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+const downloadColumns = "show_id, episode_id, media_key, method, ip_hash, user_agent, referrer, range_start, range_end, requested_at"
+
+type SqliteDownloadOutAdapter struct {
+	db *sql.DB
+}
+
+func (adapter *SqliteDownloadOutAdapter) RecordDownload(ctx context.Context, event *model.DownloadEvent) (err error) {
+	defer sqlite.TranslateError(&err)
+	_, err = adapter.db.ExecContext(ctx, "INSERT INTO download ("+downloadColumns+") VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10);",
+		event.ShowId, event.EpisodeId, event.MediaKey, event.Method, event.IpHash, event.UserAgent,
+		event.Referrer, event.RangeStart, event.RangeEnd, column.FormatTextTime(event.RequestedAt))
+	return err
+}
+
+// GetDownloadsOfShow returns the downloads requested in [from, to).
+func (adapter *SqliteDownloadOutAdapter) GetDownloadsOfShow(ctx context.Context, showId string, from time.Time, to time.Time) (downloads []*model.DownloadEvent, err error) {
+	defer sqlite.TranslateError(&err)
+	query := "SELECT " + downloadColumns + " FROM download WHERE show_id = ?1 AND requested_at >= ?2 AND requested_at < ?3 ORDER BY requested_at"
+	rows, err := adapter.db.QueryContext(ctx, query, showId, column.FormatTextTime(from), column.FormatTextTime(to))
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var requestedAt sql.NullString
+		event := &model.DownloadEvent{}
+		if err = rows.Scan(&event.ShowId, &event.EpisodeId, &event.MediaKey, &event.Method, &event.IpHash, &event.UserAgent,
+			&event.Referrer, &event.RangeStart, &event.RangeEnd, &requestedAt); err != nil {
+			return nil, err
+		}
+		if event.RequestedAt, err = column.ParseTextTime(requestedAt); err != nil {
+			return nil, err
+		}
+		downloads = append(downloads, event)
+	}
+	return downloads, rows.Err()
+}
+
+func NewSqliteDownloadRepository(db *sql.DB) *SqliteDownloadOutAdapter {
+	return &SqliteDownloadOutAdapter{db: db}
+}
@@ -0,0 +1,15 @@
+package download
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_download_repository_should_implement_port(t *testing.T) {
+	adapter := NewSqliteDownloadRepository(nil)
+
+	assert.NotNil(t, adapter)
+	assert.Implements(t, (*repository.DownloadRepository)(nil), adapter)
+}
@@ -0,0 +1,30 @@
+package episode
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/sqlite/sqliteTestSetup"
+	"podGopher/core/domain/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_episode_repository_should_implement_port(t *testing.T) {
+	adapter := NewSqliteEpisodeRepository(nil)
+
+	assert.NotNil(t, adapter)
+	assert.Implements(t, (*repository.EpisodeRepository)(nil), adapter)
+}
+
+func Test_should_enforce_foreign_keys(t *testing.T) {
+	db := sqliteTestSetup.OpenTestDatabase(t)
+	repository := NewSqliteEpisodeRepository(db)
+
+	err := repository.SaveEpisode(t.Context(), &model.Episode{Id: uuid.NewString(), ShowId: uuid.NewString(), Title: "test-Title"})
+
+	assert.NotNil(t, err)
+	var episodes int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM episode").Scan(&episodes))
+	assert.Equal(t, 0, episodes)
+}
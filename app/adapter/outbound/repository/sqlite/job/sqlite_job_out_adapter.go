@@ -0,0 +1,122 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/sqlite"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+const jobColumns = "id, type, unique_key, payload, status, attempts, max_attempts, run_at, last_error"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type SqliteJobOutAdapter struct {
+	db *sql.DB
+}
+
+// EnqueueJob only skips a job whose unique key is taken, a taken id still fails with a constraint violation.
+func (adapter *SqliteJobOutAdapter) EnqueueJob(ctx context.Context, job *model.Job) (queued bool, err error) {
+	defer sqlite.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "INSERT INTO job ("+jobColumns+") VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9) "+
+		"ON CONFLICT (unique_key) WHERE status IN ('pending', 'running') DO NOTHING;",
+		job.Id, job.Type, column.NullString(job.UniqueKey), string(job.Payload), job.Status, job.Attempts, job.MaxAttempts,
+		column.FormatTextTime(job.RunAt), job.LastError)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func (adapter *SqliteJobOutAdapter) GetJobOrNil(ctx context.Context, id string) (job *model.Job, err error) {
+	defer sqlite.TranslateError(&err)
+	row := adapter.db.QueryRowContext(ctx, "SELECT "+jobColumns+" FROM job WHERE id = ?1", id)
+
+	if job, err = scanJob(row); errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return job, err
+}
+
+// ClaimJob needs no row locks, since SQLite runs one writing statement at a time. The types are passed as JSON
+// array, as SQLite has no array parameters.
+func (adapter *SqliteJobOutAdapter) ClaimJob(ctx context.Context, types []string, now time.Time, lease time.Duration) (job *model.Job, err error) {
+	defer sqlite.TranslateError(&err)
+	jobTypes, err := column.MarshalList(types)
+	if err != nil {
+		return nil, err
+	}
+	query := `UPDATE job SET status = ?1, attempts = attempts + 1, run_at = ?2
+		WHERE id = (
+			SELECT id FROM job WHERE status <> ?3 AND run_at <= ?4 AND type IN (SELECT value FROM json_each(?5))
+			ORDER BY run_at, id LIMIT 1
+		)
+		RETURNING ` + jobColumns
+	row := adapter.db.QueryRowContext(ctx, query, model.JobRunning, column.FormatTextTime(now.Add(lease)), model.JobDead,
+		column.FormatTextTime(now), jobTypes)
+
+	if job, err = scanJob(row); errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return job, err
+}
+
+func (adapter *SqliteJobOutAdapter) CompleteJob(ctx context.Context, job *model.Job) (err error) {
+	defer sqlite.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "DELETE FROM job WHERE id = ?1 AND status = ?2 AND attempts = ?3;",
+		job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func (adapter *SqliteJobOutAdapter) RetryJob(ctx context.Context, job *model.Job) (err error) {
+	defer sqlite.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "UPDATE job SET status = ?1, run_at = ?2, last_error = ?3 WHERE id = ?4 AND status = ?5 AND attempts = ?6;",
+		model.JobPending, column.FormatTextTime(job.RunAt), job.LastError, job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func (adapter *SqliteJobOutAdapter) BuryJob(ctx context.Context, job *model.Job) (err error) {
+	defer sqlite.TranslateError(&err)
+	result, err := adapter.db.ExecContext(ctx, "UPDATE job SET status = ?1, last_error = ?2 WHERE id = ?3 AND status = ?4 AND attempts = ?5;",
+		model.JobDead, job.LastError, job.Id, model.JobRunning, job.Attempts)
+	if err != nil {
+		return err
+	}
+	return repository.RequireClaimedJob(result, job.Id)
+}
+
+func scanJob(row rowScanner) (*model.Job, error) {
+	var (
+		job       = &model.Job{}
+		uniqueKey sql.NullString
+		payload   string
+		runAt     sql.NullString
+		err       error
+	)
+	if err = row.Scan(&job.Id, &job.Type, &uniqueKey, &payload, &job.Status, &job.Attempts, &job.MaxAttempts, &runAt, &job.LastError); err != nil {
+		return nil, err
+	}
+	job.UniqueKey = uniqueKey.String
+	job.Payload = []byte(payload)
+	if job.RunAt, err = column.ParseTextTime(runAt); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+func NewSqliteJobRepository(db *sql.DB) *SqliteJobOutAdapter {
+	return &SqliteJobOutAdapter{db: db}
+}
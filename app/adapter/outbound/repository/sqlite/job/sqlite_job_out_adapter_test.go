@@ -0,0 +1,15 @@
+package job
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_job_repository_should_implement_port(t *testing.T) {
+	adapter := NewSqliteJobRepository(nil)
+
+	assert.NotNil(t, adapter)
+	assert.Implements(t, (*repository.JobRepository)(nil), adapter)
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS download;
+DROP TABLE IF EXISTS show_episodes;
+DROP TABLE IF EXISTS episode;
+DROP TABLE IF EXISTS show;
@@ -0,0 +1,72 @@
+-- the schema of the Postgres migrations up to 000008, with times as text of column.FormatTextTime
+CREATE TABLE IF NOT EXISTS show
+(
+    id         text primary key not null,
+    title      text             not null,
+    slug       text             not null,
+    guid       text,
+    locked     boolean          not null default false,
+    funding    text             not null default '[]',
+    persons    text             not null default '[]',
+    created_at text             not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_show_title_id on show (title, id);
+CREATE INDEX IF NOT EXISTS idx_show_created_at_id on show (created_at, id);
+
+CREATE TABLE IF NOT EXISTS episode
+(
+    id                 text primary key not null,
+    show_id            text             not null references show (id),
+    title              text             not null,
+    season             integer,
+    episode_number     integer,
+    transcripts        text             not null default '[]',
+    chapters_url       text,
+    chapters_type      text,
+    persons            text             not null default '[]',
+    status             text             not null default 'published',
+    published_at       text,
+    media_key          text,
+    media_file_name    text,
+    media_type         text,
+    media_size         integer,
+    media_duration_ms  integer,
+    media_bitrate      integer,
+    media_sample_rate  integer,
+    media_channels     integer,
+    media_title        text,
+    media_artwork_key  text,
+    media_artwork_type text,
+    media_chapters     text             not null default '[]'
+);
+
+CREATE INDEX IF NOT EXISTS idx_episode_show_id_published_at on episode (show_id, published_at, id);
+
+CREATE TABLE IF NOT EXISTS show_episodes
+(
+    show_id    text not null references show (id),
+    episode_id text not null references episode (id),
+
+    constraint show_episode_unique unique (show_id, episode_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_show_episodes_show_id on show_episodes (show_id);
+
+-- raw requests of episode media, kept independent of shows and episodes to preserve the history
+CREATE TABLE IF NOT EXISTS download
+(
+    id           integer primary key autoincrement,
+    show_id      text    not null,
+    episode_id   text    not null,
+    media_key    text    not null,
+    method       text    not null,
+    ip_hash      text    not null,
+    user_agent   text    not null,
+    referrer     text    not null,
+    range_start  integer not null,
+    range_end    integer not null,
+    requested_at text    not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_show_id_requested_at on download (show_id, requested_at);
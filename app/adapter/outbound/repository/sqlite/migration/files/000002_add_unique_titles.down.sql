@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS episode_title_unique;
+DROP INDEX IF EXISTS show_slug_unique;
+DROP INDEX IF EXISTS show_title_unique;
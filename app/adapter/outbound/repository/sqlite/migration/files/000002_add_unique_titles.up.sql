@@ -0,0 +1,20 @@
+-- the Postgres migration 000009
+UPDATE show
+SET title = substr(title, 1, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY created_at, id) AS occurrence FROM show)
+             WHERE occurrence > 1);
+UPDATE show
+SET slug = rtrim(substr(slug, 1, 218), '-') || '-' || id
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY slug ORDER BY created_at, id) AS occurrence FROM show)
+             WHERE occurrence > 1);
+UPDATE episode
+SET title = substr(title, 1, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY id) AS occurrence FROM episode)
+             WHERE occurrence > 1);
+
+CREATE UNIQUE INDEX IF NOT EXISTS show_title_unique on show (title);
+CREATE UNIQUE INDEX IF NOT EXISTS show_slug_unique on show (slug);
+CREATE UNIQUE INDEX IF NOT EXISTS episode_title_unique on episode (title);
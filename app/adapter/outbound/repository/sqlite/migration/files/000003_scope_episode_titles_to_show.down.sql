@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS episode_show_id_title_unique;
+
+-- like the Postgres migration 000010, duplicates get their id appended before titles are unique across shows again
+UPDATE episode
+SET title = substr(title, 1, 216) || ' (' || id || ')'
+WHERE id IN (SELECT id
+             FROM (SELECT id, row_number() OVER (PARTITION BY title ORDER BY id) AS occurrence FROM episode)
+             WHERE occurrence > 1);
+CREATE UNIQUE INDEX IF NOT EXISTS episode_title_unique on episode (title);
+
+ALTER TABLE episode DROP COLUMN unique_title;
+ALTER TABLE show DROP COLUMN allow_duplicate_episode_titles;
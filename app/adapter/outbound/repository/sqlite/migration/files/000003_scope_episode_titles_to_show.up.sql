@@ -0,0 +1,6 @@
+-- the Postgres migration 000010
+ALTER TABLE show ADD COLUMN allow_duplicate_episode_titles boolean not null default false;
+ALTER TABLE episode ADD COLUMN unique_title boolean not null default true;
+
+DROP INDEX IF EXISTS episode_title_unique;
+CREATE UNIQUE INDEX IF NOT EXISTS episode_show_id_title_unique on episode (show_id, title) WHERE unique_title;
@@ -0,0 +1,8 @@
+-- the Postgres migration 000011
+CREATE TABLE IF NOT EXISTS show_slug_alias
+(
+    slug    text primary key not null,
+    show_id text             not null references show (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_show_slug_alias_show_id on show_slug_alias (show_id);
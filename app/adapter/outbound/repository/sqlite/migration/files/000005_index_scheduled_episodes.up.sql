@@ -0,0 +1,2 @@
+-- the Postgres migration 000012
+CREATE INDEX IF NOT EXISTS idx_episode_scheduled_published_at on episode (published_at) WHERE status = 'scheduled';
@@ -0,0 +1,14 @@
+-- the Postgres migration 000013
+CREATE TABLE IF NOT EXISTS job
+(
+    id           text primary key not null,
+    type         text             not null,
+    payload      text             not null,
+    status       text             not null,
+    attempts     integer          not null default 0,
+    max_attempts integer          not null,
+    run_at       text             not null,
+    last_error   text             not null default ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_due_run_at on job (run_at) WHERE status <> 'dead';
@@ -0,0 +1 @@
+ALTER TABLE episode DROP COLUMN description;
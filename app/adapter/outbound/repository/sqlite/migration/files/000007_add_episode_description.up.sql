@@ -0,0 +1,2 @@
+-- the Postgres migration 000014
+ALTER TABLE episode ADD COLUMN description text not null default '';
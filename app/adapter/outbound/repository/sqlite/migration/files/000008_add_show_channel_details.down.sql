@@ -0,0 +1,7 @@
+ALTER TABLE show DROP COLUMN explicit;
+ALTER TABLE show DROP COLUMN subcategory;
+ALTER TABLE show DROP COLUMN category;
+ALTER TABLE show DROP COLUMN image_url;
+ALTER TABLE show DROP COLUMN author;
+ALTER TABLE show DROP COLUMN language;
+ALTER TABLE show DROP COLUMN description;
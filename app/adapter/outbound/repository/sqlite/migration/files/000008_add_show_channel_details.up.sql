@@ -0,0 +1,8 @@
+-- the Postgres migration 000015
+ALTER TABLE show ADD COLUMN description text not null default '';
+ALTER TABLE show ADD COLUMN language text not null default '';
+ALTER TABLE show ADD COLUMN author text not null default '';
+ALTER TABLE show ADD COLUMN image_url text not null default '';
+ALTER TABLE show ADD COLUMN category text not null default '';
+ALTER TABLE show ADD COLUMN subcategory text not null default '';
+ALTER TABLE show ADD COLUMN explicit boolean not null default false;
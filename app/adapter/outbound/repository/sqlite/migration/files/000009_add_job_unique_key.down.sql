@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS job_unique_key_unique;
+ALTER TABLE job DROP COLUMN unique_key;
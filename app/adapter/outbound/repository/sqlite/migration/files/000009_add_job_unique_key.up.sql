@@ -0,0 +1,4 @@
+-- the Postgres migration 000016
+ALTER TABLE job ADD COLUMN unique_key text;
+
+CREATE UNIQUE INDEX IF NOT EXISTS job_unique_key_unique on job (unique_key) WHERE status IN ('pending', 'running');
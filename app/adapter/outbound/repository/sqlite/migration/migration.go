@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/env"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// files are embedded, so a single binary carries its schema.
+//
+//go:embed files/*.sql
+var files embed.FS
+
+type Migration struct {
+	migrate       *migrate.Migrate
+	latestVersion uint
+}
+
+// NewMigration migrates the database of db. The migration does not close db, since an in-memory
+// database is gone with its last connection.
+func NewMigration(db *sql.DB) (*Migration, error) {
+	source, err := iofs.New(files, "files")
+	if err != nil {
+		return nil, err
+	}
+	latestVersion, err := repository.LatestMigrationVersion(source)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := sqlite.WithInstance(db, &sqlite.Config{})
+	if err != nil {
+		return nil, err
+	}
+	m, err := migrate.NewWithInstance("iofs", source, "sqlite", driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Migration{m, latestVersion}, nil
+}
+
+// GetSqliteConnectionString enforces foreign keys like Postgres does, and waits for concurrent writers
+// instead of failing. Unlike a query, the wait for a concurrent writer is not cut short by a cancelled context.
+func GetSqliteConnectionString() string {
+	return fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", env.SqlitePath.GetValue())
+}
+
+func (m *Migration) Migrate() error {
+	if err := m.migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+func (m *Migration) Version() (uint, bool, error) {
+	version, dirty, err := m.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+func (m *Migration) LatestVersion() uint {
+	return m.latestVersion
+}
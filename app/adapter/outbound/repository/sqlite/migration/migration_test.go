@@ -0,0 +1,122 @@
+package migration
+
+import (
+	"path/filepath"
+	"podGopher/adapter/outbound/repository/sqlite"
+	"podGopher/env"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_should_migrate_a_database_again_without_changes(t *testing.T) {
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	db, err := sqlite.Open(GetSqliteConnectionString())
+	assert.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	for range 2 {
+		m, err := NewMigration(db)
+		assert.Nil(t, err)
+		assert.Nil(t, m.Migrate())
+	}
+
+	var foreignKeys bool
+	assert.Nil(t, db.QueryRow("PRAGMA foreign_keys").Scan(&foreignKeys))
+	assert.True(t, foreignKeys)
+}
+
+func Test_should_report_the_latest_version_after_migration(t *testing.T) {
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	db, err := sqlite.Open(GetSqliteConnectionString())
+	assert.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	m, err := NewMigration(db)
+	assert.Nil(t, err)
+
+	version, dirty, err := m.Version()
+	assert.Nil(t, err)
+	assert.Equal(t, uint(0), version)
+	assert.False(t, dirty)
+
+	assert.Nil(t, m.Migrate())
+
+	version, dirty, err = m.Version()
+	assert.Nil(t, err)
+	assert.Equal(t, uint(9), m.LatestVersion())
+	assert.Equal(t, m.LatestVersion(), version)
+	assert.False(t, dirty)
+}
+
+func Test_should_rename_duplicate_titles_and_slugs_before_enforcing_uniqueness(t *testing.T) {
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	db, err := sqlite.Open(GetSqliteConnectionString())
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	m, err := NewMigration(db)
+	require.Nil(t, err)
+	require.Nil(t, m.migrate.Steps(1))
+	_, err = db.Exec(`INSERT INTO show (id, title, slug, created_at) VALUES
+		('show-1', 'Title', 'slug', '2024-01-01'), ('show-2', 'Title', 'slug', '2024-01-02'), ('show-3', 'Other', 'other', '2024-01-03');
+		INSERT INTO episode (id, show_id, title) VALUES
+		('episode-1', 'show-1', 'Trailer'), ('episode-2', 'show-1', 'Trailer'), ('episode-3', 'show-3', 'Trailer')`)
+	require.Nil(t, err)
+
+	assert.Nil(t, m.Migrate())
+
+	var title, slug string
+	require.Nil(t, db.QueryRow("SELECT title, slug FROM show WHERE id = 'show-1'").Scan(&title, &slug))
+	assert.Equal(t, []string{"Title", "slug"}, []string{title, slug})
+	require.Nil(t, db.QueryRow("SELECT title, slug FROM show WHERE id = 'show-2'").Scan(&title, &slug))
+	assert.Equal(t, []string{"Title (show-2)", "slug-show-2"}, []string{title, slug})
+	var titles []string
+	rows, err := db.Query("SELECT title FROM episode ORDER BY id")
+	require.Nil(t, err)
+	defer func() {
+		_ = rows.Close()
+	}()
+	for rows.Next() {
+		require.Nil(t, rows.Scan(&title))
+		titles = append(titles, title)
+	}
+	assert.Equal(t, []string{"Trailer", "Trailer (episode-2)", "Trailer (episode-3)"}, titles)
+}
+
+func Test_should_rename_duplicate_titles_of_shows_when_migrating_down_to_unique_titles(t *testing.T) {
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	db, err := sqlite.Open(GetSqliteConnectionString())
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	m, err := NewMigration(db)
+	require.Nil(t, err)
+	require.Nil(t, m.migrate.Steps(3))
+	_, err = db.Exec(`INSERT INTO show (id, title, slug, created_at) VALUES ('show-1', 'Title', 'slug', '2024-01-01'), ('show-2', 'Other', 'other', '2024-01-02');
+		INSERT INTO episode (id, show_id, title) VALUES ('episode-1', 'show-1', 'Trailer'), ('episode-2', 'show-2', 'Trailer')`)
+	require.Nil(t, err)
+
+	assert.Nil(t, m.migrate.Steps(-1))
+
+	var titles []string
+	rows, err := db.Query("SELECT title FROM episode ORDER BY id")
+	require.Nil(t, err)
+	defer func() {
+		_ = rows.Close()
+	}()
+	for rows.Next() {
+		var title string
+		require.Nil(t, rows.Scan(&title))
+		titles = append(titles, title)
+	}
+	assert.Equal(t, []string{"Trailer", "Trailer (episode-2)"}, titles)
+	_, err = db.Exec("INSERT INTO episode (id, show_id, title) VALUES ('episode-3', 'show-2', 'Trailer')")
+	assert.NotNil(t, err, "titles are not unique across shows")
+}
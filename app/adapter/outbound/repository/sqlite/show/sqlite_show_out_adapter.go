@@ -0,0 +1,323 @@
+package show
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/column"
+	"podGopher/adapter/outbound/repository/sqlite"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"strings"
+	"time"
+)
+
+var sortColumns = map[model.ShowSort]string{
+	model.ShowSortTitle:     "title",
+	model.ShowSortCreatedAt: "created_at",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+type SqliteShowOutAdapter struct {
+	db *sql.DB
+}
+
+// SaveShow refuses a slug which another show had before in the same statement, so a concurrent change of a slug
+// can not slip in between a check and the insert.
+func (adapter *SqliteShowOutAdapter) SaveShow(ctx context.Context, show *model.Show) (err error) {
+	defer sqlite.TranslateError(&err)
+	var funding, persons string
+
+	if funding, err = column.MarshalList(show.Funding); err != nil {
+		return err
+	}
+	if persons, err = column.MarshalList(show.Persons); err != nil {
+		return err
+	}
+
+	result, err := adapter.db.ExecContext(ctx, "INSERT INTO show (id, title, slug, guid, locked, allow_duplicate_episode_titles, funding, persons, created_at, "+
+		"description, language, author, image_url, category, subcategory, explicit) "+
+		"SELECT ?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16 "+
+		"WHERE NOT EXISTS(SELECT 1 FROM show_slug_alias WHERE slug = ?3);",
+		show.Id, show.Title, show.Slug, column.NullString(show.Guid), show.Locked, show.AllowDuplicateEpisodeTitles,
+		funding, persons, column.FormatTextTime(time.Now()),
+		show.Description, show.Language, show.Author, show.ImageUrl, show.Category, show.Subcategory, show.Explicit)
+	if sqlite.IsUniqueViolation(err) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	return nil
+}
+
+func (adapter *SqliteShowOutAdapter) ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (bool, error) {
+	return adapter.exists(ctx, "SELECT EXISTS(SELECT 1 FROM show where title = ?1 or slug = ?2) OR EXISTS(SELECT 1 FROM show_slug_alias where slug = ?2)",
+		title, slug)
+}
+
+func (adapter *SqliteShowOutAdapter) ExistsBySlug(ctx context.Context, slug string) (bool, error) {
+	return adapter.exists(ctx, "SELECT EXISTS(SELECT 1 FROM show where slug = ?1) OR EXISTS(SELECT 1 FROM show_slug_alias where slug = ?1)", slug)
+}
+
+func (adapter *SqliteShowOutAdapter) ExistsOtherByTitleOrSlug(ctx context.Context, id string, title string, slug string) (bool, error) {
+	return adapter.exists(ctx, "SELECT EXISTS(SELECT 1 FROM show where id <> ?1 and (title = ?2 or slug = ?3)) "+
+		"OR EXISTS(SELECT 1 FROM show_slug_alias where show_id <> ?1 and slug = ?3)", id, title, slug)
+}
+
+func (adapter *SqliteShowOutAdapter) exists(ctx context.Context, query string, args ...any) (exists bool, err error) {
+	defer sqlite.TranslateError(&err)
+	err = adapter.db.QueryRowContext(ctx, query, args...).Scan(&exists)
+	return exists, err
+}
+
+func (adapter *SqliteShowOutAdapter) GetShowOrNil(ctx context.Context, id string) (show *model.Show, err error) {
+	defer sqlite.TranslateError(&err)
+	var (
+		guid      sql.NullString
+		funding   []byte
+		persons   []byte
+		createdAt sql.NullString
+	)
+	show = &model.Show{}
+	err = adapter.db.QueryRowContext(ctx, "SELECT id, title, slug, guid, locked, allow_duplicate_episode_titles, funding, persons, created_at, "+
+		"description, language, author, image_url, category, subcategory, explicit FROM show WHERE id = ?1;", id).
+		Scan(&show.Id, &show.Title, &show.Slug, &guid, &show.Locked, &show.AllowDuplicateEpisodeTitles, &funding, &persons, &createdAt,
+			&show.Description, &show.Language, &show.Author, &show.ImageUrl, &show.Category, &show.Subcategory, &show.Explicit)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	show.Guid = guid.String
+	if show.CreatedAt, err = column.ParseTextTime(createdAt); err != nil {
+		return nil, err
+	}
+	if err = column.UnmarshalList(funding, &show.Funding); err != nil {
+		return nil, err
+	}
+	if err = column.UnmarshalList(persons, &show.Persons); err != nil {
+		return nil, err
+	}
+	if show.Episodes, err = adapter.getEpisodeIds(ctx, id); err != nil {
+		return nil, err
+	}
+	return show, nil
+}
+
+func (adapter *SqliteShowOutAdapter) getEpisodeIds(ctx context.Context, showId string) (episodeIds []string, err error) {
+	rows, err := adapter.db.QueryContext(ctx, "SELECT episode_id FROM show_episodes WHERE show_id = ?1;", showId)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var episodeId string
+		if err = rows.Scan(&episodeId); err != nil {
+			return nil, err
+		}
+		episodeIds = append(episodeIds, episodeId)
+	}
+	return episodeIds, rows.Err()
+}
+
+// ListShows pages through shows by keyset: the query continues after the sort value and id of the
+// last show of the previous page, so pages stay consistent while shows are added.
+func (adapter *SqliteShowOutAdapter) ListShows(ctx context.Context, query *model.ShowQuery) (shows []*model.Show, err error) {
+	defer sqlite.TranslateError(&err)
+	sortColumn, known := sortColumns[query.Sort]
+	if !known {
+		return nil, fmt.Errorf("unknown sort '%s'", query.Sort)
+	}
+	direction, comparison := "ASC", ">"
+	if query.Order == model.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	conditions := []string{`title LIKE ?1 ESCAPE '\'`}
+	args := []any{"%" + likeEscaper.Replace(query.Title) + "%"}
+	if query.After != nil {
+		var afterValue any = query.After.Title
+		if query.Sort == model.ShowSortCreatedAt {
+			afterValue = column.FormatTextTime(query.After.CreatedAt)
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (?2, ?3)", sortColumn, comparison))
+		args = append(args, afterValue, query.After.Id)
+	}
+	args = append(args, query.Limit)
+
+	statement := fmt.Sprintf("SELECT id, title, slug, guid, locked, allow_duplicate_episode_titles, created_at FROM show WHERE %s ORDER BY %s %s, id %s LIMIT ?%d",
+		strings.Join(conditions, " AND "), sortColumn, direction, direction, len(args))
+	rows, err := adapter.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var guid, createdAt sql.NullString
+		show := &model.Show{}
+		if err = rows.Scan(&show.Id, &show.Title, &show.Slug, &guid, &show.Locked, &show.AllowDuplicateEpisodeTitles, &createdAt); err != nil {
+			return nil, err
+		}
+		show.Guid = guid.String
+		if show.CreatedAt, err = column.ParseTextTime(createdAt); err != nil {
+			return nil, err
+		}
+		shows = append(shows, show)
+	}
+	return shows, rows.Err()
+}
+
+// UpdateShow changes a show and hands its episodes the setting for duplicate titles. Disallowing duplicates
+// fails with a constraint violation while episodes of the show share a title. A changed slug stays as alias
+// of the show, the new slug stops being one. Like on saving, the update refuses a former slug of another show.
+func (adapter *SqliteShowOutAdapter) UpdateShow(ctx context.Context, show *model.Show) (err error) {
+	defer sqlite.TranslateError(&err)
+	var funding, persons string
+
+	if funding, err = column.MarshalList(show.Funding); err != nil {
+		return err
+	}
+	if persons, err = column.MarshalList(show.Persons); err != nil {
+		return err
+	}
+
+	transaction, err := adapter.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(transaction *sql.Tx) {
+		_ = transaction.Rollback()
+	}(transaction)
+
+	if _, err = transaction.ExecContext(ctx, "INSERT INTO show_slug_alias (slug, show_id) SELECT slug, id FROM show WHERE id = ?1 AND slug <> ?2 "+
+		"ON CONFLICT (slug) DO UPDATE SET show_id = excluded.show_id;", show.Id, show.Slug); err != nil {
+		return err
+	}
+	result, err := transaction.ExecContext(ctx, "UPDATE show SET title = ?2, slug = ?3, guid = ?4, locked = ?5, "+
+		"allow_duplicate_episode_titles = ?6, funding = ?7, persons = ?8, description = ?9, language = ?10, author = ?11, "+
+		"image_url = ?12, category = ?13, subcategory = ?14, explicit = ?15 "+
+		"WHERE id = ?1 AND NOT EXISTS(SELECT 1 FROM show_slug_alias WHERE slug = ?3 AND show_id <> ?1);",
+		show.Id, show.Title, show.Slug, column.NullString(show.Guid), show.Locked, show.AllowDuplicateEpisodeTitles, funding, persons,
+		show.Description, show.Language, show.Author, show.ImageUrl, show.Category, show.Subcategory, show.Explicit)
+	if sqlite.IsUniqueViolation(err) {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	if err != nil {
+		return err
+	}
+	if err = repository.RequireAffectedRows(result, "show", show.Id); err != nil {
+		return slugOfOtherShowOr(ctx, transaction, show, err)
+	}
+	if _, err = transaction.ExecContext(ctx, "DELETE FROM show_slug_alias WHERE show_id = ?1 AND slug = ?2;", show.Id, show.Slug); err != nil {
+		return err
+	}
+	if _, err = transaction.ExecContext(ctx, "UPDATE episode SET unique_title = ?2 WHERE show_id = ?1 AND unique_title <> ?2;",
+		show.Id, !show.AllowDuplicateEpisodeTitles); err != nil {
+		return err
+	}
+	return transaction.Commit()
+}
+
+// slugOfOtherShowOr tells an update which changed no row because of a former slug of another show from one of
+// a missing show, which fails with notStored.
+func slugOfOtherShowOr(ctx context.Context, transaction *sql.Tx, show *model.Show, notStored error) error {
+	var exists bool
+	if err := transaction.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM show WHERE id = ?1)", show.Id).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	return notStored
+}
+
+// DeleteShow deletes a show together with its episodes. Downloads are not referenced and stay for analytics.
+func (adapter *SqliteShowOutAdapter) DeleteShow(ctx context.Context, id string) (err error) {
+	defer sqlite.TranslateError(&err)
+	transaction, err := adapter.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func(transaction *sql.Tx) {
+		_ = transaction.Rollback()
+	}(transaction)
+
+	for _, statement := range []string{
+		"DELETE FROM show_episodes WHERE show_id = ?1;",
+		"DELETE FROM episode WHERE show_id = ?1;",
+		"DELETE FROM show_slug_alias WHERE show_id = ?1;",
+	} {
+		if _, err = transaction.ExecContext(ctx, statement, id); err != nil {
+			return err
+		}
+	}
+	result, err := transaction.ExecContext(ctx, "DELETE FROM show WHERE id = ?1;", id)
+	if err != nil {
+		return err
+	}
+	if err = repository.RequireAffectedRows(result, "show", id); err != nil {
+		return err
+	}
+	return transaction.Commit()
+}
+
+// GetShowBySlugOrNil finds a show by its slug, or else by a former slug of it.
+func (adapter *SqliteShowOutAdapter) GetShowBySlugOrNil(ctx context.Context, slug string) (show *model.Show, err error) {
+	defer sqlite.TranslateError(&err)
+	var id sql.NullString
+	query := "SELECT COALESCE((SELECT id FROM show WHERE slug = ?1), (SELECT show_id FROM show_slug_alias WHERE slug = ?1))"
+	if err = adapter.db.QueryRowContext(ctx, query, slug).Scan(&id); err != nil || !id.Valid {
+		return nil, err
+	}
+	return adapter.GetShowOrNil(ctx, id.String)
+}
+
+func (adapter *SqliteShowOutAdapter) GetShowIdsWithoutGuid(ctx context.Context) (showIds []string, err error) {
+	defer sqlite.TranslateError(&err)
+	rows, err := adapter.db.QueryContext(ctx, "SELECT id FROM show WHERE guid IS NULL OR guid = '' ORDER BY id;")
+	if err != nil {
+		return nil, err
+	}
+	defer func(rows *sql.Rows) {
+		_ = rows.Close()
+	}(rows)
+
+	for rows.Next() {
+		var showId string
+		if err = rows.Scan(&showId); err != nil {
+			return nil, err
+		}
+		showIds = append(showIds, showId)
+	}
+	return showIds, rows.Err()
+}
+
+// SetShowGuidIfMissing leaves shows which were deleted or got a guid in the meantime unchanged.
+func (adapter *SqliteShowOutAdapter) SetShowGuidIfMissing(ctx context.Context, id string, guid string) (err error) {
+	defer sqlite.TranslateError(&err)
+	_, err = adapter.db.ExecContext(ctx, "UPDATE show SET guid = ?2 WHERE id = ?1 AND (guid IS NULL OR guid = '');", id, guid)
+	return err
+}
+
+func NewSqliteShowRepository(db *sql.DB) *SqliteShowOutAdapter {
+	return &SqliteShowOutAdapter{db: db}
+}
@@ -0,0 +1,31 @@
+package show
+
+import (
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/sqlite/sqliteTestSetup"
+	"podGopher/core/domain/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_implement_port(t *testing.T) {
+	adapter := NewSqliteShowRepository(nil)
+
+	assert.NotNil(t, adapter)
+	assert.Implements(t, (*repository.ShowRepository)(nil), adapter)
+}
+
+func Test_should_store_created_at_as_sortable_text(t *testing.T) {
+	db := sqliteTestSetup.OpenTestDatabase(t)
+	repository := NewSqliteShowRepository(db)
+	show := &model.Show{Id: uuid.NewString(), Title: "Some title", Slug: "Some-Slug"}
+	assert.Nil(t, repository.SaveShow(t.Context(), show))
+
+	var createdAt string
+	err := db.QueryRow("SELECT created_at FROM show WHERE id = ?1", show.Id).Scan(&createdAt)
+
+	assert.Nil(t, err)
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}Z$`, createdAt)
+}
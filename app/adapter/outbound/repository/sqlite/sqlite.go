@@ -0,0 +1,70 @@
+// Package sqlite stores shows, episodes and downloads in a single SQLite file, for deployments without
+// a Postgres server. The driver is pure Go, so podGopher stays a single binary.
+package sqlite
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	error2 "podGopher/core/domain/error"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Open opens the database of a connection string and checks the connection.
+func Open(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// TranslateError replaces an error of the driver by a repository error like its Postgres counterpart. A busy
+// database counts as a serialization failure, since a retry of the request usually succeeds.
+func TranslateError(err *error) {
+	if *err == nil {
+		return
+	}
+	if failure, known := failureOf(*err); known {
+		*err = error2.NewRepositoryError(failure, *err)
+	}
+}
+
+// IsUniqueViolation tells whether a statement failed on a unique index. SQLite names the columns instead of
+// the index, so any unique index counts. Primary keys fail with a code of their own.
+func IsUniqueViolation(err error) bool {
+	var sqliteError *sqlite.Error
+	return errors.As(err, &sqliteError) && sqliteError.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
+
+func failureOf(err error) (error2.RepositoryFailure, bool) {
+	var repositoryError *error2.RepositoryError
+	if errors.As(err, &repositoryError) {
+		return "", false
+	}
+
+	var sqliteError *sqlite.Error
+	if errors.As(err, &sqliteError) {
+		// extended codes carry the primary code in their lowest byte
+		switch sqliteError.Code() & 0xff {
+		case sqlite3.SQLITE_CONSTRAINT:
+			return error2.ConstraintViolation, true
+		case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+			return error2.SerializationFailure, true
+		case sqlite3.SQLITE_CANTOPEN, sqlite3.SQLITE_IOERR:
+			return error2.ConnectionFailure, true
+		}
+		return "", false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return error2.ConnectionFailure, true
+	}
+	return "", false
+}
@@ -0,0 +1,32 @@
+package sqliteTestSetup
+
+import (
+	"database/sql"
+	"path/filepath"
+	"podGopher/adapter/outbound/repository/sqlite"
+	"podGopher/adapter/outbound/repository/sqlite/migration"
+	"podGopher/env"
+	"testing"
+)
+
+// OpenTestDatabase opens a migrated database in a temporary directory, which is closed and removed after the test.
+func OpenTestDatabase(t *testing.T) *sql.DB {
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+
+	db, err := sqlite.Open(migration.GetSqliteConnectionString())
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	m, err := migration.NewMigration(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Migrate(); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
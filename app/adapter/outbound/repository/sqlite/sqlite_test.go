@@ -0,0 +1,41 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_translate_errors_of_driver(t *testing.T) {
+	db, err := Open(":memory:?_pragma=foreign_keys(1)")
+	assert.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	_, err = db.Exec("CREATE TABLE show (id TEXT PRIMARY KEY); INSERT INTO show (id) VALUES ('some id');")
+	assert.Nil(t, err)
+
+	_, err = db.ExecContext(t.Context(), "INSERT INTO show (id) VALUES ('some id');")
+	TranslateError(&err)
+
+	assert.True(t, error2.IsRepositoryFailure(err, error2.ConstraintViolation), err)
+
+	err = driver.ErrBadConn
+	TranslateError(&err)
+
+	assert.True(t, error2.IsRepositoryFailure(err, error2.ConnectionFailure), err)
+}
+
+func Test_should_keep_errors_without_repository_failure(t *testing.T) {
+	for _, original := range []error{nil, context.Canceled, errors.New("some error")} {
+		err := original
+
+		TranslateError(&err)
+
+		assert.Equal(t, original, err)
+	}
+}
@@ -0,0 +1,93 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"io"
+	"podGopher/core/domain/model"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/fileblob"
+	"gocloud.dev/gcerrors"
+)
+
+type FileMediaOutAdapter struct {
+	bucket *blob.Bucket
+}
+
+func (adapter *FileMediaOutAdapter) SaveMedia(ctx context.Context, key string, content io.Reader, mimeType string) (size int64, err error) {
+	// cancelling the writer's context before closing it discards a partially written blob
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var writer *blob.Writer
+	if writer, err = adapter.bucket.NewWriter(ctx, key, &blob.WriterOptions{ContentType: mimeType}); err != nil {
+		return 0, err
+	}
+
+	if size, err = io.Copy(writer, content); err != nil {
+		cancel()
+		_ = writer.Close()
+		return 0, err
+	}
+	if err = writer.Close(); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+func (adapter *FileMediaOutAdapter) OpenMediaOrNil(ctx context.Context, key string) (media *model.MediaContent, err error) {
+	var attributes *blob.Attributes
+	if attributes, err = adapter.bucket.Attributes(ctx, key); gcerrors.Code(err) == gcerrors.NotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var reader *blob.Reader
+	if reader, err = adapter.bucket.NewReader(ctx, key, nil); err != nil {
+		return nil, err
+	}
+	return &model.MediaContent{
+		Content:  reader,
+		MimeType: attributes.ContentType,
+		Size:     attributes.Size,
+		ModTime:  attributes.ModTime,
+		ETag:     attributes.ETag,
+	}, nil
+}
+
+func (adapter *FileMediaOutAdapter) DeleteMedia(ctx context.Context, key string) (err error) {
+	return adapter.bucket.Delete(ctx, key)
+}
+
+// healthCheckKey does not collide with media, whose keys start with the id of their episode.
+const healthCheckKey = "healthcheck"
+
+func (adapter *FileMediaOutAdapter) ComponentName() string {
+	return "storage"
+}
+
+// CheckHealth writes and deletes a probe, since the storage is useless to uploads unless it is writable. The
+// errors are not reported, as they name the media directory.
+func (adapter *FileMediaOutAdapter) CheckHealth(ctx context.Context) (map[string]any, error) {
+	if err := adapter.bucket.WriteAll(ctx, healthCheckKey, []byte("ok"), nil); err != nil {
+		return nil, errors.New("storage is not writable")
+	}
+	if err := adapter.bucket.Delete(ctx, healthCheckKey); err != nil {
+		return nil, errors.New("storage does not delete")
+	}
+	return nil, nil
+}
+
+func (adapter *FileMediaOutAdapter) Close() error {
+	return adapter.bucket.Close()
+}
+
+func NewFileMediaStorage(dir string) (*FileMediaOutAdapter, error) {
+	bucket, err := fileblob.OpenBucket(dir, &fileblob.Options{CreateDir: true, NoTempDir: true})
+	if err != nil {
+		return nil, err
+	}
+	return &FileMediaOutAdapter{bucket: bucket}, nil
+}
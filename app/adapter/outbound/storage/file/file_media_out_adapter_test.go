@@ -0,0 +1,150 @@
+package file
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"podGopher/core/port/outbound"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_implement_media_storage_port(t *testing.T) {
+	storage, err := NewFileMediaStorage(t.TempDir())
+	defer func() { _ = storage.Close() }()
+
+	assert.Nil(t, err)
+	assert.Implements(t, (*outbound.MediaStoragePort)(nil), storage)
+	assert.Implements(t, (*outbound.OpenMediaPort)(nil), storage)
+}
+
+func Test_should_create_missing_media_directory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "media")
+
+	storage, err := NewFileMediaStorage(dir)
+	defer func() { _ = storage.Close() }()
+
+	assert.Nil(t, err)
+	assert.DirExists(t, dir)
+}
+
+func Test_should_save_and_delete_media(t *testing.T) {
+	dir := t.TempDir()
+	storage, _ := NewFileMediaStorage(dir)
+	defer func() { _ = storage.Close() }()
+
+	key := "some-episode-id/episode.mp3"
+
+	t.Run("should save media", func(t *testing.T) {
+		size, err := storage.SaveMedia(t.Context(), key, strings.NewReader("some audio"), "audio/mpeg")
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(len("some audio")), size)
+
+		content, err := os.ReadFile(filepath.Join(dir, "some-episode-id", "episode.mp3"))
+		assert.Nil(t, err)
+		assert.Equal(t, "some audio", string(content))
+	})
+
+	t.Run("should overwrite media", func(t *testing.T) {
+		size, err := storage.SaveMedia(t.Context(), key, strings.NewReader("other"), "audio/mpeg")
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(len("other")), size)
+	})
+
+	t.Run("should open media", func(t *testing.T) {
+		media, err := storage.OpenMediaOrNil(t.Context(), key)
+		assert.Nil(t, err)
+		defer func() { _ = media.Content.Close() }()
+
+		content, _ := io.ReadAll(media.Content)
+		assert.Equal(t, "other", string(content))
+		assert.Equal(t, "audio/mpeg", media.MimeType)
+		assert.Equal(t, int64(len("other")), media.Size)
+		assert.NotEmpty(t, media.ETag)
+		assert.WithinDuration(t, time.Now(), media.ModTime, time.Minute)
+	})
+
+	t.Run("should seek in opened media", func(t *testing.T) {
+		media, _ := storage.OpenMediaOrNil(t.Context(), key)
+		defer func() { _ = media.Content.Close() }()
+
+		_, err := media.Content.Seek(2, io.SeekStart)
+		content, _ := io.ReadAll(media.Content)
+		assert.Nil(t, err)
+		assert.Equal(t, "her", string(content))
+	})
+
+	t.Run("should delete media", func(t *testing.T) {
+		err := storage.DeleteMedia(t.Context(), key)
+
+		assert.Nil(t, err)
+		assert.NoFileExists(t, filepath.Join(dir, "some-episode-id", "episode.mp3"))
+	})
+
+	t.Run("should fail to delete missing media", func(t *testing.T) {
+		err := storage.DeleteMedia(t.Context(), key)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should return nil on missing media", func(t *testing.T) {
+		media, err := storage.OpenMediaOrNil(t.Context(), key)
+
+		assert.Nil(t, err)
+		assert.Nil(t, media)
+	})
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("some error")
+}
+
+func Test_should_not_keep_media_on_failing_upload(t *testing.T) {
+	dir := t.TempDir()
+	storage, _ := NewFileMediaStorage(dir)
+	defer func() { _ = storage.Close() }()
+
+	_, err := storage.SaveMedia(t.Context(), "some-episode-id/episode.mp3", failingReader{}, "audio/mpeg")
+
+	assert.NotNil(t, err)
+	assert.NoFileExists(t, filepath.Join(dir, "some-episode-id", "episode.mp3"))
+}
+
+func Test_should_implement_health_check_port(t *testing.T) {
+	storage, err := NewFileMediaStorage(t.TempDir())
+	defer func() { _ = storage.Close() }()
+
+	assert.Nil(t, err)
+	assert.Implements(t, (*outbound.HealthCheckPort)(nil), storage)
+}
+
+func Test_should_check_that_storage_is_writable(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "media")
+	storage, _ := NewFileMediaStorage(dir)
+	defer func() { _ = storage.Close() }()
+
+	t.Run("should leave no probe behind", func(t *testing.T) {
+		_, err := storage.CheckHealth(t.Context())
+
+		assert.Nil(t, err)
+		entries, _ := os.ReadDir(dir)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("should fail if directory was replaced by a file", func(t *testing.T) {
+		assert.Nil(t, os.RemoveAll(dir))
+		assert.Nil(t, os.WriteFile(dir, nil, 0o600))
+
+		_, err := storage.CheckHealth(t.Context())
+
+		assert.EqualError(t, err, "storage is not writable")
+	})
+}
@@ -1,6 +1,10 @@
 package error
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 type ShowAlreadyExistsError struct {
 	Name string
@@ -18,10 +22,73 @@ type ShowNotFoundError struct {
 	Id string
 }
 
+type ShowSlugNotFoundError struct {
+	Slug string
+}
+
+type InvalidSlugError struct {
+	Slug string
+}
+
+// EpisodeStatusChangeError refuses a change of status which the lifecycle of episodes does not allow.
+type EpisodeStatusChangeError struct {
+	Id   string
+	From string
+	To   string
+}
+
+type InvalidPublishDateError struct {
+	PublishAt time.Time
+}
+
+type UnsupportedMediaTypeError struct {
+	MimeType string
+}
+
+type MediaNotFoundError struct {
+	Key string
+}
+
+type InvalidCursorError struct {
+	Cursor string
+}
+
+type InvalidMediaError struct {
+	FileName string
+	MimeType string
+}
+
+// RepositoryFailure tells why a repository could not serve a request, independent of the database behind it.
+type RepositoryFailure string
+
+const (
+	// ConnectionFailure means the database could not be reached, a later attempt may succeed.
+	ConnectionFailure RepositoryFailure = "connection failure"
+	// ConstraintViolation means a change conflicts with stored data, like a duplicate or a missing reference.
+	ConstraintViolation RepositoryFailure = "constraint violation"
+	// NotFound means the entity to change does not exist, usually because it was deleted concurrently.
+	NotFound RepositoryFailure = "not found"
+	// SerializationFailure means a concurrent transaction got in the way, a later attempt may succeed.
+	SerializationFailure RepositoryFailure = "serialization failure"
+)
+
+type RepositoryError struct {
+	Failure RepositoryFailure
+	Cause   error
+}
+
 func (e ShowNotFoundError) Error() string {
 	return fmt.Sprintf("show with id '%v' does not exist", e.Id)
 }
 
+func (e ShowSlugNotFoundError) Error() string {
+	return fmt.Sprintf("show with slug '%s' does not exist", e.Slug)
+}
+
+func (e InvalidSlugError) Error() string {
+	return fmt.Sprintf("slug '%s' is invalid, use lowercase letters and digits separated by hyphens", e.Slug)
+}
+
 func (e ShowAlreadyExistsError) Error() string {
 	return fmt.Sprintf("show with title '%s' or given slug already exists", e.Name)
 }
@@ -34,6 +101,38 @@ func (e EpisodeNotFoundError) Error() string {
 	return fmt.Sprintf("episode with id '%v' does not exist", e.Id)
 }
 
+func (e EpisodeStatusChangeError) Error() string {
+	return fmt.Sprintf("episode with id '%v' cannot change from %s to %s", e.Id, e.From, e.To)
+}
+
+func (e InvalidPublishDateError) Error() string {
+	return fmt.Sprintf("publish date '%s' is not in the future", e.PublishAt.Format(time.RFC3339))
+}
+
+func (e UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("media type '%s' is not supported", e.MimeType)
+}
+
+func (e MediaNotFoundError) Error() string {
+	return fmt.Sprintf("media '%s' does not exist", e.Key)
+}
+
+func (e InvalidMediaError) Error() string {
+	return fmt.Sprintf("media '%s' is not a valid '%s' file", e.FileName, e.MimeType)
+}
+
+func (e InvalidCursorError) Error() string {
+	return fmt.Sprintf("cursor '%s' is invalid", e.Cursor)
+}
+
+func (e RepositoryError) Error() string {
+	return fmt.Sprintf("repository %s: %v", e.Failure, e.Cause)
+}
+
+func (e RepositoryError) Unwrap() error {
+	return e.Cause
+}
+
 func NewShowAlreadyExistsError(name string) *ShowAlreadyExistsError {
 	return &ShowAlreadyExistsError{name}
 }
@@ -42,6 +141,14 @@ func NewShowNotFoundError(id string) *ShowNotFoundError {
 	return &ShowNotFoundError{id}
 }
 
+func NewShowSlugNotFoundError(slug string) *ShowSlugNotFoundError {
+	return &ShowSlugNotFoundError{slug}
+}
+
+func NewInvalidSlugError(slug string) *InvalidSlugError {
+	return &InvalidSlugError{slug}
+}
+
 func NewEpisodeAlreadyExistsError(name string) *EpisodeAlreadyExistsError {
 	return &EpisodeAlreadyExistsError{name}
 }
@@ -49,3 +156,37 @@ func NewEpisodeAlreadyExistsError(name string) *EpisodeAlreadyExistsError {
 func NewEpisodeNotFoundError(id string) *EpisodeNotFoundError {
 	return &EpisodeNotFoundError{id}
 }
+
+func NewEpisodeStatusChangeError(id string, from string, to string) *EpisodeStatusChangeError {
+	return &EpisodeStatusChangeError{id, from, to}
+}
+
+func NewInvalidPublishDateError(publishAt time.Time) *InvalidPublishDateError {
+	return &InvalidPublishDateError{publishAt}
+}
+
+func NewUnsupportedMediaTypeError(mimeType string) *UnsupportedMediaTypeError {
+	return &UnsupportedMediaTypeError{mimeType}
+}
+
+func NewInvalidMediaError(fileName string, mimeType string) *InvalidMediaError {
+	return &InvalidMediaError{fileName, mimeType}
+}
+
+func NewMediaNotFoundError(key string) *MediaNotFoundError {
+	return &MediaNotFoundError{key}
+}
+
+func NewInvalidCursorError(cursor string) *InvalidCursorError {
+	return &InvalidCursorError{cursor}
+}
+
+func NewRepositoryError(failure RepositoryFailure, cause error) *RepositoryError {
+	return &RepositoryError{failure, cause}
+}
+
+// IsRepositoryFailure tells whether the error chain contains a repository error of the given failure.
+func IsRepositoryFailure(err error, failure RepositoryFailure) bool {
+	var repositoryError *RepositoryError
+	return errors.As(err, &repositoryError) && repositoryError.Failure == failure
+}
@@ -1,7 +1,10 @@
 package error
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +24,16 @@ func Test_show_already_exists_is_an_error(t *testing.T) {
 			"show with id 'some-id' does not exist",
 		},
 
+		"ShowSlugNotFoundError": {
+			NewShowSlugNotFoundError("some-slug"),
+			"show with slug 'some-slug' does not exist",
+		},
+
+		"InvalidSlugError": {
+			NewInvalidSlugError("Some Slug"),
+			"slug 'Some Slug' is invalid, use lowercase letters and digits separated by hyphens",
+		},
+
 		"EpisodeAlreadyExistsError": {
 			NewEpisodeAlreadyExistsError("some-name"),
 			"episode with title 'some-name' already exists",
@@ -30,6 +43,41 @@ func Test_show_already_exists_is_an_error(t *testing.T) {
 			NewEpisodeNotFoundError("some-id"),
 			"episode with id 'some-id' does not exist",
 		},
+
+		"EpisodeStatusChangeError": {
+			NewEpisodeStatusChangeError("some-id", "published", "scheduled"),
+			"episode with id 'some-id' cannot change from published to scheduled",
+		},
+
+		"InvalidPublishDateError": {
+			NewInvalidPublishDateError(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)),
+			"publish date '2024-05-01T12:00:00Z' is not in the future",
+		},
+
+		"UnsupportedMediaTypeError": {
+			NewUnsupportedMediaTypeError("text/plain"),
+			"media type 'text/plain' is not supported",
+		},
+
+		"MediaNotFoundError": {
+			NewMediaNotFoundError("some-episode-id/episode.mp3"),
+			"media 'some-episode-id/episode.mp3' does not exist",
+		},
+
+		"InvalidMediaError": {
+			NewInvalidMediaError("episode.mp3", "audio/mpeg"),
+			"media 'episode.mp3' is not a valid 'audio/mpeg' file",
+		},
+
+		"InvalidCursorError": {
+			NewInvalidCursorError("some-cursor"),
+			"cursor 'some-cursor' is invalid",
+		},
+
+		"RepositoryError": {
+			NewRepositoryError(ConnectionFailure, errors.New("connection refused")),
+			"repository connection failure: connection refused",
+		},
 	}
 
 	for name, test := range tests {
@@ -40,3 +88,13 @@ func Test_show_already_exists_is_an_error(t *testing.T) {
 	}
 
 }
+
+func Test_should_find_repository_failure_in_wrapped_error(t *testing.T) {
+	cause := errors.New("duplicate key")
+	err := fmt.Errorf("saving show: %w", NewRepositoryError(ConstraintViolation, cause))
+
+	assert.True(t, IsRepositoryFailure(err, ConstraintViolation))
+	assert.False(t, IsRepositoryFailure(err, NotFound))
+	assert.False(t, IsRepositoryFailure(cause, ConstraintViolation))
+	assert.ErrorIs(t, err, cause)
+}
@@ -0,0 +1,94 @@
+package error
+
+import (
+	"errors"
+)
+
+// ProblemKind tells what is wrong with a request in terms of the domain, each inbound adapter answers it its own way.
+type ProblemKind string
+
+const (
+	// ProblemInvalid means the request itself is malformed or breaks a rule of the domain.
+	ProblemInvalid ProblemKind = "invalid"
+	// ProblemNotFound means the request names something which does not exist.
+	ProblemNotFound ProblemKind = "not found"
+	// ProblemConflict means the request conflicts with the current state, like a duplicate title.
+	ProblemConflict ProblemKind = "conflict"
+	// ProblemUnsupported means the request carries content of a type which is not supported.
+	ProblemUnsupported ProblemKind = "unsupported"
+	// ProblemUnprocessable means the content of the request is of a supported type, but cannot be processed.
+	ProblemUnprocessable ProblemKind = "unprocessable"
+	// ProblemUnavailable means a dependency could not be reached, a later attempt may succeed.
+	ProblemUnavailable ProblemKind = "unavailable"
+)
+
+// Problem describes an error to clients. Type identifies the error for good, Title summarizes it the same way for
+// each occurrence, while the message of the error details the occurrence.
+type Problem struct {
+	Kind  ProblemKind
+	Type  string
+	Title string
+	// Internal keeps the message of the error from clients, since it may reveal details like the database.
+	Internal bool
+}
+
+// ProblemMatcher tells the problem of an error, if it knows the error.
+type ProblemMatcher func(err error) (Problem, bool)
+
+// problems are matched in order of registration, they are registered on init and read concurrently afterward.
+var problems []ProblemMatcher
+
+// RegisterProblem describes errors of type E, found anywhere in the chain of an error, as problem.
+func RegisterProblem[E error](problem Problem) {
+	RegisterProblemMatcher(func(err error) (Problem, bool) {
+		var target E
+		return problem, errors.As(err, &target)
+	})
+}
+
+// RegisterProblemMatcher describes errors whose problem depends on their values. Register on init only.
+func RegisterProblemMatcher(matcher ProblemMatcher) {
+	problems = append(problems, matcher)
+}
+
+// ProblemOf finds the problem of err by the first registration which knows err.
+func ProblemOf(err error) (Problem, bool) {
+	for _, matcher := range problems {
+		if problem, found := matcher(err); found {
+			return problem, true
+		}
+	}
+	return Problem{}, false
+}
+
+var repositoryProblems = map[RepositoryFailure]Problem{
+	ConnectionFailure:    {Kind: ProblemUnavailable, Type: "repository-unavailable", Title: "Repository is unavailable", Internal: true},
+	ConstraintViolation:  {Kind: ProblemConflict, Type: "repository-conflict", Title: "Change conflicts with stored data", Internal: true},
+	NotFound:             {Kind: ProblemNotFound, Type: "repository-not-found", Title: "Entity to change does not exist", Internal: true},
+	SerializationFailure: {Kind: ProblemConflict, Type: "concurrent-change", Title: "Change conflicts with a concurrent change", Internal: true},
+}
+
+func repositoryProblem(err error) (Problem, bool) {
+	var repositoryError *RepositoryError
+	if !errors.As(err, &repositoryError) {
+		return Problem{}, false
+	}
+	problem, found := repositoryProblems[repositoryError.Failure]
+	return problem, found
+}
+
+func init() {
+	RegisterProblem[*ShowAlreadyExistsError](Problem{Kind: ProblemConflict, Type: "show-already-exists", Title: "Show already exists"})
+	RegisterProblem[*ShowNotFoundError](Problem{Kind: ProblemNotFound, Type: "show-not-found", Title: "Show does not exist"})
+	RegisterProblem[*ShowSlugNotFoundError](Problem{Kind: ProblemNotFound, Type: "show-not-found", Title: "Show does not exist"})
+	RegisterProblem[*InvalidSlugError](Problem{Kind: ProblemInvalid, Type: "invalid-slug", Title: "Slug is invalid"})
+	RegisterProblem[*EpisodeAlreadyExistsError](Problem{Kind: ProblemConflict, Type: "episode-already-exists", Title: "Episode already exists"})
+	RegisterProblem[*EpisodeNotFoundError](Problem{Kind: ProblemNotFound, Type: "episode-not-found", Title: "Episode does not exist"})
+	RegisterProblem[*EpisodeStatusChangeError](Problem{Kind: ProblemConflict, Type: "episode-status-change", Title: "Episode cannot change its status"})
+	RegisterProblem[*InvalidPublishDateError](Problem{Kind: ProblemInvalid, Type: "invalid-publish-date", Title: "Publish date is invalid"})
+	RegisterProblem[*UnsupportedMediaTypeError](Problem{Kind: ProblemUnsupported, Type: "unsupported-media-type", Title: "Media type is not supported"})
+	RegisterProblem[*InvalidMediaError](Problem{Kind: ProblemUnprocessable, Type: "invalid-media", Title: "Media is invalid"})
+	RegisterProblem[*MediaNotFoundError](Problem{Kind: ProblemNotFound, Type: "media-not-found", Title: "Media does not exist"})
+	RegisterProblem[*InvalidCursorError](Problem{Kind: ProblemInvalid, Type: "invalid-cursor", Title: "Cursor is invalid"})
+	RegisterProblemMatcher(repositoryProblem)
+}
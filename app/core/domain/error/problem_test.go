@@ -0,0 +1,51 @@
+package error
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type someError struct{}
+
+func (someError) Error() string {
+	return "some error"
+}
+
+func Test_should_find_problem_of_wrapped_error(t *testing.T) {
+	err := fmt.Errorf("loading show: %w", NewShowNotFoundError("some-id"))
+
+	problem, found := ProblemOf(err)
+
+	assert.True(t, found)
+	assert.Equal(t, Problem{Kind: ProblemNotFound, Type: "show-not-found", Title: "Show does not exist"}, problem)
+}
+
+func Test_should_find_problem_of_repository_failure(t *testing.T) {
+	err := NewRepositoryError(ConnectionFailure, errors.New("connection refused"))
+
+	problem, found := ProblemOf(err)
+
+	assert.True(t, found)
+	assert.Equal(t, ProblemUnavailable, problem.Kind)
+	assert.True(t, problem.Internal)
+}
+
+func Test_should_not_find_problem_of_unknown_error(t *testing.T) {
+	_, found := ProblemOf(errors.New("some error"))
+	_, repositoryFound := ProblemOf(NewRepositoryError("unknown failure", errors.New("some error")))
+
+	assert.False(t, found)
+	assert.False(t, repositoryFound)
+}
+
+func Test_should_find_problem_of_registered_error(t *testing.T) {
+	RegisterProblem[someError](Problem{Kind: ProblemInvalid, Type: "some-error", Title: "Some error"})
+
+	problem, found := ProblemOf(fmt.Errorf("wrapped: %w", someError{}))
+
+	assert.True(t, found)
+	assert.Equal(t, "some-error", problem.Type)
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// DownloadEvent describes a single request of episode media. The requested bytes are given as
+// half-open range [RangeStart, RangeEnd) resolved against the size of the media.
+type DownloadEvent struct {
+	EpisodeId   string
+	ShowId      string
+	MediaKey    string
+	Method      string
+	IpHash      string
+	UserAgent   string
+	Referrer    string
+	RangeStart  int64
+	RangeEnd    int64
+	RequestedAt time.Time
+}
+
+// DownloadCount is the number of downloads of an episode on a day in UTC by listeners of an app on a
+// device class.
+type DownloadCount struct {
+	EpisodeId string
+	Day       time.Time
+	App       string
+	Device    string
+	Downloads int
+}
@@ -1,7 +1,45 @@
 package model
 
+import (
+	"slices"
+	"time"
+)
+
+type EpisodeStatus string
+
+const (
+	EpisodeDraft       EpisodeStatus = "draft"
+	EpisodeScheduled   EpisodeStatus = "scheduled"
+	EpisodePublished   EpisodeStatus = "published"
+	EpisodeUnpublished EpisodeStatus = "unpublished"
+)
+
+// episodeTransitions lists the statuses each status may change to. A scheduled episode may be scheduled again
+// for another date, an unpublished episode may return to the feed.
+var episodeTransitions = map[EpisodeStatus][]EpisodeStatus{
+	EpisodeDraft:       {EpisodeScheduled, EpisodePublished},
+	EpisodeScheduled:   {EpisodeScheduled, EpisodePublished},
+	EpisodePublished:   {EpisodeUnpublished},
+	EpisodeUnpublished: {EpisodeScheduled, EpisodePublished},
+}
+
+// CanChangeTo tells whether an episode of the status may move on to the next status of its lifecycle.
+func (status EpisodeStatus) CanChangeTo(next EpisodeStatus) bool {
+	return slices.Contains(episodeTransitions[status], next)
+}
+
+// Episode is published at PublishedAt, which is the planned date while it is scheduled.
 type Episode struct {
-	Id     string
-	ShowId string
-	Title  string
+	Id            string
+	ShowId        string
+	Title         string
+	Description   string
+	Season        int
+	EpisodeNumber int
+	Status        EpisodeStatus
+	PublishedAt   time.Time
+	Transcripts   []Transcript
+	Chapters      *Chapters
+	Persons       []Person
+	Media         *Media
 }
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type EpisodeSort string
+
+const (
+	EpisodeSortPublishedAt EpisodeSort = "publishedAt"
+	EpisodeSortNumber      EpisodeSort = "number"
+)
+
+// EpisodeQuery selects up to Limit episodes of a show, optionally of one status, following the episode
+// After in the given sort order. Sorting by number orders by season first, episodes without season or
+// number sort as 0. Episodes of equal sort values are ordered by id.
+type EpisodeQuery struct {
+	ShowId string
+	Status EpisodeStatus
+	Sort   EpisodeSort
+	Order  SortOrder
+	After  *EpisodeKey
+	Limit  int
+}
+
+// EpisodeKey holds the sort values of the last episode of a page.
+type EpisodeKey struct {
+	Id            string
+	PublishedAt   time.Time
+	Season        int
+	EpisodeNumber int
+}
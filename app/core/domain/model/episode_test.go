@@ -0,0 +1,35 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_change_episode_status_along_lifecycle(t *testing.T) {
+	tests := map[string]struct {
+		from    EpisodeStatus
+		to      EpisodeStatus
+		allowed bool
+	}{
+		"schedule draft":        {EpisodeDraft, EpisodeScheduled, true},
+		"publish draft":         {EpisodeDraft, EpisodePublished, true},
+		"reschedule":            {EpisodeScheduled, EpisodeScheduled, true},
+		"publish scheduled":     {EpisodeScheduled, EpisodePublished, true},
+		"unpublish published":   {EpisodePublished, EpisodeUnpublished, true},
+		"republish unpublished": {EpisodeUnpublished, EpisodePublished, true},
+		"schedule unpublished":  {EpisodeUnpublished, EpisodeScheduled, true},
+		"publish published":     {EpisodePublished, EpisodePublished, false},
+		"schedule published":    {EpisodePublished, EpisodeScheduled, false},
+		"unpublish draft":       {EpisodeDraft, EpisodeUnpublished, false},
+		"unpublish scheduled":   {EpisodeScheduled, EpisodeUnpublished, false},
+		"back to draft":         {EpisodePublished, EpisodeDraft, false},
+		"change unknown status": {"unknown", EpisodePublished, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.allowed, test.from.CanChangeTo(test.to))
+		})
+	}
+}
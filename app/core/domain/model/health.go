@@ -0,0 +1,17 @@
+package model
+
+type HealthStatus string
+
+const (
+	HealthUp   HealthStatus = "up"
+	HealthDown HealthStatus = "down"
+)
+
+// ComponentHealth is the state of a component which the app needs to serve requests, like the database.
+// Details describe the state for operators, also if the component is down.
+type ComponentHealth struct {
+	Name    string
+	Status  HealthStatus
+	Error   string
+	Details map[string]any
+}
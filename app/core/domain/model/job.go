@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type JobStatus string
+
+const (
+	JobPending JobStatus = "pending"
+	JobRunning JobStatus = "running"
+	// JobDead marks a job which failed on every attempt. It stays as dead letter for inspection, but never runs again.
+	JobDead JobStatus = "dead"
+)
+
+// Job is a unit of asynchronous work, which the handler registered for its type processes. A job runs at least
+// once, so handlers have to tolerate running twice.
+//
+// RunAt is the time the job is due. While it runs, RunAt is the end of the lease of its worker, after which another
+// worker takes the job over, like after a crash.
+//
+// A job with a UniqueKey is not queued while another job with the key waits or runs, so work queued repeatedly,
+// like on every tick of a scheduler of each replica, does not pile up.
+type Job struct {
+	Id          string
+	Type        string
+	UniqueKey   string
+	Payload     json.RawMessage
+	Status      JobStatus
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LastError   string
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"io"
+	"time"
+)
+
+type Media struct {
+	Key        string
+	FileName   string
+	MimeType   string
+	Size       int64
+	Duration   time.Duration
+	Bitrate    int
+	SampleRate int
+	Channels   int
+	Title      string
+	Artwork    *Artwork
+	Chapters   []MediaChapter
+}
+
+type Artwork struct {
+	Key      string
+	MimeType string
+}
+
+type MediaChapter struct {
+	Start time.Duration
+	Title string
+}
+
+// MediaContent is a stored media file opened for reading.
+type MediaContent struct {
+	Content  io.ReadSeekCloser
+	MimeType string
+	Size     int64
+	ModTime  time.Time
+	ETag     string
+}
+
+// StorageKeys lists the keys of the media file and its artwork in the media storage.
+func (media *Media) StorageKeys() []string {
+	keys := []string{media.Key}
+	if media.Artwork != nil {
+		keys = append(keys, media.Artwork.Key)
+	}
+	return keys
+}
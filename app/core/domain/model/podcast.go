@@ -0,0 +1,45 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// podcastGuidNamespace is the UUIDv5 namespace defined by the podcast namespace for podcast:guid
+var podcastGuidNamespace = uuid.MustParse("ead4c236-bf58-58c6-a2c6-a6b28d128cb6")
+
+type Person struct {
+	Name  string
+	Role  string
+	Group string
+	Img   string
+	Href  string
+}
+
+type Funding struct {
+	Url  string
+	Text string
+}
+
+type Transcript struct {
+	Url      string
+	Type     string
+	Language string
+	Rel      string
+}
+
+type Chapters struct {
+	Url  string
+	Type string
+}
+
+// NewPodcastGuid derives the podcast:guid of a feed as UUIDv5 of its url without scheme and trailing slashes.
+func NewPodcastGuid(feedUrl string) string {
+	normalized := feedUrl
+	if _, withoutScheme, found := strings.Cut(feedUrl, "://"); found {
+		normalized = withoutScheme
+	}
+	normalized = strings.TrimRight(normalized, "/")
+	return uuid.NewSHA1(podcastGuidNamespace, []byte(normalized)).String()
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_derive_podcast_guid_from_feed_url(t *testing.T) {
+	tests := map[string]struct {
+		feedUrl      string
+		expectedGuid string
+	}{
+		"with scheme": {
+			"https://podnews.net/rss",
+			"9b024349-ccf0-5f69-a609-6b82873eab3c",
+		},
+		"without scheme": {
+			"podnews.net/rss",
+			"9b024349-ccf0-5f69-a609-6b82873eab3c",
+		},
+		"with trailing slashes": {
+			"http://podnews.net/rss//",
+			"9b024349-ccf0-5f69-a609-6b82873eab3c",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedGuid, NewPodcastGuid(test.feedUrl))
+		})
+	}
+}
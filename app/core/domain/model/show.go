@@ -1,8 +1,23 @@
 package model
 
+import "time"
+
 type Show struct {
-	Id       string
-	Title    string
-	Slug     string
-	Episodes []string
+	Id                          string
+	Title                       string
+	Slug                        string
+	Guid                        string
+	Locked                      bool
+	AllowDuplicateEpisodeTitles bool
+	Funding                     []Funding
+	Persons                     []Person
+	Description                 string
+	Language                    string
+	Author                      string
+	ImageUrl                    string
+	Category                    string
+	Subcategory                 string
+	Explicit                    bool
+	Episodes                    []string
+	CreatedAt                   time.Time
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type ShowSort string
+
+const (
+	ShowSortTitle     ShowSort = "title"
+	ShowSortCreatedAt ShowSort = "createdAt"
+)
+
+// ShowQuery selects up to Limit shows whose title contains Title, following the show After in the
+// given sort order. Shows of equal sort values are ordered by id.
+type ShowQuery struct {
+	Title string
+	Sort  ShowSort
+	Order SortOrder
+	After *ShowKey
+	Limit int
+}
+
+// ShowKey holds the sort values of the last show of a page.
+type ShowKey struct {
+	Id        string
+	Title     string
+	CreatedAt time.Time
+}
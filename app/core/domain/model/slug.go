@@ -0,0 +1,71 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength leaves room for a collision suffix within the 256 characters a slug may have.
+const MaxSlugLength = 240
+
+// fallbackSlug names shows whose title has no letter or digit to transliterate.
+const fallbackSlug = "show"
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// transliterations spell letters in ASCII which do not decompose into a base letter and marks.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'ø': "o", 'œ': "oe", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i", 'ħ': "h",
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g",
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// NewSlug derives a slug from a title: letters are transliterated to lowercase ASCII, everything else
+// separates words by a single hyphen. Characters without transliteration are dropped.
+func NewSlug(title string) string {
+	var slug strings.Builder
+	separate := false
+	for _, r := range norm.NFKD.String(strings.ToLower(title)) {
+		spelled, known := transliterations[r]
+		switch {
+		case known:
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			spelled = string(r)
+		case unicode.Is(unicode.Mn, r):
+			continue
+		default:
+			separate = slug.Len() > 0
+			continue
+		}
+		if spelled == "" {
+			continue
+		}
+		if separate {
+			slug.WriteByte('-')
+			separate = false
+		}
+		slug.WriteString(spelled)
+	}
+
+	result := slug.String()
+	if len(result) > MaxSlugLength {
+		result = strings.TrimRight(result[:MaxSlugLength], "-")
+	}
+	if result == "" {
+		return fallbackSlug
+	}
+	return result
+}
+
+// IsValidSlug tells whether a slug is made of lowercase ASCII letters and digits, separated by single hyphens.
+func IsValidSlug(slug string) bool {
+	return len(slug) <= MaxSlugLength && slugPattern.MatchString(slug)
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_derive_slug_from_title(t *testing.T) {
+	tests := map[string]struct {
+		title        string
+		expectedSlug string
+	}{
+		"lowercase":               {"My Podcast", "my-podcast"},
+		"punctuation":             {"  Hello, World! (Part 2)  ", "hello-world-part-2"},
+		"accents":                 {"Café Crème", "cafe-creme"},
+		"special latin letters":   {"Straße Ærø Łódź", "strasse-aero-lodz"},
+		"cyrillic":                {"Привет мир", "privet-mir"},
+		"greek":                   {"Καλημέρα", "kalimera"},
+		"compatibility letters":   {"ﬁsh ２４", "fish-24"},
+		"without transliteration": {"日本語", "show"},
+		"empty":                   {"", "show"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expectedSlug, NewSlug(test.title))
+		})
+	}
+}
+
+func Test_should_shorten_long_slugs(t *testing.T) {
+	slug := NewSlug(strings.Repeat("a", MaxSlugLength-1) + " bc")
+
+	assert.Equal(t, strings.Repeat("a", MaxSlugLength-1), slug)
+	assert.True(t, IsValidSlug(slug))
+}
+
+func Test_should_validate_slug(t *testing.T) {
+	tests := map[string]struct {
+		slug  string
+		valid bool
+	}{
+		"words":           {"my-podcast-2", true},
+		"single word":     {"podcast", true},
+		"uppercase":       {"My-Podcast", false},
+		"space":           {"my podcast", false},
+		"double hyphen":   {"my--podcast", false},
+		"leading hyphen":  {"-podcast", false},
+		"trailing hyphen": {"podcast-", false},
+		"non ascii":       {"café", false},
+		"empty":           {"", false},
+		"too long":        {strings.Repeat("a", MaxSlugLength+1), false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.valid, IsValidSlug(test.slug))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package analytics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Anonymizer replaces remote addresses by keyed hashes. They identify a listener within the
+// deduplication window but cannot be reversed without the secret.
+type Anonymizer struct {
+	secret []byte
+}
+
+func NewAnonymizer(secret string) *Anonymizer {
+	return &Anonymizer{secret: []byte(secret)}
+}
+
+func (a *Anonymizer) HashRemoteAddress(address string) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(address))
+	return hex.EncodeToString(mac.Sum(nil))
+}
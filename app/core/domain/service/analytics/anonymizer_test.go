@@ -0,0 +1,19 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_hash_remote_addresses_with_secret(t *testing.T) {
+	anonymizer := NewAnonymizer("some-secret")
+
+	hash := anonymizer.HashRemoteAddress("192.0.2.1")
+
+	assert.Len(t, hash, 64)
+	assert.NotContains(t, hash, "192.0.2.1")
+	assert.Equal(t, hash, anonymizer.HashRemoteAddress("192.0.2.1"))
+	assert.NotEqual(t, hash, anonymizer.HashRemoteAddress("192.0.2.2"))
+	assert.NotEqual(t, hash, NewAnonymizer("other-secret").HashRemoteAddress("192.0.2.1"))
+}
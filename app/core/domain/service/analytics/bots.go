@@ -0,0 +1,37 @@
+package analytics
+
+import (
+	_ "embed"
+	"strings"
+)
+
+//go:embed bots.txt
+var botList string
+
+var botFragments = parseBotList(botList)
+
+func parseBotList(list string) []string {
+	var fragments []string
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			fragments = append(fragments, line)
+		}
+	}
+	return fragments
+}
+
+// IsBot reports whether the user agent belongs to a bot of the bundled list.
+// Requests without user agent are treated as bots as well.
+func IsBot(userAgent string) bool {
+	userAgent = strings.ToLower(strings.TrimSpace(userAgent))
+	if userAgent == "" {
+		return true
+	}
+	for _, fragment := range botFragments {
+		if strings.Contains(userAgent, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_detect_bots(t *testing.T) {
+	tests := map[string]bool{
+		"": true,
+		"Googlebot/2.1 (+http://www.google.com/bot.html)": true,
+		"curl/8.4.0":              true,
+		"Go-http-client/1.1":      true,
+		"python-requests/2.31.0":  true,
+		"facebookexternalhit/1.1": true,
+		"PodcastIndex.org/1.0":    true,
+		"AppleCoreMedia/1.0.0.20G75 (iPhone; U; CPU OS 16_6 like Mac OS X; de_de)": false,
+		"Spotify/8.8.56 Android/33 (Pixel 7)":                                      false,
+		"Overcast/3.0 (+http://overcast.fm/; iOS podcast app)":                     false,
+		"AntennaPod/3.2.0": false,
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36": false,
+	}
+
+	for userAgent, expected := range tests {
+		t.Run(userAgent, func(t *testing.T) {
+			assert.Equal(t, expected, IsBot(userAgent))
+		})
+	}
+}
+
+func Test_should_ignore_comments_of_bot_list(t *testing.T) {
+	fragments := parseBotList("# comment\n\nbot\n  spider  \n")
+
+	assert.Equal(t, []string{"bot", "spider"}, fragments)
+}
@@ -0,0 +1,63 @@
+package analytics
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ByteSpan is a half-open range [Start, End) of requested bytes.
+type ByteSpan struct {
+	Start int64
+	End   int64
+}
+
+// ByteRanges resolves a Range header against the size of the media to the half-open ranges of requested bytes.
+// Requests without or with a malformed header request the whole media, as they are answered with it. Each range
+// of a header with multiple ranges is returned on its own, so bytes between them do not count as requested.
+// Unsatisfiable ranges are left out, a request with only those requests no bytes at all.
+func ByteRanges(header string, size int64) []ByteSpan {
+	specs, found := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !found {
+		return []ByteSpan{{0, size}}
+	}
+
+	var spans []ByteSpan
+	for _, spec := range strings.Split(specs, ",") {
+		start, end, valid := parseRangeSpec(strings.TrimSpace(spec), size)
+		if !valid {
+			return []ByteSpan{{0, size}}
+		}
+		if start < end {
+			spans = append(spans, ByteSpan{start, end})
+		}
+	}
+	return spans
+}
+
+func parseRangeSpec(spec string, size int64) (start int64, end int64, valid bool) {
+	first, last, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, 0, false
+		}
+		return max(size-suffix, 0), size, true
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+	end = size
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, 0, false
+		}
+		end = min(end+1, size)
+	}
+	return min(start, size), end, true
+}
@@ -0,0 +1,36 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_resolve_byte_ranges(t *testing.T) {
+	tests := map[string]struct {
+		header   string
+		expected []ByteSpan
+	}{
+		"no range":                {"", []ByteSpan{{0, 1000}}},
+		"probe":                   {"bytes=0-1", []ByteSpan{{0, 2}}},
+		"closed":                  {"bytes=100-199", []ByteSpan{{100, 200}}},
+		"open":                    {"bytes=500-", []ByteSpan{{500, 1000}}},
+		"suffix":                  {"bytes=-100", []ByteSpan{{900, 1000}}},
+		"suffix beyond":           {"bytes=-5000", []ByteSpan{{0, 1000}}},
+		"end beyond size":         {"bytes=900-5000", []ByteSpan{{900, 1000}}},
+		"multiple ranges":         {"bytes=0-9, 500-599", []ByteSpan{{0, 10}, {500, 600}}},
+		"partially unsatisfiable": {"bytes=0-9, 2000-", []ByteSpan{{0, 10}}},
+		"unsatisfiable":           {"bytes=2000-", nil},
+		"other unit":              {"items=0-1", []ByteSpan{{0, 1000}}},
+		"malformed":               {"bytes=abc", []ByteSpan{{0, 1000}}},
+		"reversed":                {"bytes=200-100", []ByteSpan{{0, 1000}}},
+		"negative suffix":         {"bytes=--1", []ByteSpan{{0, 1000}}},
+		"with whitespace":         {" bytes=10-19 ", []ByteSpan{{10, 20}}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ByteRanges(test.header, 1000))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package analytics
+
+import (
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/useragent"
+	"sort"
+	"time"
+)
+
+const (
+	// DeduplicationWindow is the length of the windows in which requests of a listener form one download. The
+	// windows are aligned to days in UTC, so a download does not depend on requests before the queried period.
+	DeduplicationWindow = 24 * time.Hour
+	// MinimumPlayback is the duration of audio a listener has to fetch for a download to count.
+	MinimumPlayback = time.Minute
+	// fallbackBitrate estimates the audio duration of media which could not be analyzed.
+	fallbackBitrate = 128000
+)
+
+type listenerKey struct {
+	episodeId string
+	ipHash    string
+	userAgent string
+}
+
+type byteSpan struct {
+	start int64
+	end   int64
+}
+
+// CountDownloads applies the IAB podcast measurement rules to raw download events: Requests of bots and
+// requests without content are dropped, requests of the same IP and user agent on the same day in UTC
+// form one download, and a download only counts if the union of its requested byte ranges holds at least
+// one minute of audio. Downloads are counted on their day, if that day lies within [from, to], and attributed to the app and device detected from the user agent. Media of the
+// episodes is used to convert durations into bytes.
+func CountDownloads(events []*model.DownloadEvent, media map[string]*model.Media, from time.Time, to time.Time) []model.DownloadCount {
+	listeners := make(map[listenerKey][]*model.DownloadEvent)
+	for _, event := range events {
+		if event.Method != http.MethodGet || IsBot(event.UserAgent) {
+			continue
+		}
+		key := listenerKey{event.EpisodeId, event.IpHash, event.UserAgent}
+		listeners[key] = append(listeners[key], event)
+	}
+
+	firstDay, lastDay := dayOf(from), dayOf(to)
+	counts := make(map[model.DownloadCount]int)
+	for key, requests := range listeners {
+		threshold := minimumBytes(media[key.episodeId])
+		client := useragent.Detect(key.userAgent)
+		for _, day := range countedDownloads(requests, threshold) {
+			if day.Before(firstDay) || day.After(lastDay) {
+				continue
+			}
+			counts[model.DownloadCount{EpisodeId: key.episodeId, Day: day, App: client.App, Device: client.Device}]++
+		}
+	}
+
+	result := make([]model.DownloadCount, 0, len(counts))
+	for count, downloads := range counts {
+		count.Downloads = downloads
+		result = append(result, count)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Day.Equal(result[j].Day) {
+			return result[i].Day.Before(result[j].Day)
+		}
+		if result[i].EpisodeId != result[j].EpisodeId {
+			return result[i].EpisodeId < result[j].EpisodeId
+		}
+		if result[i].App != result[j].App {
+			return result[i].App < result[j].App
+		}
+		return result[i].Device < result[j].Device
+	})
+	return result
+}
+
+// countedDownloads groups the requests of one listener into deduplication windows and returns the start
+// of each window in which enough bytes were fetched. The windows do not depend on the first request given, so
+// the same requests count the same in every queried period.
+func countedDownloads(requests []*model.DownloadEvent, threshold int64) []time.Time {
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].RequestedAt.Before(requests[j].RequestedAt)
+	})
+
+	var starts []time.Time
+	for first := 0; first < len(requests); {
+		windowStart := windowOf(requests[first].RequestedAt)
+		var spans []byteSpan
+		next := first
+		for ; next < len(requests) && windowOf(requests[next].RequestedAt).Equal(windowStart); next++ {
+			spans = append(spans, byteSpan{requests[next].RangeStart, requests[next].RangeEnd})
+		}
+		if coveredBytes(spans) >= threshold {
+			starts = append(starts, windowStart)
+		}
+		first = next
+	}
+	return starts
+}
+
+func coveredBytes(spans []byteSpan) int64 {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	var covered, reached int64
+	for _, span := range spans {
+		start := max(span.start, reached)
+		if span.end > start {
+			covered += span.end - start
+			reached = span.end
+		}
+	}
+	return covered
+}
+
+// minimumBytes converts the minimum playback into bytes of the given media. Media shorter than that
+// counts once it was fetched completely.
+func minimumBytes(media *model.Media) int64 {
+	bitrate := int64(fallbackBitrate)
+	var size int64
+	if media != nil {
+		size = media.Size
+		switch {
+		case media.Bitrate > 0:
+			bitrate = int64(media.Bitrate)
+		case media.Duration > 0 && media.Size > 0:
+			bitrate = int64(float64(media.Size*8) / media.Duration.Seconds())
+		}
+	}
+
+	threshold := bitrate / 8 * int64(MinimumPlayback/time.Second)
+	if size > 0 && size < threshold {
+		return size
+	}
+	return max(threshold, 1)
+}
+
+func windowOf(t time.Time) time.Time {
+	return t.UTC().Truncate(DeduplicationWindow)
+}
+
+func dayOf(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,194 @@
+package analytics
+
+import (
+	"podGopher/core/domain/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	listenerApp = "AppleCoreMedia/1.0.0.20G75 (iPhone; U; CPU OS 16_6 like Mac OS X; de_de)"
+	oneMinute   = 960_000
+)
+
+var day1 = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+var day2 = day1.AddDate(0, 0, 1)
+var day3 = day1.AddDate(0, 0, 2)
+
+var testMedia = map[string]*model.Media{
+	"episode-1": {Key: "episode-1/episode.mp3", Size: 10 * oneMinute, Bitrate: 128000},
+	"episode-2": {Key: "episode-2/episode.mp3", Size: 10 * oneMinute, Bitrate: 128000},
+	"short":     {Key: "short/episode.mp3", Size: oneMinute / 2, Bitrate: 128000},
+}
+
+func request(episodeId string, at time.Time, start int64, end int64) *model.DownloadEvent {
+	return &model.DownloadEvent{
+		EpisodeId:   episodeId,
+		Method:      "GET",
+		IpHash:      "some-ip-hash",
+		UserAgent:   listenerApp,
+		RangeStart:  start,
+		RangeEnd:    end,
+		RequestedAt: at,
+	}
+}
+
+func with(event *model.DownloadEvent, change func(event *model.DownloadEvent)) *model.DownloadEvent {
+	change(event)
+	return event
+}
+
+func Test_should_count_downloads(t *testing.T) {
+	tests := map[string]struct {
+		events   []*model.DownloadEvent
+		expected []model.DownloadCount
+	}{
+		"complete download": {
+			[]*model.DownloadEvent{request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute)},
+			[]model.DownloadCount{{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1}},
+		},
+		"probe only": {
+			[]*model.DownloadEvent{request("episode-1", day1.Add(time.Hour), 0, 2)},
+			[]model.DownloadCount{},
+		},
+		"less than a minute": {
+			[]*model.DownloadEvent{request("episode-1", day1.Add(time.Hour), 0, oneMinute-1)},
+			[]model.DownloadCount{},
+		},
+		"head request": {
+			[]*model.DownloadEvent{with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+				event.Method = "HEAD"
+			})},
+			[]model.DownloadCount{},
+		},
+		"bot": {
+			[]*model.DownloadEvent{with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+				event.UserAgent = "Googlebot/2.1"
+			})},
+			[]model.DownloadCount{},
+		},
+		"ranges combined within window": {
+			[]*model.DownloadEvent{
+				request("episode-1", day1.Add(time.Hour), 0, 2),
+				request("episode-1", day1.Add(2*time.Hour), 0, oneMinute/2),
+				request("episode-1", day1.Add(3*time.Hour), oneMinute/4, oneMinute/2),
+				request("episode-1", day1.Add(23*time.Hour), oneMinute/2, oneMinute),
+			},
+			[]model.DownloadCount{{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1}},
+		},
+		"repeated requests within window": {
+			[]*model.DownloadEvent{
+				request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute),
+				request("episode-1", day1.Add(5*time.Hour), 0, 10*oneMinute),
+				request("episode-1", day1.Add(23*time.Hour+50*time.Minute), 0, 10*oneMinute),
+			},
+			[]model.DownloadCount{{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1}},
+		},
+		"requests after window": {
+			[]*model.DownloadEvent{
+				request("episode-1", day1.Add(23*time.Hour), 0, 10*oneMinute),
+				request("episode-1", day2.Add(time.Hour), 0, 10*oneMinute),
+			},
+			[]model.DownloadCount{
+				{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1},
+				{EpisodeId: "episode-1", Day: day2, App: "Apple Podcasts", Device: "phone", Downloads: 1},
+			},
+		},
+		"different listeners and episodes": {
+			[]*model.DownloadEvent{
+				request("episode-2", day1.Add(time.Hour), 0, 10*oneMinute),
+				request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute),
+				with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+					event.IpHash = "other-ip-hash"
+				}),
+				with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+					event.UserAgent = "Spotify/8.8.56 Android/33 (Pixel 7)"
+				}),
+			},
+			[]model.DownloadCount{
+				{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 2},
+				{EpisodeId: "episode-1", Day: day1, App: "Spotify", Device: "phone", Downloads: 1},
+				{EpisodeId: "episode-2", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1},
+			},
+		},
+		"unknown client": {
+			[]*model.DownloadEvent{with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+				event.UserAgent = "some unknown client"
+			})},
+			[]model.DownloadCount{{EpisodeId: "episode-1", Day: day1, App: "unknown", Device: "unknown", Downloads: 1}},
+		},
+		"short media fetched completely": {
+			[]*model.DownloadEvent{request("short", day1.Add(time.Hour), 0, oneMinute/2)},
+			[]model.DownloadCount{{EpisodeId: "short", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1}},
+		},
+		"unknown media": {
+			[]*model.DownloadEvent{
+				request("unknown", day1.Add(time.Hour), 0, oneMinute),
+				with(request("unknown", day1.Add(time.Hour), 0, oneMinute-1), func(event *model.DownloadEvent) {
+					event.IpHash = "other-ip-hash"
+				}),
+			},
+			[]model.DownloadCount{{EpisodeId: "unknown", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1}},
+		},
+		"ranges not combined across days": {
+			[]*model.DownloadEvent{
+				request("episode-1", day1.Add(23*time.Hour), 0, oneMinute/2),
+				request("episode-1", day2.Add(time.Hour), oneMinute/2, oneMinute),
+			},
+			[]model.DownloadCount{},
+		},
+		"outside of period": {
+			[]*model.DownloadEvent{
+				request("episode-1", day1.Add(-time.Hour), 0, 10*oneMinute),
+				request("episode-2", day3.Add(time.Hour), 0, 10*oneMinute),
+			},
+			[]model.DownloadCount{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			counts := CountDownloads(test.events, testMedia, day1, day2)
+
+			assert.Equal(t, test.expected, counts)
+		})
+	}
+}
+
+func Test_should_count_downloads_independent_of_requests_before_period(t *testing.T) {
+	withinPeriod := []*model.DownloadEvent{
+		request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute),
+		request("episode-1", day2.Add(time.Hour), 0, 10*oneMinute),
+	}
+	before := []*model.DownloadEvent{
+		request("episode-1", day1.Add(-23*time.Hour), 0, 2),
+		request("episode-1", day1.Add(-2*time.Hour), 0, 10*oneMinute),
+	}
+
+	counts := CountDownloads(withinPeriod, testMedia, day1, day2)
+	countsWithBefore := CountDownloads(append(before, withinPeriod...), testMedia, day1, day2)
+
+	assert.Equal(t, counts, countsWithBefore)
+	assert.Len(t, counts, 2)
+}
+
+func Test_should_estimate_minimum_bytes_of_media(t *testing.T) {
+	tests := map[string]struct {
+		media    *model.Media
+		expected int64
+	}{
+		"bitrate":           {&model.Media{Size: 10_000_000, Bitrate: 64000}, 480_000},
+		"size and duration": {&model.Media{Size: 9_600_000, Duration: 10 * time.Minute}, 960_000},
+		"short media":       {&model.Media{Size: 1000, Bitrate: 64000}, 1000},
+		"unanalyzed media":  {&model.Media{Size: 10_000_000}, 960_000},
+		"no media":          {nil, 960_000},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, minimumBytes(test.media))
+		})
+	}
+}
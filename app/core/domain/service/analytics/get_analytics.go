@@ -0,0 +1,137 @@
+package analytics
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"sort"
+)
+
+type GetAnalyticsService struct {
+	getShowOutPort         outbound.GetShowPort
+	getShowEpisodesOutPort outbound.GetShowEpisodesPort
+	getDownloadsOutPort    outbound.GetDownloadsPort
+}
+
+func NewGetAnalyticsService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetShowEpisodesPort,
+	downloadRepository outbound.GetDownloadsPort,
+) *GetAnalyticsService {
+	return &GetAnalyticsService{
+		getShowOutPort:         showRepository,
+		getShowEpisodesOutPort: episodeRepository,
+		getDownloadsOutPort:    downloadRepository,
+	}
+}
+
+func (service *GetAnalyticsService) GetAnalytics(ctx context.Context, command *inbound.GetAnalyticsCommand) (response *inbound.GetAnalyticsResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetAnalytics")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, err := service.getShowOutPort.GetShowOrNil(ctx, command.ShowId)
+	if err != nil {
+		return nil, err
+	}
+	if show == nil {
+		return nil, error2.NewShowNotFoundError(command.ShowId)
+	}
+
+	episodes, err := service.getShowEpisodesOutPort.GetEpisodesOfShow(ctx, command.ShowId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the deduplication windows are aligned to days, so the requests of the period are all that counts
+	from, to := dayOf(command.From), dayOf(command.To)
+	events, err := service.getDownloadsOutPort.GetDownloadsOfShow(ctx, command.ShowId, from, to.Add(DeduplicationWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	media := make(map[string]*model.Media, len(episodes))
+	for _, episode := range episodes {
+		media[episode.Id] = episode.Media
+	}
+	counts := CountDownloads(events, media, from, to)
+
+	return &inbound.GetAnalyticsResponse{
+		ShowId:   command.ShowId,
+		From:     from,
+		To:       to,
+		Total:    totalOf(counts),
+		Episodes: downloadsPerEpisode(episodes, counts),
+		Days:     downloadsPerDay(counts),
+		Groups:   downloadsPerGroup(command.GroupBy, counts),
+		Counts:   counts,
+	}, nil
+}
+
+func totalOf(counts []model.DownloadCount) int {
+	total := 0
+	for _, count := range counts {
+		total += count.Downloads
+	}
+	return total
+}
+
+// downloadsPerEpisode lists all episodes of the show, including those without downloads, most downloaded first.
+func downloadsPerEpisode(episodes []*model.Episode, counts []model.DownloadCount) []inbound.EpisodeDownloads {
+	downloads := make(map[string]int)
+	for _, count := range counts {
+		downloads[count.EpisodeId] += count.Downloads
+	}
+
+	result := make([]inbound.EpisodeDownloads, 0, len(episodes))
+	for _, episode := range episodes {
+		result = append(result, inbound.EpisodeDownloads{EpisodeId: episode.Id, Title: episode.Title, Downloads: downloads[episode.Id]})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Downloads > result[j].Downloads
+	})
+	return result
+}
+
+func downloadsPerDay(counts []model.DownloadCount) []inbound.DayDownloads {
+	var result []inbound.DayDownloads
+	for _, count := range counts {
+		if last := len(result) - 1; last >= 0 && result[last].Day.Equal(count.Day) {
+			result[last].Downloads += count.Downloads
+		} else {
+			result = append(result, inbound.DayDownloads{Day: count.Day, Downloads: count.Downloads})
+		}
+	}
+	return result
+}
+
+// downloadsPerGroup sums the downloads per app or device, most downloaded first. Without grouping it returns nil.
+func downloadsPerGroup(group inbound.AnalyticsGroup, counts []model.DownloadCount) []inbound.GroupDownloads {
+	if group == inbound.GroupByNone {
+		return nil
+	}
+
+	var result []inbound.GroupDownloads
+	index := make(map[string]int)
+	for _, count := range counts {
+		name := count.App
+		if group == inbound.GroupByDevice {
+			name = count.Device
+		}
+		if i, found := index[name]; found {
+			result[i].Downloads += count.Downloads
+		} else {
+			index[name] = len(result)
+			result = append(result, inbound.GroupDownloads{Name: name, Downloads: count.Downloads})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Downloads != result[j].Downloads {
+			return result[i].Downloads > result[j].Downloads
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
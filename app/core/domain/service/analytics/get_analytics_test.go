@@ -0,0 +1,169 @@
+package analytics
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var getAnalyticsService = NewGetAnalyticsService(mockGetShowAdapter, mockGetShowEpisodesAdapter, mockGetDownloadsAdapter)
+
+func newTestGetAnalyticsCommand() *inbound.GetAnalyticsCommand {
+	return &inbound.GetAnalyticsCommand{ShowId: "some-show-id", From: day1.Add(5 * time.Hour), To: day2}
+}
+
+func givenShowWithEpisodes() {
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = &model.Show{Id: "some-show-id"}
+	mockGetShowEpisodesAdapter.returnsOnGetEpisodesOfShow["some-show-id"] = []*model.Episode{
+		{Id: "episode-1", Title: "First", Media: testMedia["episode-1"]},
+		{Id: "episode-2", Title: "Second", Media: testMedia["episode-2"]},
+		{Id: "episode-3", Title: "Third"},
+	}
+}
+
+func Test_should_implement_GetAnalyticsInPort(t *testing.T) {
+	assert.NotNil(t, getAnalyticsService)
+	assert.Implements(t, (*inbound.GetAnalyticsPort)(nil), getAnalyticsService)
+}
+
+func Test_should_throw_error_if_show_does_not_exist_on_get_analytics(t *testing.T) {
+	defer initAdapter()
+
+	result, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowNotFoundError{Id: "some-show-id"}, err)
+}
+
+func Test_should_propagate_errors_from_adapters_on_get_analytics(t *testing.T) {
+	expectedError := errors.New("some error")
+
+	t.Run("show", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+		result, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("episodes", func(t *testing.T) {
+		defer initAdapter()
+		givenShowWithEpisodes()
+		mockGetShowEpisodesAdapter.withErrorOnGetEpisodes = expectedError
+
+		result, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("downloads", func(t *testing.T) {
+		defer initAdapter()
+		givenShowWithEpisodes()
+		mockGetDownloadsAdapter.withErrorOnGetDownloads = expectedError
+
+		result, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func Test_should_load_downloads_of_period(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisodes()
+
+	_, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+	assert.Nil(t, err)
+	assert.Equal(t, day1, mockGetDownloadsAdapter.calledWithFrom)
+	assert.Equal(t, day3, mockGetDownloadsAdapter.calledWithTo)
+}
+
+func Test_should_aggregate_downloads_per_episode_and_day(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisodes()
+	mockGetDownloadsAdapter.returnsOnGetDownloadsOfShow = []*model.DownloadEvent{
+		request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute),
+		request("episode-2", day1.Add(time.Hour), 0, 10*oneMinute),
+		request("episode-2", day2.Add(2*time.Hour), 0, 10*oneMinute),
+		with(request("episode-2", day2.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+			event.IpHash = "other-ip-hash"
+		}),
+	}
+
+	result, err := getAnalyticsService.GetAnalytics(t.Context(), newTestGetAnalyticsCommand())
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.GetAnalyticsResponse{
+		ShowId: "some-show-id",
+		From:   day1,
+		To:     day2,
+		Total:  4,
+		Episodes: []inbound.EpisodeDownloads{
+			{EpisodeId: "episode-2", Title: "Second", Downloads: 3},
+			{EpisodeId: "episode-1", Title: "First", Downloads: 1},
+			{EpisodeId: "episode-3", Title: "Third", Downloads: 0},
+		},
+		Days: []inbound.DayDownloads{
+			{Day: day1, Downloads: 2},
+			{Day: day2, Downloads: 2},
+		},
+		Counts: []model.DownloadCount{
+			{EpisodeId: "episode-1", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1},
+			{EpisodeId: "episode-2", Day: day1, App: "Apple Podcasts", Device: "phone", Downloads: 1},
+			{EpisodeId: "episode-2", Day: day2, App: "Apple Podcasts", Device: "phone", Downloads: 2},
+		},
+	}, result)
+}
+
+func Test_should_group_downloads_by_client(t *testing.T) {
+	givenDownloads := func() {
+		givenShowWithEpisodes()
+		mockGetDownloadsAdapter.returnsOnGetDownloadsOfShow = []*model.DownloadEvent{
+			request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute),
+			request("episode-2", day1.Add(time.Hour), 0, 10*oneMinute),
+			with(request("episode-1", day1.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+				event.UserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15"
+			}),
+			with(request("episode-1", day2.Add(time.Hour), 0, 10*oneMinute), func(event *model.DownloadEvent) {
+				event.UserAgent = "Spotify/8.8.56 iOS/17.0 (iPhone15,2)"
+			}),
+		}
+	}
+
+	tests := map[inbound.AnalyticsGroup][]inbound.GroupDownloads{
+		inbound.GroupByNone: nil,
+		inbound.GroupByApp: {
+			{Name: "Apple Podcasts", Downloads: 2},
+			{Name: "Safari", Downloads: 1},
+			{Name: "Spotify", Downloads: 1},
+		},
+		inbound.GroupByDevice: {
+			{Name: "phone", Downloads: 3},
+			{Name: "computer", Downloads: 1},
+		},
+	}
+
+	for group, expected := range tests {
+		t.Run(string(group), func(t *testing.T) {
+			defer initAdapter()
+			givenDownloads()
+			command := newTestGetAnalyticsCommand()
+			command.GroupBy = group
+
+			result, err := getAnalyticsService.GetAnalytics(t.Context(), command)
+
+			assert.Nil(t, err)
+			assert.Equal(t, 4, result.Total)
+			assert.Equal(t, expected, result.Groups)
+		})
+	}
+}
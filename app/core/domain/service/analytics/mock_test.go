@@ -0,0 +1,68 @@
+package analytics
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type getShowTestAdapter struct {
+	returnsOnGetOrNilShow   map[string]*model.Show
+	withErrorOnGetOrNilShow error
+}
+
+func (a *getShowTestAdapter) init() {
+	a.returnsOnGetOrNilShow = make(map[string]*model.Show)
+	a.withErrorOnGetOrNilShow = nil
+}
+
+func (a *getShowTestAdapter) GetShowOrNil(_ context.Context, id string) (*model.Show, error) {
+	return a.returnsOnGetOrNilShow[id], a.withErrorOnGetOrNilShow
+}
+
+type getShowEpisodesTestAdapter struct {
+	returnsOnGetEpisodesOfShow map[string][]*model.Episode
+	withErrorOnGetEpisodes     error
+}
+
+func (a *getShowEpisodesTestAdapter) init() {
+	a.returnsOnGetEpisodesOfShow = make(map[string][]*model.Episode)
+	a.withErrorOnGetEpisodes = nil
+}
+
+func (a *getShowEpisodesTestAdapter) GetEpisodesOfShow(_ context.Context, showId string) ([]*model.Episode, error) {
+	return a.returnsOnGetEpisodesOfShow[showId], a.withErrorOnGetEpisodes
+}
+
+type getDownloadsTestAdapter struct {
+	returnsOnGetDownloadsOfShow []*model.DownloadEvent
+	withErrorOnGetDownloads     error
+	calledWithFrom              time.Time
+	calledWithTo                time.Time
+}
+
+func (a *getDownloadsTestAdapter) init() {
+	a.returnsOnGetDownloadsOfShow = nil
+	a.withErrorOnGetDownloads = nil
+	a.calledWithFrom = time.Time{}
+	a.calledWithTo = time.Time{}
+}
+
+func (a *getDownloadsTestAdapter) GetDownloadsOfShow(_ context.Context, _ string, from time.Time, to time.Time) ([]*model.DownloadEvent, error) {
+	a.calledWithFrom, a.calledWithTo = from, to
+	return a.returnsOnGetDownloadsOfShow, a.withErrorOnGetDownloads
+}
+
+func initAdapter() {
+	mockGetShowAdapter.init()
+	mockGetShowEpisodesAdapter.init()
+	mockGetDownloadsAdapter.init()
+}
+
+var mockGetShowAdapter = new(getShowTestAdapter)
+var mockGetShowEpisodesAdapter = new(getShowEpisodesTestAdapter)
+var mockGetDownloadsAdapter = new(getDownloadsTestAdapter)
+
+func init() {
+	initAdapter()
+}
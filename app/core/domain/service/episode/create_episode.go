@@ -1,8 +1,10 @@
 package episode
 
 import (
+	"context"
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
 	"podGopher/core/port/inbound"
 	"podGopher/core/port/outbound"
 
@@ -21,23 +23,53 @@ func NewCreateEpisodeService(showRepository outbound.GetShowPort, episodeReposit
 	}
 }
 
-func (service CreateEpisodeService) CreateEpisode(command *inbound.CreateEpisodeCommand) (*inbound.CreateEpisodeResponse, error) {
-	if exists := service.saveEpisodeOutPort.ExistsByTitle(command.Title); exists != false {
-		return nil, error2.NewEpisodeAlreadyExistsError(command.Title)
+func (service CreateEpisodeService) CreateEpisode(ctx context.Context, command *inbound.CreateEpisodeCommand) (response *inbound.CreateEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CreateEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, err := requireShow(ctx, service.getShowOutPort, command.ShowId)
+	if err != nil {
+		return nil, err
 	}
-	if show, _ := service.getShowOutPort.GetShowOrNil(command.ShowId); show == nil {
-		return nil, error2.NewShowNotFoundError(command.ShowId)
+	if !show.AllowDuplicateEpisodeTitles {
+		// answers the usual case early, the repository refuses a title taken by a concurrent request on saving
+		exists, err := service.saveEpisodeOutPort.ExistsByTitle(ctx, show.Id, command.Title)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, error2.NewEpisodeAlreadyExistsError(command.Title)
+		}
 	}
 
 	id := uuid.NewString()
-	episode := &model.Episode{Id: id, ShowId: command.ShowId, Title: command.Title}
-	err := service.saveEpisodeOutPort.SaveEpisode(episode)
+	episode := &model.Episode{
+		Id:            id,
+		ShowId:        command.ShowId,
+		Title:         command.Title,
+		Description:   command.Description,
+		Season:        command.Season,
+		EpisodeNumber: command.EpisodeNumber,
+		Status:        model.EpisodeDraft,
+		Transcripts:   command.Transcripts,
+		Chapters:      command.Chapters,
+		Persons:       command.Persons,
+	}
+	err = service.saveEpisodeOutPort.SaveEpisode(ctx, episode)
 	if err != nil {
 		return nil, err
 	}
 	return &inbound.CreateEpisodeResponse{
-		Id:     episode.Id,
-		ShowId: episode.ShowId,
-		Title:  episode.Title,
+		Id:            episode.Id,
+		ShowId:        episode.ShowId,
+		Title:         episode.Title,
+		Description:   episode.Description,
+		Season:        episode.Season,
+		EpisodeNumber: episode.EpisodeNumber,
+		Status:        episode.Status,
+		PublishedAt:   episode.PublishedAt,
+		Transcripts:   episode.Transcripts,
+		Chapters:      episode.Chapters,
+		Persons:       episode.Persons,
 	}, nil
 }
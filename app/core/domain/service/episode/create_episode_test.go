@@ -21,9 +21,10 @@ func Test_should_throw_error_if_episode_with_name_already_exists(t *testing.T) {
 	defer initAdapter()
 
 	mockSaveAndGetEpisodeAdapter.everyExistsByTitleReturns("Test", true)
+	mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = &model.Show{Id: "test-show-id"}
 
 	command := newTestCreateEpisodeCommand("Test")
-	result, err := createEpisodeService.CreateEpisode(command)
+	result, err := createEpisodeService.CreateEpisode(t.Context(), command)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -31,6 +32,19 @@ func Test_should_throw_error_if_episode_with_name_already_exists(t *testing.T) {
 	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledSave)
 }
 
+func Test_should_create_episode_with_title_of_other_episode_if_show_allows_duplicates(t *testing.T) {
+	defer initAdapter()
+
+	mockSaveAndGetEpisodeAdapter.everyExistsByTitleReturns("Test", true)
+	mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = &model.Show{Id: "test-show-id", AllowDuplicateEpisodeTitles: true}
+
+	result, err := createEpisodeService.CreateEpisode(t.Context(), newTestCreateEpisodeCommand("Test"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Test", result.Title)
+	assert.Equal(t, 1, mockSaveAndGetEpisodeAdapter.calledSave)
+}
+
 func Test_should_propagate_errors_from_adapter_on_create_episode(t *testing.T) {
 	defer initAdapter()
 
@@ -39,7 +53,7 @@ func Test_should_propagate_errors_from_adapter_on_create_episode(t *testing.T) {
 	mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = &model.Show{Id: "test-show-id"}
 
 	command := newTestCreateEpisodeCommand("Fake")
-	result, err := createEpisodeService.CreateEpisode(command)
+	result, err := createEpisodeService.CreateEpisode(t.Context(), command)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -54,7 +68,7 @@ func Test_should_throw_error_if_show_does_not_exist_on_save_episode(t *testing.T
 	mockSaveAndGetEpisodeAdapter.everyExistsByTitleReturns("Test", false)
 	mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = nil
 
-	result, err := createEpisodeService.CreateEpisode(command)
+	result, err := createEpisodeService.CreateEpisode(t.Context(), command)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -69,24 +83,68 @@ func Test_should_save_a_new_episode(t *testing.T) {
 	mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = &model.Show{Id: "mocked-show-id"}
 	createEpisodeCommand := newTestCreateEpisodeCommand("Test")
 
-	result, err := createEpisodeService.CreateEpisode(createEpisodeCommand)
+	result, err := createEpisodeService.CreateEpisode(t.Context(), createEpisodeCommand)
 
 	savedEpisode := mockSaveAndGetEpisodeAdapter.onSaveCalledWith
 
 	expectedSavedEpisode := &model.Episode{
-		Id:     savedEpisode.Id,
-		ShowId: "test-show-id",
-		Title:  "Test",
+		Id:            savedEpisode.Id,
+		ShowId:        "test-show-id",
+		Title:         "Test",
+		Season:        1,
+		EpisodeNumber: 2,
+		Status:        model.EpisodeDraft,
+		Transcripts:   createEpisodeCommand.Transcripts,
+		Chapters:      createEpisodeCommand.Chapters,
+		Persons:       createEpisodeCommand.Persons,
 	}
 	assert.NotNil(t, savedEpisode)
 	assert.Equal(t, 1, mockSaveAndGetEpisodeAdapter.calledSave)
 	assert.Equal(t, expectedSavedEpisode, savedEpisode)
 	assert.NotEmpty(t, savedEpisode.Id)
+	assert.True(t, savedEpisode.PublishedAt.IsZero())
 
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
 	assert.IsType(t, (*inbound.CreateEpisodeResponse)(nil), result)
 
-	expectedCreatedEpisode := &inbound.CreateEpisodeResponse{Id: savedEpisode.Id, ShowId: "test-show-id", Title: "Test"}
+	expectedCreatedEpisode := &inbound.CreateEpisodeResponse{
+		Id:            savedEpisode.Id,
+		ShowId:        "test-show-id",
+		Title:         "Test",
+		Season:        1,
+		EpisodeNumber: 2,
+		Status:        model.EpisodeDraft,
+		Transcripts:   createEpisodeCommand.Transcripts,
+		Chapters:      createEpisodeCommand.Chapters,
+		Persons:       createEpisodeCommand.Persons,
+	}
 	assert.Equal(t, expectedCreatedEpisode, result)
 }
+
+func Test_should_not_save_episode_if_lookups_fail(t *testing.T) {
+	expectedError := error2.NewRepositoryError(error2.ConnectionFailure, errors.New("connection refused"))
+
+	t.Run("exists", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.returnsOnGetOrNilShow["test-show-id"] = &model.Show{Id: "test-show-id"}
+		mockSaveAndGetEpisodeAdapter.withErrorOnExists = expectedError
+
+		result, err := createEpisodeService.CreateEpisode(t.Context(), newTestCreateEpisodeCommand("Test"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledSave)
+	})
+
+	t.Run("show", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+		result, err := createEpisodeService.CreateEpisode(t.Context(), newTestCreateEpisodeCommand("Test"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledSave)
+	})
+}
@@ -0,0 +1,47 @@
+package episode
+
+import (
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"time"
+)
+
+// episodeCursor remembers the listing it was created for, so it cannot continue a listing of another
+// order or status.
+type episodeCursor struct {
+	Sort          model.EpisodeSort   `json:"s"`
+	Order         model.SortOrder     `json:"o"`
+	Status        model.EpisodeStatus `json:"f,omitempty"`
+	Id            string              `json:"i"`
+	PublishedAt   time.Time           `json:"p,omitempty"`
+	Season        int                 `json:"n,omitempty"`
+	EpisodeNumber int                 `json:"e,omitempty"`
+}
+
+func encodeCursor(query *model.EpisodeQuery, episode *model.Episode) string {
+	cursor := episodeCursor{Sort: query.Sort, Order: query.Order, Status: query.Status, Id: episode.Id}
+	switch query.Sort {
+	case model.EpisodeSortPublishedAt:
+		cursor.PublishedAt = episode.PublishedAt
+	case model.EpisodeSortNumber:
+		cursor.Season, cursor.EpisodeNumber = episode.Season, episode.EpisodeNumber
+	}
+	return pagination.EncodeCursor(cursor)
+}
+
+func decodeCursor(token string, query *model.EpisodeQuery) (*model.EpisodeKey, error) {
+	var cursor episodeCursor
+	if err := pagination.DecodeCursor(token, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.Id == "" || cursor.Sort != query.Sort || cursor.Order != query.Order || cursor.Status != query.Status {
+		return nil, error2.NewInvalidCursorError(token)
+	}
+	return &model.EpisodeKey{
+		Id:            cursor.Id,
+		PublishedAt:   cursor.PublishedAt,
+		Season:        cursor.Season,
+		EpisodeNumber: cursor.EpisodeNumber,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package episode
+
+import (
+	"context"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type DeleteEpisodeService struct {
+	getShowOutPort       outbound.GetShowPort
+	getEpisodeOutPort    outbound.GetEpisodePort
+	deleteEpisodeOutPort outbound.DeleteEpisodePort
+	mediaStorageOutPort  outbound.MediaStoragePort
+}
+
+func NewDeleteEpisodeService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetEpisodePort,
+	deleteEpisodeRepository outbound.DeleteEpisodePort,
+	mediaStorage outbound.MediaStoragePort,
+) *DeleteEpisodeService {
+	return &DeleteEpisodeService{
+		getShowOutPort:       showRepository,
+		getEpisodeOutPort:    episodeRepository,
+		deleteEpisodeOutPort: deleteEpisodeRepository,
+		mediaStorageOutPort:  mediaStorage,
+	}
+}
+
+// DeleteEpisode deletes an episode and its media. Recorded downloads are kept.
+func (service *DeleteEpisodeService) DeleteEpisode(ctx context.Context, command *inbound.DeleteEpisodeCommand) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "DeleteEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return err
+	}
+
+	if err = service.deleteEpisodeOutPort.DeleteEpisode(ctx, episode.Id); err != nil {
+		return err
+	}
+	if episode.Media != nil {
+		for _, key := range episode.Media.StorageKeys() {
+			_ = service.mediaStorageOutPort.DeleteMedia(ctx, key)
+		}
+	}
+	return nil
+}
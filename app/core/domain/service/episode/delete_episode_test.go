@@ -0,0 +1,50 @@
+package episode
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var deleteEpisodeService = NewDeleteEpisodeService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter, mockMediaStorageAdapter)
+
+func Test_should_implement_DeleteEpisodeInPort(t *testing.T) {
+	assert.NotNil(t, deleteEpisodeService)
+	assert.Implements(t, (*inbound.DeleteEpisodePort)(nil), deleteEpisodeService)
+}
+
+func Test_should_throw_error_if_episode_does_not_exist_on_delete_episode(t *testing.T) {
+	defer initAdapter()
+	givenShow()
+
+	err := deleteEpisodeService.DeleteEpisode(t.Context(), &inbound.DeleteEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Equal(t, error2.NewEpisodeNotFoundError("some-episode-id"), err)
+	assert.Empty(t, mockSaveAndGetEpisodeAdapter.deleted)
+}
+
+func Test_should_delete_episode_with_its_media(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+
+	err := deleteEpisodeService.DeleteEpisode(t.Context(), &inbound.DeleteEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"some-episode-id"}, mockSaveAndGetEpisodeAdapter.deleted)
+	assert.Equal(t, []string{"some-episode-id/episode.mp3", "some-episode-id/artwork.png"}, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_keep_media_if_episode_could_not_be_deleted(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+	expectedError := errors.New("some error")
+	mockSaveAndGetEpisodeAdapter.withErrorOnDeleteEpisode = expectedError
+
+	err := deleteEpisodeService.DeleteEpisode(t.Context(), &inbound.DeleteEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Equal(t, expectedError, err)
+	assert.Empty(t, mockMediaStorageAdapter.deleted)
+}
@@ -1,8 +1,10 @@
 package episode
 
 import (
+	"context"
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
 	"podGopher/core/port/inbound"
 	"podGopher/core/port/outbound"
 )
@@ -19,23 +21,40 @@ func NewGetEpisodeService(showRepository outbound.GetShowPort, episodeRepository
 	}
 }
 
-func (service *GetEpisodeService) GetEpisode(command *inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
-	if show, _ := service.getShowOutPort.GetShowOrNil(command.ShowId); show == nil {
-		return nil, error2.NewShowNotFoundError(command.ShowId)
+func (service *GetEpisodeService) GetEpisode(ctx context.Context, command *inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if _, err = requireShow(ctx, service.getShowOutPort, command.ShowId); err != nil {
+		return nil, err
 	}
 
 	var foundEpisode *model.Episode
-	if foundEpisode, err = service.getEpisodeOutPort.GetEpisodeOrNil(command.EpisodeId); err != nil {
+	if foundEpisode, err = service.getEpisodeOutPort.GetEpisodeOrNil(ctx, command.EpisodeId); err != nil {
 		return nil, err
 	}
 
-	if foundEpisode == nil {
+	// the episode is public, drafts, scheduled and unpublished episodes do not exist for the public
+	if foundEpisode == nil || foundEpisode.Status != model.EpisodePublished {
 		return nil, error2.NewEpisodeNotFoundError(command.EpisodeId)
 	}
 
+	return episodeResponseOf(foundEpisode), nil
+}
+
+func episodeResponseOf(episode *model.Episode) *inbound.GetEpisodeResponse {
 	return &inbound.GetEpisodeResponse{
-		Id:     foundEpisode.Id,
-		ShowId: foundEpisode.ShowId,
-		Title:  foundEpisode.Title,
-	}, nil
+		Id:            episode.Id,
+		ShowId:        episode.ShowId,
+		Title:         episode.Title,
+		Description:   episode.Description,
+		Season:        episode.Season,
+		EpisodeNumber: episode.EpisodeNumber,
+		Status:        episode.Status,
+		PublishedAt:   episode.PublishedAt,
+		Transcripts:   episode.Transcripts,
+		Chapters:      episode.Chapters,
+		Persons:       episode.Persons,
+		Media:         episode.Media,
+	}
 }
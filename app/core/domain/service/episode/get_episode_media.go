@@ -0,0 +1,95 @@
+package episode
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/analytics"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"time"
+)
+
+type GetEpisodeMediaService struct {
+	getEpisodeOutPort     outbound.GetEpisodePort
+	openMediaOutPort      outbound.OpenMediaPort
+	recordDownloadOutPort outbound.RecordDownloadPort
+	anonymizer            *analytics.Anonymizer
+}
+
+func NewGetEpisodeMediaService(
+	episodeRepository outbound.GetEpisodePort,
+	mediaStorage outbound.OpenMediaPort,
+	downloadRepository outbound.RecordDownloadPort,
+	anonymizer *analytics.Anonymizer,
+) *GetEpisodeMediaService {
+	return &GetEpisodeMediaService{
+		getEpisodeOutPort:     episodeRepository,
+		openMediaOutPort:      mediaStorage,
+		recordDownloadOutPort: downloadRepository,
+		anonymizer:            anonymizer,
+	}
+}
+
+// GetEpisodeMedia opens the enclosure or the embedded artwork of an episode. Every request of an enclosure
+// is recorded as download events, independent of method and range, as filtering is up to the analytics. Media of
+// episodes which are not published does not exist for listeners.
+func (service *GetEpisodeMediaService) GetEpisodeMedia(ctx context.Context, command *inbound.GetEpisodeMediaCommand) (response *inbound.GetEpisodeMediaResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetEpisodeMedia")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	key := command.EpisodeId + "/" + command.FileName
+
+	episode, err := service.getEpisodeOutPort.GetEpisodeOrNil(ctx, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+	if episode == nil || episode.Status != model.EpisodePublished || episode.Media == nil || !referencesMedia(episode.Media, key) {
+		return nil, error2.NewMediaNotFoundError(key)
+	}
+
+	media, err := service.openMediaOutPort.OpenMediaOrNil(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if media == nil {
+		return nil, error2.NewMediaNotFoundError(key)
+	}
+
+	if key == episode.Media.Key {
+		service.recordDownload(ctx, episode, key, media.Size, command)
+	}
+
+	return &inbound.GetEpisodeMediaResponse{FileName: command.FileName, Media: media}, nil
+}
+
+// recordDownload records an event for each range of the request, so the analytics unions the bytes actually
+// requested instead of the span between them. A request of no satisfiable range is recorded with an empty range.
+func (service *GetEpisodeMediaService) recordDownload(ctx context.Context, episode *model.Episode, key string, size int64, command *inbound.GetEpisodeMediaCommand) {
+	ranges := analytics.ByteRanges(command.Range, size)
+	if len(ranges) == 0 {
+		ranges = []analytics.ByteSpan{{}}
+	}
+	ipHash := service.anonymizer.HashRemoteAddress(command.RemoteAddress)
+	requestedAt := time.Now().UTC()
+	for _, span := range ranges {
+		// a failing analytics backend must not prevent the delivery of media
+		_ = service.recordDownloadOutPort.RecordDownload(ctx, &model.DownloadEvent{
+			EpisodeId:   episode.Id,
+			ShowId:      episode.ShowId,
+			MediaKey:    key,
+			Method:      command.Method,
+			IpHash:      ipHash,
+			UserAgent:   command.UserAgent,
+			Referrer:    command.Referrer,
+			RangeStart:  span.Start,
+			RangeEnd:    span.End,
+			RequestedAt: requestedAt,
+		})
+	}
+}
+
+func referencesMedia(media *model.Media, key string) bool {
+	return media.Key == key || (media.Artwork != nil && media.Artwork.Key == key)
+}
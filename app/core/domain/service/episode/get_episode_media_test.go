@@ -0,0 +1,200 @@
+package episode
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/analytics"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAnonymizer = analytics.NewAnonymizer("some-secret")
+
+var getEpisodeMediaService = NewGetEpisodeMediaService(mockSaveAndGetEpisodeAdapter, mockMediaStorageAdapter, mockRecordDownloadAdapter, testAnonymizer)
+
+var storedEpisodeMedia = &model.Media{
+	Key:     "some-episode-id/episode.mp3",
+	Artwork: &model.Artwork{Key: "some-episode-id/artwork.png", MimeType: "image/png"},
+}
+
+func newTestGetEpisodeMediaCommand(fileName string) *inbound.GetEpisodeMediaCommand {
+	return &inbound.GetEpisodeMediaCommand{
+		EpisodeId:     "some-episode-id",
+		FileName:      fileName,
+		Method:        "GET",
+		RemoteAddress: "192.0.2.1",
+		UserAgent:     "AppleCoreMedia/1.0.0",
+		Referrer:      "https://example.com",
+		Range:         "bytes=0-1",
+	}
+}
+
+func givenStoredMedia(keys ...string) {
+	mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"] = &model.Episode{
+		Id:     "some-episode-id",
+		ShowId: "some-show-id",
+		Status: model.EpisodePublished,
+		Media:  storedEpisodeMedia,
+	}
+	for _, key := range keys {
+		mockMediaStorageAdapter.returnsOnOpenMediaOrNil[key] = &model.MediaContent{Size: 1024}
+	}
+}
+
+func Test_should_implement_GetEpisodeMediaInPort(t *testing.T) {
+	assert.NotNil(t, getEpisodeMediaService)
+	assert.Implements(t, (*inbound.GetEpisodeMediaPort)(nil), getEpisodeMediaService)
+}
+
+func Test_should_open_media_and_record_download(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/episode.mp3")
+
+	result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand("episode.mp3"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.GetEpisodeMediaResponse{FileName: "episode.mp3", Media: &model.MediaContent{Size: 1024}}, result)
+	assert.Len(t, mockRecordDownloadAdapter.recorded, 1)
+	event := mockRecordDownloadAdapter.recorded[0]
+	assert.WithinDuration(t, time.Now(), event.RequestedAt, time.Minute)
+	event.RequestedAt = time.Time{}
+	assert.Equal(t, &model.DownloadEvent{
+		EpisodeId:  "some-episode-id",
+		ShowId:     "some-show-id",
+		MediaKey:   "some-episode-id/episode.mp3",
+		Method:     "GET",
+		IpHash:     testAnonymizer.HashRemoteAddress("192.0.2.1"),
+		UserAgent:  "AppleCoreMedia/1.0.0",
+		Referrer:   "https://example.com",
+		RangeStart: 0,
+		RangeEnd:   2,
+	}, event)
+}
+
+func Test_should_record_whole_media_as_requested_without_range(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/episode.mp3")
+	command := newTestGetEpisodeMediaCommand("episode.mp3")
+	command.Range = ""
+
+	_, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), command)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), mockRecordDownloadAdapter.recorded[0].RangeStart)
+	assert.Equal(t, int64(1024), mockRecordDownloadAdapter.recorded[0].RangeEnd)
+}
+
+func Test_should_record_each_of_multiple_ranges(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/episode.mp3")
+	command := newTestGetEpisodeMediaCommand("episode.mp3")
+	command.Range = "bytes=0-9, 500-599"
+
+	_, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), command)
+
+	assert.Nil(t, err)
+	require.Len(t, mockRecordDownloadAdapter.recorded, 2)
+	assert.Equal(t, []int64{0, 10}, []int64{mockRecordDownloadAdapter.recorded[0].RangeStart, mockRecordDownloadAdapter.recorded[0].RangeEnd})
+	assert.Equal(t, []int64{500, 600}, []int64{mockRecordDownloadAdapter.recorded[1].RangeStart, mockRecordDownloadAdapter.recorded[1].RangeEnd})
+	assert.Equal(t, mockRecordDownloadAdapter.recorded[0].RequestedAt, mockRecordDownloadAdapter.recorded[1].RequestedAt)
+}
+
+func Test_should_record_request_of_unsatisfiable_range_without_bytes(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/episode.mp3")
+	command := newTestGetEpisodeMediaCommand("episode.mp3")
+	command.Range = "bytes=2000-"
+
+	_, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), command)
+
+	assert.Nil(t, err)
+	require.Len(t, mockRecordDownloadAdapter.recorded, 1)
+	assert.Equal(t, int64(0), mockRecordDownloadAdapter.recorded[0].RangeEnd)
+}
+
+func Test_should_open_artwork_without_recording_download(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/artwork.png")
+
+	result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand("artwork.png"))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Media)
+	assert.Empty(t, mockRecordDownloadAdapter.recorded)
+}
+
+func Test_should_deliver_media_if_download_could_not_be_recorded(t *testing.T) {
+	defer initAdapter()
+	givenStoredMedia("some-episode-id/episode.mp3")
+	mockRecordDownloadAdapter.withErrorOnRecord = errors.New("some error")
+
+	result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand("episode.mp3"))
+
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+}
+
+func Test_should_throw_error_if_media_does_not_exist(t *testing.T) {
+	tests := map[string]struct {
+		given    func()
+		fileName string
+	}{
+		"unknown episode": {func() {}, "episode.mp3"},
+		"episode without media": {func() {
+			mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"] = &model.Episode{Id: "some-episode-id"}
+		}, "episode.mp3"},
+		"unpublished episode": {func() {
+			givenStoredMedia("some-episode-id/episode.mp3")
+			mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"].Status = model.EpisodeUnpublished
+		}, "episode.mp3"},
+		"draft episode": {func() {
+			givenStoredMedia("some-episode-id/episode.mp3")
+			mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"].Status = model.EpisodeDraft
+		}, "episode.mp3"},
+		"replaced file name":   {func() { givenStoredMedia("some-episode-id/old.mp3") }, "old.mp3"},
+		"missing from storage": {func() { givenStoredMedia() }, "episode.mp3"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+			test.given()
+
+			result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand(test.fileName))
+
+			assert.Nil(t, result)
+			assert.Equal(t, &error2.MediaNotFoundError{Key: "some-episode-id/" + test.fileName}, err)
+			assert.Empty(t, mockRecordDownloadAdapter.recorded)
+		})
+	}
+}
+
+func Test_should_propagate_errors_from_adapters_on_get_media(t *testing.T) {
+	expectedError := errors.New("some error")
+
+	t.Run("repository", func(t *testing.T) {
+		defer initAdapter()
+		mockSaveAndGetEpisodeAdapter.withErrorOnGetEpisodeOrNil = expectedError
+
+		result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand("episode.mp3"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("storage", func(t *testing.T) {
+		defer initAdapter()
+		givenStoredMedia()
+		mockMediaStorageAdapter.withErrorOnOpen = expectedError
+
+		result, err := getEpisodeMediaService.GetEpisodeMedia(t.Context(), newTestGetEpisodeMediaCommand("episode.mp3"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
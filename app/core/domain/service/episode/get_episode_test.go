@@ -26,7 +26,7 @@ func Test_should_throw_error_if_show_does_not_exist_on_get_episode(t *testing.T)
 
 	mockGetShowAdapter.returnsOnGetOrNilShow["i-do-not-exist"] = nil
 
-	result, err := getEpisodeService.GetEpisode(command)
+	result, err := getEpisodeService.GetEpisode(t.Context(), command)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -34,6 +34,18 @@ func Test_should_throw_error_if_show_does_not_exist_on_get_episode(t *testing.T)
 	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledGet)
 }
 
+func Test_should_not_report_missing_show_if_show_could_not_be_read_on_get_episode(t *testing.T) {
+	defer initAdapter()
+	expectedError := error2.NewRepositoryError(error2.ConnectionFailure, errors.New("connection refused"))
+	mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+	result, err := getEpisodeService.GetEpisode(t.Context(), &inbound.GetEpisodeCommand{EpisodeId: "some-episode-id", ShowId: "some-show-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledGet)
+}
+
 func Test_should_propagate_errors_from_adapter_on_get(t *testing.T) {
 	defer initAdapter()
 
@@ -42,7 +54,7 @@ func Test_should_propagate_errors_from_adapter_on_get(t *testing.T) {
 	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = expectedShow
 	mockSaveAndGetEpisodeAdapter.withErrorOnGetEpisodeOrNil = expectedError
 
-	foundEpisode, err := getEpisodeService.GetEpisode(&inbound.GetEpisodeCommand{EpisodeId: "id-with-error", ShowId: "some-show-id"})
+	foundEpisode, err := getEpisodeService.GetEpisode(t.Context(), &inbound.GetEpisodeCommand{EpisodeId: "id-with-error", ShowId: "some-show-id"})
 
 	assert.Nil(t, foundEpisode)
 	assert.NotNil(t, err)
@@ -56,7 +68,7 @@ func Test_should_return_not_found_if_episode_was_not_found_on_get(t *testing.T)
 	expectedShow := &model.Show{Id: "mocked-show-id"}
 	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = expectedShow
 
-	foundShow, err := getEpisodeService.GetEpisode(&inbound.GetEpisodeCommand{EpisodeId: "id-with-error", ShowId: "some-show-id"})
+	foundShow, err := getEpisodeService.GetEpisode(t.Context(), &inbound.GetEpisodeCommand{EpisodeId: "id-with-error", ShowId: "some-show-id"})
 
 	assert.Nil(t, foundShow)
 	assert.NotNil(t, err)
@@ -68,23 +80,48 @@ func Test_retrieve_episode_from_repository_on_get(t *testing.T) {
 	defer initAdapter()
 
 	expectedEpisode := &model.Episode{
-		Id:     "some-id",
-		ShowId: "some-show-id",
-		Title:  "some title",
+		Id:            "some-id",
+		ShowId:        "some-show-id",
+		Title:         "some title",
+		Season:        2,
+		EpisodeNumber: 3,
+		Status:        model.EpisodePublished,
+		Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Persons:       []model.Person{{Name: "some guest", Role: "guest"}},
 	}
 	expectedEpisodeResponse := &inbound.GetEpisodeResponse{
-		Id:     "some-id",
-		ShowId: "some-show-id",
-		Title:  "some title",
+		Id:            "some-id",
+		ShowId:        "some-show-id",
+		Title:         "some title",
+		Season:        2,
+		EpisodeNumber: 3,
+		Status:        model.EpisodePublished,
+		Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Persons:       []model.Person{{Name: "some guest", Role: "guest"}},
 	}
 	expectedShow := &model.Show{Id: "mocked-show-id"}
 	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = expectedShow
 	mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-id"] = expectedEpisode
 
-	foundEpisode, err := getEpisodeService.GetEpisode(&inbound.GetEpisodeCommand{EpisodeId: "some-id", ShowId: "some-show-id"})
+	foundEpisode, err := getEpisodeService.GetEpisode(t.Context(), &inbound.GetEpisodeCommand{EpisodeId: "some-id", ShowId: "some-show-id"})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, foundEpisode)
 	assert.Equal(t, expectedEpisodeResponse, foundEpisode)
 	assert.Equal(t, 1, mockSaveAndGetEpisodeAdapter.calledGet)
 }
+
+func Test_should_return_not_found_if_episode_is_not_published_on_get(t *testing.T) {
+	for _, status := range []model.EpisodeStatus{model.EpisodeDraft, model.EpisodeScheduled, model.EpisodeUnpublished} {
+		t.Run(string(status), func(t *testing.T) {
+			defer initAdapter()
+			mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = &model.Show{Id: "some-show-id"}
+			mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-id"] = &model.Episode{Id: "some-id", ShowId: "some-show-id", Status: status}
+
+			foundEpisode, err := getEpisodeService.GetEpisode(t.Context(), &inbound.GetEpisodeCommand{EpisodeId: "some-id", ShowId: "some-show-id"})
+
+			assert.Nil(t, foundEpisode)
+			assert.Equal(t, &error2.EpisodeNotFoundError{Id: "some-id"}, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package episode
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type ListEpisodesService struct {
+	getShowOutPort      outbound.GetShowPort
+	listEpisodesOutPort outbound.ListEpisodesPort
+}
+
+func NewListEpisodesService(showRepository outbound.GetShowPort, episodeRepository outbound.ListEpisodesPort) *ListEpisodesService {
+	return &ListEpisodesService{
+		getShowOutPort:      showRepository,
+		listEpisodesOutPort: episodeRepository,
+	}
+}
+
+func (service *ListEpisodesService) ListEpisodes(ctx context.Context, command *inbound.ListEpisodesCommand) (response *inbound.ListEpisodesResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ListEpisodes")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if _, err := requireShow(ctx, service.getShowOutPort, command.ShowId); err != nil {
+		return nil, err
+	}
+
+	query := &model.EpisodeQuery{
+		ShowId: command.ShowId,
+		Status: model.EpisodePublished,
+		Sort:   command.Sort,
+		Order:  command.Order,
+		Limit:  pagination.PageSize(command.Limit),
+	}
+	if query.Sort == "" {
+		query.Sort = model.EpisodeSortPublishedAt
+	}
+	if query.Order == "" {
+		query.Order = model.Descending
+	}
+	if command.Cursor != "" {
+		after, err := decodeCursor(command.Cursor, query)
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	// one episode more than requested tells whether another page follows
+	pageSize := query.Limit
+	query.Limit++
+	episodes, err := service.listEpisodesOutPort.ListEpisodes(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &inbound.ListEpisodesResponse{Episodes: []*inbound.GetEpisodeResponse{}}
+	if len(episodes) > pageSize {
+		episodes = episodes[:pageSize]
+		response.NextCursor = encodeCursor(query, episodes[pageSize-1])
+	}
+	for _, episode := range episodes {
+		response.Episodes = append(response.Episodes, episodeResponseOf(episode))
+	}
+	return response, nil
+}
@@ -0,0 +1,177 @@
+package episode
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var listEpisodesService = NewListEpisodesService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter)
+
+var somePublishedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func givenShow() {
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = &model.Show{Id: "some-show-id"}
+}
+
+func someEpisodes(count int) []*model.Episode {
+	var episodes []*model.Episode
+	for i := 0; i < count; i++ {
+		episodes = append(episodes, &model.Episode{
+			Id:            string(rune('a'+i)) + "-id",
+			ShowId:        "some-show-id",
+			Title:         string(rune('A'+i)) + " Title",
+			Season:        1,
+			EpisodeNumber: i + 1,
+			Status:        model.EpisodePublished,
+			PublishedAt:   somePublishedAt.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return episodes
+}
+
+func Test_should_implement_ListEpisodesInPort(t *testing.T) {
+	assert.NotNil(t, listEpisodesService)
+	assert.Implements(t, (*inbound.ListEpisodesPort)(nil), listEpisodesService)
+}
+
+func Test_should_throw_error_if_show_does_not_exist_on_list_episodes(t *testing.T) {
+	defer initAdapter()
+
+	result, err := listEpisodesService.ListEpisodes(t.Context(), &inbound.ListEpisodesCommand{ShowId: "some-show-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewShowNotFoundError("some-show-id"), err)
+	assert.Nil(t, mockSaveAndGetEpisodeAdapter.onListEpisodesCalledWith)
+}
+
+func Test_should_default_query_on_list_episodes(t *testing.T) {
+	tests := map[string]struct {
+		command  *inbound.ListEpisodesCommand
+		expected *model.EpisodeQuery
+	}{
+		"defaults": {
+			&inbound.ListEpisodesCommand{ShowId: "some-show-id"},
+			&model.EpisodeQuery{ShowId: "some-show-id", Status: model.EpisodePublished, Sort: model.EpisodeSortPublishedAt, Order: model.Descending, Limit: pagination.DefaultPageSize + 1},
+		},
+		"given values": {
+			&inbound.ListEpisodesCommand{ShowId: "some-show-id", Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 5},
+			&model.EpisodeQuery{ShowId: "some-show-id", Status: model.EpisodePublished, Sort: model.EpisodeSortNumber, Order: model.Ascending, Limit: 6},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+			givenShow()
+
+			_, err := listEpisodesService.ListEpisodes(t.Context(), test.command)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, mockSaveAndGetEpisodeAdapter.onListEpisodesCalledWith)
+		})
+	}
+}
+
+func Test_should_return_full_episodes(t *testing.T) {
+	defer initAdapter()
+	givenShow()
+	episodes := someEpisodes(1)
+	episodes[0].Media = &model.Media{Key: "a-id/episode.mp3", FileName: "episode.mp3", MimeType: "audio/mpeg", Size: 1024}
+	mockSaveAndGetEpisodeAdapter.returnsOnListEpisodes = episodes
+
+	result, err := listEpisodesService.ListEpisodes(t.Context(), &inbound.ListEpisodesCommand{ShowId: "some-show-id"})
+
+	assert.Nil(t, err)
+	assert.Empty(t, result.NextCursor)
+	assert.Equal(t, []*inbound.GetEpisodeResponse{{
+		Id:            "a-id",
+		ShowId:        "some-show-id",
+		Title:         "A Title",
+		Season:        1,
+		EpisodeNumber: 1,
+		Status:        model.EpisodePublished,
+		PublishedAt:   somePublishedAt,
+		Media:         episodes[0].Media,
+	}}, result.Episodes)
+}
+
+func Test_should_return_empty_page_of_episodes(t *testing.T) {
+	defer initAdapter()
+	givenShow()
+
+	result, err := listEpisodesService.ListEpisodes(t.Context(), &inbound.ListEpisodesCommand{ShowId: "some-show-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ListEpisodesResponse{Episodes: []*inbound.GetEpisodeResponse{}}, result)
+}
+
+func Test_should_continue_listing_episodes_after_cursor(t *testing.T) {
+	tests := map[model.EpisodeSort]*model.EpisodeKey{
+		model.EpisodeSortPublishedAt: {Id: "b-id", PublishedAt: somePublishedAt.Add(time.Hour)},
+		model.EpisodeSortNumber:      {Id: "b-id", Season: 1, EpisodeNumber: 2},
+	}
+
+	for sort, expectedKey := range tests {
+		t.Run(string(sort), func(t *testing.T) {
+			defer initAdapter()
+			givenShow()
+			mockSaveAndGetEpisodeAdapter.returnsOnListEpisodes = someEpisodes(3)
+			command := &inbound.ListEpisodesCommand{ShowId: "some-show-id", Sort: sort, Limit: 2}
+
+			firstPage, err := listEpisodesService.ListEpisodes(t.Context(), command)
+
+			assert.Nil(t, err)
+			assert.Len(t, firstPage.Episodes, 2)
+			assert.NotEmpty(t, firstPage.NextCursor)
+
+			command.Cursor = firstPage.NextCursor
+			_, err = listEpisodesService.ListEpisodes(t.Context(), command)
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectedKey, mockSaveAndGetEpisodeAdapter.onListEpisodesCalledWith.After)
+		})
+	}
+}
+
+func Test_should_reject_cursor_of_other_listing(t *testing.T) {
+	cursor := encodeCursor(&model.EpisodeQuery{Status: model.EpisodePublished, Sort: model.EpisodeSortPublishedAt, Order: model.Descending}, someEpisodes(1)[0])
+
+	tests := map[string]*inbound.ListEpisodesCommand{
+		"invalid":      {ShowId: "some-show-id", Cursor: "not a cursor!"},
+		"other sort":   {ShowId: "some-show-id", Cursor: cursor, Sort: model.EpisodeSortNumber},
+		"other order":  {ShowId: "some-show-id", Cursor: cursor, Order: model.Ascending},
+		"other status": {ShowId: "some-show-id", Cursor: encodeCursor(&model.EpisodeQuery{Status: model.EpisodeDraft, Sort: model.EpisodeSortPublishedAt, Order: model.Descending}, someEpisodes(1)[0])},
+	}
+
+	for name, command := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+			givenShow()
+
+			result, err := listEpisodesService.ListEpisodes(t.Context(), command)
+
+			assert.Nil(t, result)
+			assert.Equal(t, error2.NewInvalidCursorError(command.Cursor), err)
+			assert.Nil(t, mockSaveAndGetEpisodeAdapter.onListEpisodesCalledWith)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_list_episodes(t *testing.T) {
+	defer initAdapter()
+	givenShow()
+	expectedError := errors.New("some error")
+	mockSaveAndGetEpisodeAdapter.withErrorOnListEpisodes = expectedError
+
+	result, err := listEpisodesService.ListEpisodes(t.Context(), &inbound.ListEpisodesCommand{ShowId: "some-show-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
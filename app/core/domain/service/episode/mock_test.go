@@ -1,23 +1,47 @@
 package episode
 
 import (
+	"context"
+	"io"
 	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
+	"time"
 )
 
 type saveAndGetEpisodeTestAdapter struct {
 	calledGet                  int
 	calledSave                 int
+	calledSaveMedia            int
 	onSaveCalledWith           *model.Episode
+	onSaveMediaCalledWith      *model.Media
+	withErrorOnSaveMedia       error
 	returnsOnExistsByTitle     map[string]bool
+	withErrorOnExists          error
 	withErrorOnSaveEpisode     error
 	withErrorOnGetEpisodeOrNil error
 	returnsOnGetEpisodeOrNil   map[string]*model.Episode
+	onListEpisodesCalledWith   *model.EpisodeQuery
+	returnsOnListEpisodes      []*model.Episode
+	withErrorOnListEpisodes    error
+	calledUpdate               int
+	onUpdateCalledWith         *model.Episode
+	returnsOnExistsOtherTitle  bool
+	withErrorOnUpdateEpisode   error
+	deleted                    []string
+	withErrorOnDeleteEpisode   error
+	calledChangeStatus         int
+	onChangeStatusCalledWith   *model.Episode
+	onChangeStatusFrom         model.EpisodeStatus
+	withErrorOnChangeStatus    error
+	onPublishDueCalledWith     time.Time
+	returnsOnPublishDue        []string
+	withErrorOnPublishDue      error
 }
 
 type getShowTestAdapter struct {
-	called                int
-	returnsOnGetOrNilShow map[string]*model.Show
+	called                  int
+	returnsOnGetOrNilShow   map[string]*model.Show
+	withErrorOnGetOrNilShow error
 }
 
 func newSaveAndGetEpisodeTestAdapter() *saveAndGetEpisodeTestAdapter {
@@ -28,50 +52,114 @@ func newSaveAndGetEpisodeTestAdapter() *saveAndGetEpisodeTestAdapter {
 
 func newTestCreateEpisodeCommand(title string) *inbound.CreateEpisodeCommand {
 	episode := &inbound.CreateEpisodeCommand{
-		ShowId: "test-show-id",
-		Title:  title,
+		ShowId:        "test-show-id",
+		Title:         title,
+		Season:        1,
+		EpisodeNumber: 2,
+		Transcripts:   []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt"}},
+		Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Persons:       []model.Person{{Name: "some guest", Role: "guest"}},
 	}
 	return episode
 }
 
-func (adapter *saveAndGetEpisodeTestAdapter) SaveEpisode(episode *model.Episode) error {
+func (adapter *saveAndGetEpisodeTestAdapter) SaveEpisode(_ context.Context, episode *model.Episode) error {
 	adapter.calledSave++
 	adapter.onSaveCalledWith = episode
 	return adapter.withErrorOnSaveEpisode
 }
 
-func (a *getShowTestAdapter) GetShowOrNil(id string) (*model.Show, error) {
+func (a *getShowTestAdapter) GetShowOrNil(_ context.Context, id string) (*model.Show, error) {
 	a.called++
 	show := a.returnsOnGetOrNilShow[id]
-	return show, nil
+	return show, a.withErrorOnGetOrNilShow
 }
 
 func (adapter *saveAndGetEpisodeTestAdapter) init() {
 	adapter.calledGet = 0
 	adapter.calledSave = 0
+	adapter.calledSaveMedia = 0
 	adapter.onSaveCalledWith = nil
+	adapter.onSaveMediaCalledWith = nil
+	adapter.withErrorOnSaveMedia = nil
 	adapter.returnsOnExistsByTitle = make(map[string]bool)
+	adapter.withErrorOnExists = nil
 	adapter.returnsOnGetEpisodeOrNil = make(map[string]*model.Episode)
 	adapter.withErrorOnSaveEpisode = nil
 	adapter.withErrorOnGetEpisodeOrNil = nil
+	adapter.onListEpisodesCalledWith = nil
+	adapter.returnsOnListEpisodes = nil
+	adapter.withErrorOnListEpisodes = nil
+	adapter.calledUpdate = 0
+	adapter.onUpdateCalledWith = nil
+	adapter.returnsOnExistsOtherTitle = false
+	adapter.withErrorOnUpdateEpisode = nil
+	adapter.deleted = nil
+	adapter.withErrorOnDeleteEpisode = nil
+	adapter.calledChangeStatus = 0
+	adapter.onChangeStatusCalledWith = nil
+	adapter.onChangeStatusFrom = ""
+	adapter.withErrorOnChangeStatus = nil
+	adapter.onPublishDueCalledWith = time.Time{}
+	adapter.returnsOnPublishDue = nil
+	adapter.withErrorOnPublishDue = nil
 }
 
 func (adapter *saveAndGetEpisodeTestAdapter) everyExistsByTitleReturns(title string, returnValue bool) {
 	adapter.returnsOnExistsByTitle[title] = returnValue
 }
 
-func (adapter *saveAndGetEpisodeTestAdapter) ExistsByTitle(title string) bool {
-	return adapter.returnsOnExistsByTitle[title]
+func (adapter *saveAndGetEpisodeTestAdapter) ExistsByTitle(_ context.Context, _ string, title string) (bool, error) {
+	return adapter.returnsOnExistsByTitle[title], adapter.withErrorOnExists
 }
 
-func (adapter *saveAndGetEpisodeTestAdapter) GetEpisodeOrNil(id string) (*model.Episode, error) {
+func (adapter *saveAndGetEpisodeTestAdapter) SaveEpisodeMedia(_ context.Context, episodeId string, media *model.Media) error {
+	adapter.calledSaveMedia++
+	adapter.onSaveMediaCalledWith = media
+	return adapter.withErrorOnSaveMedia
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) GetEpisodeOrNil(_ context.Context, id string) (*model.Episode, error) {
 	adapter.calledGet++
 	return adapter.returnsOnGetEpisodeOrNil[id], adapter.withErrorOnGetEpisodeOrNil
 }
 
+func (adapter *saveAndGetEpisodeTestAdapter) ListEpisodes(_ context.Context, query *model.EpisodeQuery) ([]*model.Episode, error) {
+	adapter.onListEpisodesCalledWith = query
+	return adapter.returnsOnListEpisodes, adapter.withErrorOnListEpisodes
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) UpdateEpisode(_ context.Context, episode *model.Episode) error {
+	adapter.calledUpdate++
+	adapter.onUpdateCalledWith = episode
+	return adapter.withErrorOnUpdateEpisode
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) ExistsOtherByTitle(context.Context, string, string, string) (bool, error) {
+	return adapter.returnsOnExistsOtherTitle, nil
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) DeleteEpisode(_ context.Context, id string) error {
+	adapter.deleted = append(adapter.deleted, id)
+	return adapter.withErrorOnDeleteEpisode
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) ChangeEpisodeStatus(_ context.Context, episode *model.Episode, from model.EpisodeStatus) error {
+	adapter.calledChangeStatus++
+	adapter.onChangeStatusCalledWith = episode
+	adapter.onChangeStatusFrom = from
+	return adapter.withErrorOnChangeStatus
+}
+
+func (adapter *saveAndGetEpisodeTestAdapter) PublishDueEpisodes(_ context.Context, now time.Time) ([]string, error) {
+	adapter.onPublishDueCalledWith = now
+	return adapter.returnsOnPublishDue, adapter.withErrorOnPublishDue
+}
+
 func (a *getShowTestAdapter) init() {
 	a.called = 0
 	a.returnsOnGetOrNilShow = make(map[string]*model.Show)
+	a.withErrorOnGetOrNilShow = nil
 }
 
 func newGetShowTestAdapter() *getShowTestAdapter {
@@ -80,10 +168,71 @@ func newGetShowTestAdapter() *getShowTestAdapter {
 	return adapter
 }
 
+type mediaStorageTestAdapter struct {
+	saved                   map[string]string
+	deleted                 []string
+	withErrorOnSave         error
+	returnsSizeOnSave       int64
+	returnsOnOpenMediaOrNil map[string]*model.MediaContent
+	withErrorOnOpen         error
+}
+
+func newMediaStorageTestAdapter() *mediaStorageTestAdapter {
+	adapter := &mediaStorageTestAdapter{}
+	adapter.init()
+	return adapter
+}
+
+func (a *mediaStorageTestAdapter) init() {
+	a.saved = make(map[string]string)
+	a.deleted = nil
+	a.withErrorOnSave = nil
+	a.returnsSizeOnSave = 0
+	a.returnsOnOpenMediaOrNil = make(map[string]*model.MediaContent)
+	a.withErrorOnOpen = nil
+}
+
+func (a *mediaStorageTestAdapter) SaveMedia(_ context.Context, key string, content io.Reader, mimeType string) (int64, error) {
+	if a.withErrorOnSave != nil {
+		return 0, a.withErrorOnSave
+	}
+	data, _ := io.ReadAll(content)
+	a.saved[key] = string(data)
+	return int64(len(data)), nil
+}
+
+func (a *mediaStorageTestAdapter) DeleteMedia(_ context.Context, key string) error {
+	a.deleted = append(a.deleted, key)
+	return nil
+}
+
+func (a *mediaStorageTestAdapter) OpenMediaOrNil(_ context.Context, key string) (*model.MediaContent, error) {
+	return a.returnsOnOpenMediaOrNil[key], a.withErrorOnOpen
+}
+
+type recordDownloadTestAdapter struct {
+	recorded          []*model.DownloadEvent
+	withErrorOnRecord error
+}
+
+func (a *recordDownloadTestAdapter) init() {
+	a.recorded = nil
+	a.withErrorOnRecord = nil
+}
+
+func (a *recordDownloadTestAdapter) RecordDownload(_ context.Context, event *model.DownloadEvent) error {
+	a.recorded = append(a.recorded, event)
+	return a.withErrorOnRecord
+}
+
 func initAdapter() {
 	mockGetShowAdapter.init()
 	mockSaveAndGetEpisodeAdapter.init()
+	mockMediaStorageAdapter.init()
+	mockRecordDownloadAdapter.init()
 }
 
 var mockSaveAndGetEpisodeAdapter = newSaveAndGetEpisodeTestAdapter()
 var mockGetShowAdapter = newGetShowTestAdapter()
+var mockMediaStorageAdapter = newMediaStorageTestAdapter()
+var mockRecordDownloadAdapter = new(recordDownloadTestAdapter)
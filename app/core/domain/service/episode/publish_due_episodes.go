@@ -0,0 +1,31 @@
+package episode
+
+import (
+	"context"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type PublishDueEpisodesService struct {
+	changeEpisodeStatusOutPort outbound.ChangeEpisodeStatusPort
+}
+
+func NewPublishDueEpisodesService(statusRepository outbound.ChangeEpisodeStatusPort) *PublishDueEpisodesService {
+	return &PublishDueEpisodesService{
+		changeEpisodeStatusOutPort: statusRepository,
+	}
+}
+
+// PublishDueEpisodes keeps the publish date of the episodes, so they appear in the feed at their planned time
+// even if they are published late.
+func (service *PublishDueEpisodesService) PublishDueEpisodes(ctx context.Context, command *inbound.PublishDueEpisodesCommand) (response *inbound.PublishDueEpisodesResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PublishDueEpisodes")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	episodeIds, err := service.changeEpisodeStatusOutPort.PublishDueEpisodes(ctx, command.Now)
+	if err != nil {
+		return nil, err
+	}
+	return &inbound.PublishDueEpisodesResponse{EpisodeIds: episodeIds}, nil
+}
@@ -0,0 +1,40 @@
+package episode
+
+import (
+	"errors"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var publishDueEpisodesService = NewPublishDueEpisodesService(mockSaveAndGetEpisodeAdapter)
+
+func Test_should_implement_PublishDueEpisodesInPort(t *testing.T) {
+	assert.NotNil(t, publishDueEpisodesService)
+	assert.Implements(t, (*inbound.PublishDueEpisodesPort)(nil), publishDueEpisodesService)
+}
+
+func Test_should_publish_due_episodes(t *testing.T) {
+	defer initAdapter()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockSaveAndGetEpisodeAdapter.returnsOnPublishDue = []string{"first-episode-id", "second-episode-id"}
+
+	result, err := publishDueEpisodesService.PublishDueEpisodes(t.Context(), &inbound.PublishDueEpisodesCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.PublishDueEpisodesResponse{EpisodeIds: []string{"first-episode-id", "second-episode-id"}}, result)
+	assert.Equal(t, now, mockSaveAndGetEpisodeAdapter.onPublishDueCalledWith)
+}
+
+func Test_should_propagate_errors_on_publish_due_episodes(t *testing.T) {
+	defer initAdapter()
+	expectedError := errors.New("some error")
+	mockSaveAndGetEpisodeAdapter.withErrorOnPublishDue = expectedError
+
+	result, err := publishDueEpisodesService.PublishDueEpisodes(t.Context(), &inbound.PublishDueEpisodesCommand{Now: time.Now()})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
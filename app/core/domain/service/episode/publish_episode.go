@@ -0,0 +1,66 @@
+package episode
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"time"
+)
+
+type PublishEpisodeService struct {
+	getShowOutPort             outbound.GetShowPort
+	getEpisodeOutPort          outbound.GetEpisodePort
+	changeEpisodeStatusOutPort outbound.ChangeEpisodeStatusPort
+}
+
+func NewPublishEpisodeService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetEpisodePort,
+	statusRepository outbound.ChangeEpisodeStatusPort,
+) *PublishEpisodeService {
+	return &PublishEpisodeService{
+		getShowOutPort:             showRepository,
+		getEpisodeOutPort:          episodeRepository,
+		changeEpisodeStatusOutPort: statusRepository,
+	}
+}
+
+func (service *PublishEpisodeService) PublishEpisode(ctx context.Context, command *inbound.PublishEpisodeCommand) (response *inbound.GetEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "PublishEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// an unpublished episode returns to its former place in the feed
+	publishedAt := episode.PublishedAt
+	if episode.Status != model.EpisodeUnpublished {
+		publishedAt = time.Now().UTC()
+	}
+	return changeEpisodeStatus(ctx, service.changeEpisodeStatusOutPort, episode, model.EpisodePublished, publishedAt)
+}
+
+// changeEpisodeStatus moves an episode on to the status and publish date, if its lifecycle allows it.
+func changeEpisodeStatus(
+	ctx context.Context,
+	repository outbound.ChangeEpisodeStatusPort,
+	episode *model.Episode,
+	status model.EpisodeStatus,
+	publishedAt time.Time,
+) (*inbound.GetEpisodeResponse, error) {
+	from := episode.Status
+	if !from.CanChangeTo(status) {
+		return nil, error2.NewEpisodeStatusChangeError(episode.Id, string(from), string(status))
+	}
+
+	episode.Status, episode.PublishedAt = status, publishedAt
+	if err := repository.ChangeEpisodeStatus(ctx, episode, from); err != nil {
+		return nil, err
+	}
+	return episodeResponseOf(episode), nil
+}
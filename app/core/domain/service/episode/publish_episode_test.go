@@ -0,0 +1,87 @@
+package episode
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var publishEpisodeService = NewPublishEpisodeService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter)
+
+func givenEpisodeWithStatus(status model.EpisodeStatus) *model.Episode {
+	episode := givenExistingEpisode()
+	episode.Status = status
+	return episode
+}
+
+func Test_should_implement_PublishEpisodeInPort(t *testing.T) {
+	assert.NotNil(t, publishEpisodeService)
+	assert.Implements(t, (*inbound.PublishEpisodePort)(nil), publishEpisodeService)
+}
+
+func Test_should_publish_episode_now(t *testing.T) {
+	for _, status := range []model.EpisodeStatus{model.EpisodeDraft, model.EpisodeScheduled} {
+		t.Run(string(status), func(t *testing.T) {
+			defer initAdapter()
+			givenEpisodeWithStatus(status)
+
+			result, err := publishEpisodeService.PublishEpisode(t.Context(), &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+			assert.Nil(t, err)
+			changed := mockSaveAndGetEpisodeAdapter.onChangeStatusCalledWith
+			assert.Equal(t, model.EpisodePublished, changed.Status)
+			assert.WithinDuration(t, time.Now(), changed.PublishedAt, time.Minute)
+			assert.Equal(t, status, mockSaveAndGetEpisodeAdapter.onChangeStatusFrom)
+			assert.Equal(t, episodeResponseOf(changed), result)
+		})
+	}
+}
+
+func Test_should_republish_episode_at_former_date(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodeUnpublished)
+
+	result, err := publishEpisodeService.PublishEpisode(t.Context(), &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.EpisodePublished, result.Status)
+	assert.Equal(t, somePublishedAt, result.PublishedAt)
+}
+
+func Test_should_not_publish_published_episode(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodePublished)
+
+	result, err := publishEpisodeService.PublishEpisode(t.Context(), &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewEpisodeStatusChangeError("some-episode-id", "published", "published"), err)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledChangeStatus)
+}
+
+func Test_should_throw_not_found_errors_on_publish_episode(t *testing.T) {
+	defer initAdapter()
+	givenShow()
+
+	result, err := publishEpisodeService.PublishEpisode(t.Context(), &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewEpisodeNotFoundError("some-episode-id"), err)
+}
+
+func Test_should_propagate_errors_on_publish_episode(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodeDraft)
+	expectedError := errors.New("some error")
+	mockSaveAndGetEpisodeAdapter.withErrorOnChangeStatus = expectedError
+
+	result, err := publishEpisodeService.PublishEpisode(t.Context(), &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
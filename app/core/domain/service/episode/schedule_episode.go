@@ -0,0 +1,43 @@
+package episode
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"time"
+)
+
+type ScheduleEpisodeService struct {
+	getShowOutPort             outbound.GetShowPort
+	getEpisodeOutPort          outbound.GetEpisodePort
+	changeEpisodeStatusOutPort outbound.ChangeEpisodeStatusPort
+}
+
+func NewScheduleEpisodeService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetEpisodePort,
+	statusRepository outbound.ChangeEpisodeStatusPort,
+) *ScheduleEpisodeService {
+	return &ScheduleEpisodeService{
+		getShowOutPort:             showRepository,
+		getEpisodeOutPort:          episodeRepository,
+		changeEpisodeStatusOutPort: statusRepository,
+	}
+}
+
+func (service *ScheduleEpisodeService) ScheduleEpisode(ctx context.Context, command *inbound.ScheduleEpisodeCommand) (response *inbound.GetEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ScheduleEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if !command.PublishAt.After(time.Now()) {
+		return nil, error2.NewInvalidPublishDateError(command.PublishAt)
+	}
+	_, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+	return changeEpisodeStatus(ctx, service.changeEpisodeStatusOutPort, episode, model.EpisodeScheduled, command.PublishAt.UTC())
+}
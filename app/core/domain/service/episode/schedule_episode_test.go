@@ -0,0 +1,67 @@
+package episode
+
+import (
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var scheduleEpisodeService = NewScheduleEpisodeService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter)
+
+func Test_should_implement_ScheduleEpisodeInPort(t *testing.T) {
+	assert.NotNil(t, scheduleEpisodeService)
+	assert.Implements(t, (*inbound.ScheduleEpisodePort)(nil), scheduleEpisodeService)
+}
+
+func Test_should_schedule_episode(t *testing.T) {
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	for _, status := range []model.EpisodeStatus{model.EpisodeDraft, model.EpisodeScheduled, model.EpisodeUnpublished} {
+		t.Run(string(status), func(t *testing.T) {
+			defer initAdapter()
+			givenEpisodeWithStatus(status)
+
+			result, err := scheduleEpisodeService.ScheduleEpisode(t.Context(), &inbound.ScheduleEpisodeCommand{
+				ShowId: "some-show-id", EpisodeId: "some-episode-id", PublishAt: publishAt.In(time.FixedZone("CEST", 2*60*60)),
+			})
+
+			assert.Nil(t, err)
+			changed := mockSaveAndGetEpisodeAdapter.onChangeStatusCalledWith
+			assert.Equal(t, model.EpisodeScheduled, changed.Status)
+			assert.Equal(t, publishAt.UTC(), changed.PublishedAt)
+			assert.Equal(t, status, mockSaveAndGetEpisodeAdapter.onChangeStatusFrom)
+			assert.Equal(t, episodeResponseOf(changed), result)
+		})
+	}
+}
+
+func Test_should_not_schedule_episode_in_the_past(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodeDraft)
+	publishAt := time.Now().Add(-time.Minute)
+
+	result, err := scheduleEpisodeService.ScheduleEpisode(t.Context(), &inbound.ScheduleEpisodeCommand{
+		ShowId: "some-show-id", EpisodeId: "some-episode-id", PublishAt: publishAt,
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewInvalidPublishDateError(publishAt), err)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledChangeStatus)
+}
+
+func Test_should_not_schedule_published_episode(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodePublished)
+
+	result, err := scheduleEpisodeService.ScheduleEpisode(t.Context(), &inbound.ScheduleEpisodeCommand{
+		ShowId: "some-show-id", EpisodeId: "some-episode-id", PublishAt: time.Now().Add(time.Hour),
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewEpisodeStatusChangeError("some-episode-id", "published", "scheduled"), err)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledChangeStatus)
+}
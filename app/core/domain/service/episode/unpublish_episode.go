@@ -0,0 +1,38 @@
+package episode
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type UnpublishEpisodeService struct {
+	getShowOutPort             outbound.GetShowPort
+	getEpisodeOutPort          outbound.GetEpisodePort
+	changeEpisodeStatusOutPort outbound.ChangeEpisodeStatusPort
+}
+
+func NewUnpublishEpisodeService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetEpisodePort,
+	statusRepository outbound.ChangeEpisodeStatusPort,
+) *UnpublishEpisodeService {
+	return &UnpublishEpisodeService{
+		getShowOutPort:             showRepository,
+		getEpisodeOutPort:          episodeRepository,
+		changeEpisodeStatusOutPort: statusRepository,
+	}
+}
+
+func (service *UnpublishEpisodeService) UnpublishEpisode(ctx context.Context, command *inbound.UnpublishEpisodeCommand) (response *inbound.GetEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "UnpublishEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+	return changeEpisodeStatus(ctx, service.changeEpisodeStatusOutPort, episode, model.EpisodeUnpublished, episode.PublishedAt)
+}
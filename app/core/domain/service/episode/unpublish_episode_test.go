@@ -0,0 +1,46 @@
+package episode
+
+import (
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var unpublishEpisodeService = NewUnpublishEpisodeService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter)
+
+func Test_should_implement_UnpublishEpisodeInPort(t *testing.T) {
+	assert.NotNil(t, unpublishEpisodeService)
+	assert.Implements(t, (*inbound.UnpublishEpisodePort)(nil), unpublishEpisodeService)
+}
+
+func Test_should_unpublish_episode_and_keep_its_date(t *testing.T) {
+	defer initAdapter()
+	givenEpisodeWithStatus(model.EpisodePublished)
+
+	result, err := unpublishEpisodeService.UnpublishEpisode(t.Context(), &inbound.UnpublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, err)
+	changed := mockSaveAndGetEpisodeAdapter.onChangeStatusCalledWith
+	assert.Equal(t, model.EpisodeUnpublished, changed.Status)
+	assert.Equal(t, somePublishedAt, changed.PublishedAt)
+	assert.Equal(t, model.EpisodePublished, mockSaveAndGetEpisodeAdapter.onChangeStatusFrom)
+	assert.Equal(t, episodeResponseOf(changed), result)
+}
+
+func Test_should_only_unpublish_published_episode(t *testing.T) {
+	for _, status := range []model.EpisodeStatus{model.EpisodeDraft, model.EpisodeScheduled, model.EpisodeUnpublished} {
+		t.Run(string(status), func(t *testing.T) {
+			defer initAdapter()
+			givenEpisodeWithStatus(status)
+
+			result, err := unpublishEpisodeService.UnpublishEpisode(t.Context(), &inbound.UnpublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+			assert.Nil(t, result)
+			assert.Equal(t, error2.NewEpisodeStatusChangeError("some-episode-id", string(status), "unpublished"), err)
+			assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledChangeStatus)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package episode
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type UpdateEpisodeService struct {
+	getShowOutPort       outbound.GetShowPort
+	getEpisodeOutPort    outbound.GetEpisodePort
+	updateEpisodeOutPort outbound.UpdateEpisodePort
+}
+
+func NewUpdateEpisodeService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetEpisodePort,
+	updateEpisodeRepository outbound.UpdateEpisodePort,
+) *UpdateEpisodeService {
+	return &UpdateEpisodeService{
+		getShowOutPort:       showRepository,
+		getEpisodeOutPort:    episodeRepository,
+		updateEpisodeOutPort: updateEpisodeRepository,
+	}
+}
+
+func (service *UpdateEpisodeService) UpdateEpisode(ctx context.Context, command *inbound.UpdateEpisodeCommand) (response *inbound.GetEpisodeResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "UpdateEpisode")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	title := episode.Title
+	applyEpisodeChanges(episode, command)
+	if episode.Title != title && !show.AllowDuplicateEpisodeTitles {
+		// the same rule as on creation applies, the episode itself does not count
+		exists, err := service.updateEpisodeOutPort.ExistsOtherByTitle(ctx, show.Id, episode.Id, episode.Title)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, error2.NewEpisodeAlreadyExistsError(episode.Title)
+		}
+	}
+
+	if err = service.updateEpisodeOutPort.UpdateEpisode(ctx, episode); err != nil {
+		return nil, err
+	}
+	return episodeResponseOf(episode), nil
+}
+
+// episodeOfShow finds an episode which belongs to the given show, and the show.
+func episodeOfShow(ctx context.Context, showRepository outbound.GetShowPort, episodeRepository outbound.GetEpisodePort, showId string, episodeId string) (*model.Show, *model.Episode, error) {
+	show, err := requireShow(ctx, showRepository, showId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	episode, err := episodeRepository.GetEpisodeOrNil(ctx, episodeId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if episode == nil || episode.ShowId != showId {
+		return nil, nil, error2.NewEpisodeNotFoundError(episodeId)
+	}
+	return show, episode, nil
+}
+
+// requireShow returns the show, or fails with ShowNotFoundError if it does not exist, or with the error of the
+// repository.
+func requireShow(ctx context.Context, showRepository outbound.GetShowPort, showId string) (*model.Show, error) {
+	show, err := showRepository.GetShowOrNil(ctx, showId)
+	if err != nil {
+		return nil, err
+	}
+	if show == nil {
+		return nil, error2.NewShowNotFoundError(showId)
+	}
+	return show, nil
+}
+
+func applyEpisodeChanges(episode *model.Episode, command *inbound.UpdateEpisodeCommand) {
+	if command.Title != nil {
+		episode.Title = *command.Title
+	}
+	if command.Description != nil {
+		episode.Description = *command.Description
+	}
+	if command.Season != nil {
+		episode.Season = *command.Season
+	}
+	if command.EpisodeNumber != nil {
+		episode.EpisodeNumber = *command.EpisodeNumber
+	}
+	if command.Transcripts != nil {
+		episode.Transcripts = *command.Transcripts
+	}
+	if command.Chapters != nil {
+		episode.Chapters = command.Chapters
+		if *command.Chapters == (model.Chapters{}) {
+			episode.Chapters = nil
+		}
+	}
+	if command.Persons != nil {
+		episode.Persons = *command.Persons
+	}
+}
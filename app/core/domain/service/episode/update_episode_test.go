@@ -0,0 +1,159 @@
+package episode
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateEpisodeService = NewUpdateEpisodeService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter)
+
+func givenExistingEpisode() *model.Episode {
+	givenShow()
+	episode := &model.Episode{
+		Id:            "some-episode-id",
+		ShowId:        "some-show-id",
+		Title:         "Some Title",
+		Season:        1,
+		EpisodeNumber: 2,
+		Status:        model.EpisodePublished,
+		PublishedAt:   somePublishedAt,
+		Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Media:         &model.Media{Key: "some-episode-id/episode.mp3", Artwork: &model.Artwork{Key: "some-episode-id/artwork.png"}},
+	}
+	mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil[episode.Id] = episode
+	return episode
+}
+
+func Test_should_implement_UpdateEpisodeInPort(t *testing.T) {
+	assert.NotNil(t, updateEpisodeService)
+	assert.Implements(t, (*inbound.UpdateEpisodePort)(nil), updateEpisodeService)
+}
+
+func Test_should_throw_not_found_errors_on_update_episode(t *testing.T) {
+	tests := map[string]struct {
+		given    func()
+		command  *inbound.UpdateEpisodeCommand
+		expected error
+	}{
+		"show does not exist": {
+			func() {},
+			&inbound.UpdateEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"},
+			error2.NewShowNotFoundError("some-show-id"),
+		},
+		"episode does not exist": {
+			givenShow,
+			&inbound.UpdateEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"},
+			error2.NewEpisodeNotFoundError("some-episode-id"),
+		},
+		"episode of other show": {
+			func() {
+				givenExistingEpisode()
+				mockGetShowAdapter.returnsOnGetOrNilShow["other-show-id"] = &model.Show{Id: "other-show-id"}
+			},
+			&inbound.UpdateEpisodeCommand{ShowId: "other-show-id", EpisodeId: "some-episode-id"},
+			error2.NewEpisodeNotFoundError("some-episode-id"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+			test.given()
+
+			result, err := updateEpisodeService.UpdateEpisode(t.Context(), test.command)
+
+			assert.Nil(t, result)
+			assert.Equal(t, test.expected, err)
+			assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledUpdate)
+		})
+	}
+}
+
+func Test_should_update_only_given_fields_of_an_episode(t *testing.T) {
+	defer initAdapter()
+	episode := givenExistingEpisode()
+	title, episodeNumber := "Other Title", 3
+	persons := []model.Person{{Name: "some guest", Role: "guest"}}
+
+	result, err := updateEpisodeService.UpdateEpisode(t.Context(), &inbound.UpdateEpisodeCommand{
+		ShowId:        "some-show-id",
+		EpisodeId:     "some-episode-id",
+		Title:         &title,
+		EpisodeNumber: &episodeNumber,
+		Persons:       &persons,
+	})
+
+	expectedEpisode := &model.Episode{
+		Id:            "some-episode-id",
+		ShowId:        "some-show-id",
+		Title:         "Other Title",
+		Season:        1,
+		EpisodeNumber: 3,
+		Status:        model.EpisodePublished,
+		PublishedAt:   somePublishedAt,
+		Chapters:      episode.Chapters,
+		Persons:       persons,
+		Media:         episode.Media,
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEpisode, mockSaveAndGetEpisodeAdapter.onUpdateCalledWith)
+	assert.Equal(t, episodeResponseOf(expectedEpisode), result)
+}
+
+func Test_should_remove_chapters_with_empty_chapters(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+
+	_, err := updateEpisodeService.UpdateEpisode(t.Context(), &inbound.UpdateEpisodeCommand{
+		ShowId:    "some-show-id",
+		EpisodeId: "some-episode-id",
+		Chapters:  &model.Chapters{},
+	})
+
+	assert.Nil(t, err)
+	assert.Nil(t, mockSaveAndGetEpisodeAdapter.onUpdateCalledWith.Chapters)
+}
+
+func Test_should_not_update_episode_to_title_of_other_episode(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+	mockSaveAndGetEpisodeAdapter.returnsOnExistsOtherTitle = true
+	title := "Other Title"
+
+	result, err := updateEpisodeService.UpdateEpisode(t.Context(), &inbound.UpdateEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id", Title: &title})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewEpisodeAlreadyExistsError("Other Title"), err)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledUpdate)
+}
+
+func Test_should_update_episode_to_title_of_other_episode_if_show_allows_duplicates(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"].AllowDuplicateEpisodeTitles = true
+	mockSaveAndGetEpisodeAdapter.returnsOnExistsOtherTitle = true
+	title := "Other Title"
+
+	result, err := updateEpisodeService.UpdateEpisode(t.Context(), &inbound.UpdateEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id", Title: &title})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Other Title", result.Title)
+	assert.Equal(t, 1, mockSaveAndGetEpisodeAdapter.calledUpdate)
+}
+
+func Test_should_propagate_error_on_update_episode(t *testing.T) {
+	defer initAdapter()
+	givenExistingEpisode()
+	expectedError := errors.New("some error")
+	mockSaveAndGetEpisodeAdapter.withErrorOnUpdateEpisode = expectedError
+
+	result, err := updateEpisodeService.UpdateEpisode(t.Context(), &inbound.UpdateEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,164 @@
+package episode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/metadata"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var supportedMediaTypes = map[string]bool{
+	"audio/mpeg":  true,
+	"audio/mp4":   true,
+	"audio/x-m4a": true,
+	"audio/aac":   true,
+	"audio/ogg":   true,
+	"audio/opus":  true,
+	"video/mp4":   true,
+}
+
+var artworkExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type UploadEpisodeMediaService struct {
+	getShowOutPort          outbound.GetShowPort
+	getEpisodeOutPort       outbound.GetEpisodePort
+	saveEpisodeMediaOutPort outbound.SaveEpisodeMediaPort
+	mediaStorageOutPort     outbound.MediaStoragePort
+	analyzer                *metadata.Analyzer
+}
+
+func NewUploadEpisodeMediaService(
+	showRepository outbound.GetShowPort,
+	getEpisodeRepository outbound.GetEpisodePort,
+	saveEpisodeMediaRepository outbound.SaveEpisodeMediaPort,
+	mediaStorage outbound.MediaStoragePort,
+) *UploadEpisodeMediaService {
+	return &UploadEpisodeMediaService{
+		getShowOutPort:          showRepository,
+		getEpisodeOutPort:       getEpisodeRepository,
+		saveEpisodeMediaOutPort: saveEpisodeMediaRepository,
+		mediaStorageOutPort:     mediaStorage,
+		analyzer:                metadata.NewAnalyzer(),
+	}
+}
+
+func (service *UploadEpisodeMediaService) UploadEpisodeMedia(ctx context.Context, command *inbound.UploadEpisodeMediaCommand) (response *inbound.UploadEpisodeMediaResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "UploadEpisodeMedia")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	mimeType := strings.ToLower(strings.TrimSpace(command.MimeType))
+	if !supportedMediaTypes[mimeType] {
+		return nil, error2.NewUnsupportedMediaTypeError(command.MimeType)
+	}
+	_, episode, err := episodeOfShow(ctx, service.getShowOutPort, service.getEpisodeOutPort, command.ShowId, command.EpisodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := sanitizeFileName(command.FileName)
+	analyzed, err := service.analyzer.Analyze(command.Content, mimeType)
+	if errors.Is(err, metadata.ErrUnrecognizedMedia) {
+		return nil, error2.NewInvalidMediaError(fileName, mimeType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err = command.Content.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	// each upload is stored under keys of its own, so the media served until the episode records the upload stays intact
+	version := uuid.NewString()
+	media := &model.Media{
+		Key:        episode.Id + "/" + version + "-" + fileName,
+		FileName:   fileName,
+		MimeType:   mimeType,
+		Duration:   analyzed.Duration,
+		Bitrate:    analyzed.Bitrate,
+		SampleRate: analyzed.SampleRate,
+		Channels:   analyzed.Channels,
+		Title:      analyzed.Title,
+		Chapters:   analyzed.Chapters,
+	}
+	if media.Size, err = service.mediaStorageOutPort.SaveMedia(ctx, media.Key, command.Content, mimeType); err != nil {
+		return nil, err
+	}
+	if media.Artwork, err = service.saveArtwork(ctx, episode.Id+"/"+version, analyzed.Artwork); err != nil {
+		service.deleteMedia(ctx, media)
+		return nil, err
+	}
+	if err = service.saveEpisodeMediaOutPort.SaveEpisodeMedia(ctx, episode.Id, media); err != nil {
+		service.deleteMedia(ctx, media)
+		return nil, err
+	}
+	service.deleteReplacedMedia(ctx, episode.Media, media)
+
+	return &inbound.UploadEpisodeMediaResponse{
+		EpisodeId: episode.Id,
+		ShowId:    episode.ShowId,
+		Media:     media,
+	}, nil
+}
+
+// saveArtwork stores artwork embedded in the media next to it. Artwork in other formats than JPEG and PNG is ignored,
+// as podcast apps do not display it.
+func (service *UploadEpisodeMediaService) saveArtwork(ctx context.Context, keyPrefix string, embedded *metadata.EmbeddedArtwork) (*model.Artwork, error) {
+	if embedded == nil {
+		return nil, nil
+	}
+	extension, supported := artworkExtensions[embedded.MimeType]
+	if !supported {
+		return nil, nil
+	}
+
+	artwork := &model.Artwork{Key: keyPrefix + "-artwork" + extension, MimeType: embedded.MimeType}
+	if _, err := service.mediaStorageOutPort.SaveMedia(ctx, artwork.Key, bytes.NewReader(embedded.Data), artwork.MimeType); err != nil {
+		return nil, err
+	}
+	return artwork, nil
+}
+
+func (service *UploadEpisodeMediaService) deleteReplacedMedia(ctx context.Context, replaced *model.Media, media *model.Media) {
+	if replaced == nil {
+		return
+	}
+	if replaced.Key != media.Key {
+		_ = service.mediaStorageOutPort.DeleteMedia(ctx, replaced.Key)
+	}
+	if replaced.Artwork != nil && (media.Artwork == nil || replaced.Artwork.Key != media.Artwork.Key) {
+		_ = service.mediaStorageOutPort.DeleteMedia(ctx, replaced.Artwork.Key)
+	}
+}
+
+// deleteMedia removes the stored files of an upload which the episode does not record, they would be orphaned otherwise.
+func (service *UploadEpisodeMediaService) deleteMedia(ctx context.Context, media *model.Media) {
+	_ = service.mediaStorageOutPort.DeleteMedia(ctx, media.Key)
+	if media.Artwork != nil {
+		_ = service.mediaStorageOutPort.DeleteMedia(ctx, media.Artwork.Key)
+	}
+}
+
+func sanitizeFileName(fileName string) string {
+	baseName := path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	baseName = strings.Trim(unsafeFileNameCharacters.ReplaceAllString(baseName, "-"), "-.")
+	if baseName == "" {
+		return "media"
+	}
+	return baseName
+}
@@ -0,0 +1,253 @@
+package episode
+
+import (
+	"bytes"
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var uploadEpisodeMediaService = NewUploadEpisodeMediaService(mockGetShowAdapter, mockSaveAndGetEpisodeAdapter, mockSaveAndGetEpisodeAdapter, mockMediaStorageAdapter)
+
+// testMp3 holds ten MPEG-1 Layer III frames at 128 kbit/s and 44.1 kHz stereo without any tag
+var testMp3 = bytes.Repeat(append([]byte{0xFF, 0xFB, 0x90, 0x00}, make([]byte, 413)...), 10)
+
+var testPng = []byte("\x89PNG\r\n\x1a\nsome image")
+
+func newTestUploadEpisodeMediaCommand(fileName string, mimeType string) *inbound.UploadEpisodeMediaCommand {
+	return newTestUploadEpisodeMediaCommandWithContent(fileName, mimeType, testMp3)
+}
+
+func newTestUploadEpisodeMediaCommandWithContent(fileName string, mimeType string, content []byte) *inbound.UploadEpisodeMediaCommand {
+	return &inbound.UploadEpisodeMediaCommand{
+		ShowId:    "some-show-id",
+		EpisodeId: "some-episode-id",
+		FileName:  fileName,
+		MimeType:  mimeType,
+		Content:   bytes.NewReader(content),
+	}
+}
+
+// withId3Artwork prefixes content with an ID3v2.3 tag containing a title and a front cover
+func withId3Artwork(title string, content []byte) []byte {
+	titleFrame := append([]byte{0}, title...)
+	pictureFrame := append(append([]byte{0}, "image/png\x00\x03\x00"...), testPng...)
+	var frames []byte
+	for _, frame := range []struct {
+		id   string
+		body []byte
+	}{{"TIT2", titleFrame}, {"APIC", pictureFrame}} {
+		frames = append(frames, frame.id...)
+		frames = append(frames, 0, 0, byte(len(frame.body)>>8), byte(len(frame.body)), 0, 0)
+		frames = append(frames, frame.body...)
+	}
+	tag := append([]byte("ID3\x03\x00\x00\x00\x00"), byte(len(frames)>>7), byte(len(frames)&0x7F))
+	return append(append(tag, frames...), content...)
+}
+
+// versionedKey matches the key of an upload, which the name of the file is prefixed with a version of
+func versionedKey(name string) *regexp.Regexp {
+	return regexp.MustCompile("^some-episode-id/[0-9a-f-]{36}-" + regexp.QuoteMeta(name) + "$")
+}
+
+func givenShowWithEpisode(media *model.Media) {
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = &model.Show{Id: "some-show-id"}
+	mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"] = &model.Episode{
+		Id:     "some-episode-id",
+		ShowId: "some-show-id",
+		Media:  media,
+	}
+}
+
+func Test_should_implement_UploadEpisodeMediaInPort(t *testing.T) {
+	assert.NotNil(t, uploadEpisodeMediaService)
+	assert.Implements(t, (*inbound.UploadEpisodeMediaPort)(nil), uploadEpisodeMediaService)
+}
+
+func Test_should_reject_unsupported_media_types_on_upload(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(nil)
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("notes.txt", "text/plain"))
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.UnsupportedMediaTypeError{MimeType: "text/plain"}, err)
+	assert.Empty(t, mockMediaStorageAdapter.saved)
+}
+
+func Test_should_throw_error_if_show_does_not_exist_on_upload(t *testing.T) {
+	defer initAdapter()
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowNotFoundError{Id: "some-show-id"}, err)
+	assert.Empty(t, mockMediaStorageAdapter.saved)
+}
+
+func Test_should_throw_error_if_episode_does_not_belong_to_show_on_upload(t *testing.T) {
+	defer initAdapter()
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-show-id"] = &model.Show{Id: "some-show-id"}
+	mockSaveAndGetEpisodeAdapter.returnsOnGetEpisodeOrNil["some-episode-id"] = &model.Episode{Id: "some-episode-id", ShowId: "other-show-id"}
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.EpisodeNotFoundError{Id: "some-episode-id"}, err)
+	assert.Empty(t, mockMediaStorageAdapter.saved)
+}
+
+func Test_should_propagate_errors_from_adapters_on_upload(t *testing.T) {
+	defer initAdapter()
+	expectedError := errors.New("some error")
+
+	t.Run("storage", func(t *testing.T) {
+		defer initAdapter()
+		givenShowWithEpisode(nil)
+		mockMediaStorageAdapter.withErrorOnSave = expectedError
+
+		result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledSaveMedia)
+	})
+
+	t.Run("repository", func(t *testing.T) {
+		defer initAdapter()
+		givenShowWithEpisode(nil)
+		mockSaveAndGetEpisodeAdapter.withErrorOnSaveMedia = expectedError
+
+		result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
+
+func Test_should_delete_stored_files_when_recording_upload_fails(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(&model.Media{Key: "some-episode-id/episode.mp3"})
+	mockSaveAndGetEpisodeAdapter.withErrorOnSaveMedia = errors.New("some error")
+	content := withId3Artwork("Tagged Title", testMp3)
+
+	_, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommandWithContent("episode.mp3", "audio/mpeg", content))
+
+	assert.NotNil(t, err)
+	assert.Len(t, mockMediaStorageAdapter.saved, 2)
+	assert.Len(t, mockMediaStorageAdapter.deleted, 2)
+	for _, key := range mockMediaStorageAdapter.deleted {
+		assert.Contains(t, mockMediaStorageAdapter.saved, key)
+	}
+	assert.NotContains(t, mockMediaStorageAdapter.deleted, "some-episode-id/episode.mp3")
+}
+
+func Test_should_store_media_and_record_it_on_episode(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(nil)
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("My Episode #1.mp3", "Audio/MPEG"))
+
+	assert.Nil(t, err)
+	assert.Regexp(t, versionedKey("My-Episode-1.mp3"), result.Media.Key)
+	expectedMedia := &model.Media{
+		Key:        result.Media.Key,
+		FileName:   "My-Episode-1.mp3",
+		MimeType:   "audio/mpeg",
+		Size:       int64(len(testMp3)),
+		Duration:   260625 * time.Microsecond,
+		Bitrate:    128000,
+		SampleRate: 44100,
+		Channels:   2,
+	}
+	assert.Equal(t, &inbound.UploadEpisodeMediaResponse{EpisodeId: "some-episode-id", ShowId: "some-show-id", Media: expectedMedia}, result)
+	assert.Equal(t, string(testMp3), mockMediaStorageAdapter.saved[result.Media.Key])
+	assert.Equal(t, 1, mockSaveAndGetEpisodeAdapter.calledSaveMedia)
+	assert.Equal(t, expectedMedia, mockSaveAndGetEpisodeAdapter.onSaveMediaCalledWith)
+	assert.Empty(t, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_store_embedded_artwork_and_title_on_upload(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(nil)
+	content := withId3Artwork("Tagged Title", testMp3)
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommandWithContent("episode.mp3", "audio/mpeg", content))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Tagged Title", result.Media.Title)
+	assert.Regexp(t, versionedKey("artwork.png"), result.Media.Artwork.Key)
+	assert.Equal(t, "image/png", result.Media.Artwork.MimeType)
+	assert.Equal(t, string(testPng), mockMediaStorageAdapter.saved[result.Media.Artwork.Key])
+	assert.Equal(t, string(content), mockMediaStorageAdapter.saved[result.Media.Key])
+}
+
+func Test_should_reject_media_which_cannot_be_parsed_on_upload(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(nil)
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommandWithContent("episode.mp3", "audio/mpeg", []byte("some text")))
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.InvalidMediaError{FileName: "episode.mp3", MimeType: "audio/mpeg"}, err)
+	assert.Empty(t, mockMediaStorageAdapter.saved)
+	assert.Equal(t, 0, mockSaveAndGetEpisodeAdapter.calledSaveMedia)
+}
+
+func Test_should_store_media_without_parser_unanalyzed(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(nil)
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommandWithContent("episode.ogg", "audio/ogg", []byte("some audio")))
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), result.Media.Duration)
+	assert.Equal(t, "some audio", mockMediaStorageAdapter.saved[result.Media.Key])
+}
+
+func Test_should_delete_replaced_artwork_on_upload(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(&model.Media{
+		Key:     "some-episode-id/episode.mp3",
+		Artwork: &model.Artwork{Key: "some-episode-id/artwork.jpg", MimeType: "image/jpeg"},
+	})
+
+	_, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"some-episode-id/episode.mp3", "some-episode-id/artwork.jpg"}, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_delete_replaced_media_on_upload(t *testing.T) {
+	defer initAdapter()
+	givenShowWithEpisode(&model.Media{Key: "some-episode-id/episode.mp3"})
+
+	result, err := uploadEpisodeMediaService.UploadEpisodeMedia(t.Context(), newTestUploadEpisodeMediaCommand("episode.mp3", "audio/mpeg"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, string(testMp3), mockMediaStorageAdapter.saved[result.Media.Key])
+	assert.Equal(t, []string{"some-episode-id/episode.mp3"}, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_sanitize_file_names(t *testing.T) {
+	tests := map[string]string{
+		"episode.mp3":             "episode.mp3",
+		"../../etc/passwd":        "passwd",
+		`C:\Users\me\episode.m4a`: "episode.m4a",
+		"Folge über Äpfel.mp3":    "Folge-ber-pfel.mp3",
+		"":                        "media",
+		"..":                      "media",
+	}
+
+	for fileName, expected := range tests {
+		t.Run(fileName, func(t *testing.T) {
+			assert.Equal(t, expected, sanitizeFileName(fileName))
+		})
+	}
+}
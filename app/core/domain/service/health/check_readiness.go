@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"time"
+)
+
+// CheckReadinessService is the registry of health checks. Adapters register their checks once they are created.
+type CheckReadinessService struct {
+	healthCheckOutPorts []outbound.HealthCheckPort
+	timeout             time.Duration
+}
+
+// NewCheckReadinessService reports components whose check takes longer than timeout as down, so a hanging
+// component does not hang the probe of the orchestrator.
+func NewCheckReadinessService(timeout time.Duration) *CheckReadinessService {
+	return &CheckReadinessService{timeout: timeout}
+}
+
+// RegisterHealthCheck has to be called before the readiness is checked.
+func (service *CheckReadinessService) RegisterHealthCheck(check outbound.HealthCheckPort) {
+	service.healthCheckOutPorts = append(service.healthCheckOutPorts, check)
+}
+
+// CheckReadiness checks all components at the same time, so the slowest check decides how long it takes.
+func (service *CheckReadinessService) CheckReadiness(ctx context.Context, _ *inbound.CheckReadinessCommand) (response *inbound.CheckReadinessResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CheckReadiness")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, service.timeout)
+	defer cancel()
+
+	results := make([]chan model.ComponentHealth, len(service.healthCheckOutPorts))
+	for i, check := range service.healthCheckOutPorts {
+		results[i] = make(chan model.ComponentHealth, 1)
+		go func() {
+			results[i] <- componentHealth(ctx, check)
+		}()
+	}
+
+	response = &inbound.CheckReadinessResponse{Ready: true, Components: make([]model.ComponentHealth, 0, len(results))}
+	for i, check := range service.healthCheckOutPorts {
+		health := awaitComponentHealth(ctx, check, results[i])
+		response.Ready = response.Ready && health.Status == model.HealthUp
+		response.Components = append(response.Components, health)
+	}
+	return response, nil
+}
+
+func componentHealth(ctx context.Context, check outbound.HealthCheckPort) model.ComponentHealth {
+	details, err := check.CheckHealth(ctx)
+	if err != nil {
+		return model.ComponentHealth{Name: check.ComponentName(), Status: model.HealthDown, Error: err.Error(), Details: details}
+	}
+	return model.ComponentHealth{Name: check.ComponentName(), Status: model.HealthUp, Details: details}
+}
+
+// awaitComponentHealth prefers a finished check to the timeout, as both may be done when a previous check timed out.
+func awaitComponentHealth(ctx context.Context, check outbound.HealthCheckPort, result chan model.ComponentHealth) model.ComponentHealth {
+	select {
+	case health := <-result:
+		return health
+	case <-ctx.Done():
+	}
+	select {
+	case health := <-result:
+		return health
+	default:
+		return model.ComponentHealth{Name: check.ComponentName(), Status: model.HealthDown, Error: "check timed out"}
+	}
+}
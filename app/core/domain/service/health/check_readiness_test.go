@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type healthCheckTestAdapter struct {
+	name      string
+	details   map[string]any
+	failsWith error
+	hangs     bool
+}
+
+func (adapter *healthCheckTestAdapter) ComponentName() string {
+	return adapter.name
+}
+
+func (adapter *healthCheckTestAdapter) CheckHealth(ctx context.Context) (map[string]any, error) {
+	if adapter.hangs {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+	}
+	return adapter.details, adapter.failsWith
+}
+
+func Test_should_implement_CheckReadinessInPort(t *testing.T) {
+	service := NewCheckReadinessService(time.Second)
+
+	assert.Implements(t, (*inbound.CheckReadinessPort)(nil), service)
+}
+
+func Test_should_be_ready_if_all_components_are_up(t *testing.T) {
+	service := NewCheckReadinessService(time.Second)
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "database", details: map[string]any{"openConnections": 1}})
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "storage"})
+
+	result, err := service.CheckReadiness(t.Context(), &inbound.CheckReadinessCommand{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.CheckReadinessResponse{
+		Ready: true,
+		Components: []model.ComponentHealth{
+			{Name: "database", Status: model.HealthUp, Details: map[string]any{"openConnections": 1}},
+			{Name: "storage", Status: model.HealthUp},
+		},
+	}, result)
+}
+
+func Test_should_be_ready_without_components(t *testing.T) {
+	service := NewCheckReadinessService(time.Second)
+
+	result, err := service.CheckReadiness(t.Context(), &inbound.CheckReadinessCommand{})
+
+	assert.Nil(t, err)
+	assert.True(t, result.Ready)
+	assert.Empty(t, result.Components)
+}
+
+func Test_should_not_be_ready_if_a_component_is_down(t *testing.T) {
+	service := NewCheckReadinessService(time.Second)
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "database"})
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "migration", details: map[string]any{"dirty": true}, failsWith: errors.New("some error")})
+
+	result, err := service.CheckReadiness(t.Context(), &inbound.CheckReadinessCommand{})
+
+	assert.Nil(t, err)
+	assert.False(t, result.Ready)
+	assert.Equal(t, model.ComponentHealth{
+		Name: "migration", Status: model.HealthDown, Error: "some error", Details: map[string]any{"dirty": true},
+	}, result.Components[1])
+}
+
+func Test_should_report_hanging_component_as_down(t *testing.T) {
+	service := NewCheckReadinessService(10 * time.Millisecond)
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "database", hangs: true})
+	service.RegisterHealthCheck(&healthCheckTestAdapter{name: "storage"})
+
+	result, err := service.CheckReadiness(t.Context(), &inbound.CheckReadinessCommand{})
+
+	assert.Nil(t, err)
+	assert.False(t, result.Ready)
+	assert.Equal(t, []model.ComponentHealth{
+		{Name: "database", Status: model.HealthDown, Error: "check timed out"},
+		{Name: "storage", Status: model.HealthUp},
+	}, result.Components)
+}
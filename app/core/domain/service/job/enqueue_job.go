@@ -0,0 +1,61 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultMaxAttempts retries a failed job with backoff for about twenty minutes, before it becomes a dead letter.
+const defaultMaxAttempts = 8
+
+type EnqueueJobService struct {
+	enqueueJobOutPort outbound.EnqueueJobPort
+}
+
+func NewEnqueueJobService(jobRepository outbound.EnqueueJobPort) *EnqueueJobService {
+	return &EnqueueJobService{
+		enqueueJobOutPort: jobRepository,
+	}
+}
+
+func (service *EnqueueJobService) EnqueueJob(ctx context.Context, command *inbound.EnqueueJobCommand) (response *inbound.EnqueueJobResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "EnqueueJob")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	payload, err := json.Marshal(command.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &model.Job{
+		Id:          uuid.NewString(),
+		Type:        command.Type,
+		UniqueKey:   command.UniqueKey,
+		Payload:     payload,
+		Status:      model.JobPending,
+		MaxAttempts: command.MaxAttempts,
+		RunAt:       command.RunAt.UTC(),
+	}
+	if job.MaxAttempts <= 0 {
+		job.MaxAttempts = defaultMaxAttempts
+	}
+	if command.RunAt.IsZero() {
+		job.RunAt = time.Now().UTC()
+	}
+
+	queued, err := service.enqueueJobOutPort.EnqueueJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if !queued {
+		return &inbound.EnqueueJobResponse{}, nil
+	}
+	return &inbound.EnqueueJobResponse{Id: job.Id}, nil
+}
@@ -0,0 +1,88 @@
+package job
+
+import (
+	"errors"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var enqueueJobService = NewEnqueueJobService(mockJobAdapter)
+
+func Test_should_implement_EnqueueJobInPort(t *testing.T) {
+	assert.NotNil(t, enqueueJobService)
+	assert.Implements(t, (*inbound.EnqueueJobPort)(nil), enqueueJobService)
+}
+
+func Test_should_enqueue_job(t *testing.T) {
+	defer initAdapter()
+	runAt := time.Date(2024, 5, 1, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	result, err := enqueueJobService.EnqueueJob(t.Context(), &inbound.EnqueueJobCommand{
+		Type:        "some-type",
+		Payload:     map[string]string{"episodeId": "some-episode-id"},
+		RunAt:       runAt,
+		MaxAttempts: 3,
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, mockJobAdapter.enqueued, 1)
+	job := mockJobAdapter.enqueued[0]
+	assert.Equal(t, &inbound.EnqueueJobResponse{Id: job.Id}, result)
+	assert.NotEmpty(t, job.Id)
+	assert.Equal(t, "some-type", job.Type)
+	assert.JSONEq(t, `{"episodeId":"some-episode-id"}`, string(job.Payload))
+	assert.Equal(t, model.JobPending, job.Status)
+	assert.Equal(t, 0, job.Attempts)
+	assert.Equal(t, 3, job.MaxAttempts)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), job.RunAt)
+}
+
+func Test_should_enqueue_job_due_now_with_default_attempts(t *testing.T) {
+	defer initAdapter()
+	before := time.Now()
+
+	_, err := enqueueJobService.EnqueueJob(t.Context(), &inbound.EnqueueJobCommand{Type: "some-type"})
+
+	assert.Nil(t, err)
+	job := mockJobAdapter.enqueued[0]
+	assert.Equal(t, "null", string(job.Payload))
+	assert.Equal(t, defaultMaxAttempts, job.MaxAttempts)
+	assert.WithinRange(t, job.RunAt, before, time.Now())
+	assert.Equal(t, time.UTC, job.RunAt.Location())
+}
+
+func Test_should_report_job_left_out_for_its_unique_key_without_id(t *testing.T) {
+	defer initAdapter()
+	mockJobAdapter.notQueuedOnEnqueue = true
+
+	result, err := enqueueJobService.EnqueueJob(t.Context(), &inbound.EnqueueJobCommand{Type: "some-type", UniqueKey: "some-key"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.EnqueueJobResponse{}, result)
+	assert.Equal(t, "some-key", mockJobAdapter.enqueued[0].UniqueKey)
+}
+
+func Test_should_not_enqueue_job_with_payload_which_is_no_json(t *testing.T) {
+	defer initAdapter()
+
+	result, err := enqueueJobService.EnqueueJob(t.Context(), &inbound.EnqueueJobCommand{Type: "some-type", Payload: func() {}})
+
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Empty(t, mockJobAdapter.enqueued)
+}
+
+func Test_should_propagate_errors_on_enqueue_job(t *testing.T) {
+	defer initAdapter()
+	expectedError := errors.New("some error")
+	mockJobAdapter.withErrorOnEnqueue = expectedError
+
+	result, err := enqueueJobService.EnqueueJob(t.Context(), &inbound.EnqueueJobCommand{Type: "some-type"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,68 @@
+package job
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type jobTestAdapter struct {
+	enqueued            []*model.Job
+	withErrorOnEnqueue  error
+	notQueuedOnEnqueue  bool
+	onClaimTypes        []string
+	onClaimNow          time.Time
+	onClaimLease        time.Duration
+	returnsOnClaim      *model.Job
+	withErrorOnClaim    error
+	completed           []*model.Job
+	withErrorOnComplete error
+	retried             []*model.Job
+	withErrorOnRetry    error
+	buried              []*model.Job
+}
+
+func (adapter *jobTestAdapter) EnqueueJob(_ context.Context, job *model.Job) (bool, error) {
+	adapter.enqueued = append(adapter.enqueued, job)
+	return !adapter.notQueuedOnEnqueue, adapter.withErrorOnEnqueue
+}
+
+func (adapter *jobTestAdapter) ClaimJob(_ context.Context, types []string, now time.Time, lease time.Duration) (*model.Job, error) {
+	adapter.onClaimTypes, adapter.onClaimNow, adapter.onClaimLease = types, now, lease
+	return adapter.returnsOnClaim, adapter.withErrorOnClaim
+}
+
+func (adapter *jobTestAdapter) CompleteJob(_ context.Context, job *model.Job) error {
+	adapter.completed = append(adapter.completed, job)
+	return adapter.withErrorOnComplete
+}
+
+func (adapter *jobTestAdapter) RetryJob(_ context.Context, job *model.Job) error {
+	adapter.retried = append(adapter.retried, job)
+	return adapter.withErrorOnRetry
+}
+
+func (adapter *jobTestAdapter) BuryJob(_ context.Context, job *model.Job) error {
+	adapter.buried = append(adapter.buried, job)
+	return nil
+}
+
+var mockJobAdapter = new(jobTestAdapter)
+
+func initAdapter() {
+	*mockJobAdapter = jobTestAdapter{}
+}
+
+type jobTestHandler struct {
+	handled   []*model.Job
+	failsWith error
+	panics    bool
+}
+
+func (handler *jobTestHandler) HandleJob(_ context.Context, job *model.Job) error {
+	handler.handled = append(handler.handled, job)
+	if handler.panics {
+		panic("some panic")
+	}
+	return handler.failsWith
+}
@@ -0,0 +1,113 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+	"slices"
+	"time"
+)
+
+const (
+	firstRetryDelay = 10 * time.Second
+	maxRetryDelay   = time.Hour
+)
+
+type ProcessJobService struct {
+	claimJobOutPort  outbound.ClaimJobPort
+	finishJobOutPort outbound.FinishJobPort
+	lease            time.Duration
+	handlers         map[string]inbound.JobHandler
+}
+
+// NewProcessJobService leases claimed jobs for the given duration, which has to exceed the longest run of a
+// handler, since another worker takes over a job once its lease ended.
+func NewProcessJobService(claimRepository outbound.ClaimJobPort, finishRepository outbound.FinishJobPort, lease time.Duration) *ProcessJobService {
+	return &ProcessJobService{
+		claimJobOutPort:  claimRepository,
+		finishJobOutPort: finishRepository,
+		lease:            lease,
+		handlers:         map[string]inbound.JobHandler{},
+	}
+}
+
+func (service *ProcessJobService) RegisterJobHandler(jobType string, handler inbound.JobHandler) {
+	service.handlers[jobType] = handler
+}
+
+func (service *ProcessJobService) ProcessNextJob(ctx context.Context, command *inbound.ProcessJobCommand) (response *inbound.ProcessJobResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ProcessNextJob")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if len(service.handlers) == 0 {
+		return &inbound.ProcessJobResponse{}, nil
+	}
+	types := make([]string, 0, len(service.handlers))
+	for jobType := range service.handlers {
+		types = append(types, jobType)
+	}
+	slices.Sort(types)
+
+	job, err := service.claimJobOutPort.ClaimJob(ctx, types, command.Now, service.lease)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return &inbound.ProcessJobResponse{}, nil
+	}
+
+	// a job whose worker got lost on every attempt, like by crashing on it, does not get another one
+	if job.Attempts > job.MaxAttempts {
+		return service.fail(ctx, job, command.Now, fmt.Errorf("lost the worker on all %d attempts", job.MaxAttempts))
+	}
+	if err = service.handle(ctx, job); err != nil {
+		return service.fail(ctx, job, command.Now, err)
+	}
+	if err = service.finishJobOutPort.CompleteJob(ctx, job); err != nil {
+		return nil, err
+	}
+	return &inbound.ProcessJobResponse{JobId: job.Id, Type: job.Type}, nil
+}
+
+// handle turns the panic of a handler into a failure of its job, so a broken job does not stop the worker.
+func (service *ProcessJobService) handle(ctx context.Context, job *model.Job) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+	return service.handlers[job.Type].HandleJob(ctx, job)
+}
+
+// fail retries a failed job with exponential backoff, or buries it after its last attempt.
+func (service *ProcessJobService) fail(ctx context.Context, job *model.Job, now time.Time, failure error) (*inbound.ProcessJobResponse, error) {
+	job.LastError = failure.Error()
+	var err error
+	if job.Attempts >= job.MaxAttempts {
+		job.Status = model.JobDead
+		err = service.finishJobOutPort.BuryJob(ctx, job)
+	} else {
+		job.Status = model.JobPending
+		job.RunAt = now.Add(retryDelay(job.Attempts))
+		err = service.finishJobOutPort.RetryJob(ctx, job)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inbound.ProcessJobResponse{JobId: job.Id, Type: job.Type, Failure: job.LastError, Dead: job.Status == model.JobDead}, nil
+}
+
+// retryDelay doubles with every attempt, starting with firstRetryDelay after the first one.
+func retryDelay(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
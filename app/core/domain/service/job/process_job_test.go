@@ -0,0 +1,165 @@
+package job
+
+import (
+	"errors"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var now = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func newProcessJobService() (*ProcessJobService, *jobTestHandler) {
+	service := NewProcessJobService(mockJobAdapter, mockJobAdapter, time.Minute)
+	handler := new(jobTestHandler)
+	service.RegisterJobHandler("some-type", handler)
+	service.RegisterJobHandler("another-type", new(jobTestHandler))
+	return service, handler
+}
+
+func claimedJob(attempts int) *model.Job {
+	return &model.Job{Id: "some-job-id", Type: "some-type", Status: model.JobRunning, Attempts: attempts, MaxAttempts: 3}
+}
+
+func Test_should_implement_ProcessJobInPort(t *testing.T) {
+	service, _ := newProcessJobService()
+
+	assert.Implements(t, (*inbound.ProcessJobPort)(nil), service)
+}
+
+func Test_should_process_and_complete_claimed_job(t *testing.T) {
+	defer initAdapter()
+	service, handler := newProcessJobService()
+	job := claimedJob(1)
+	mockJobAdapter.returnsOnClaim = job
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type"}, result)
+	assert.Equal(t, []string{"another-type", "some-type"}, mockJobAdapter.onClaimTypes)
+	assert.Equal(t, now, mockJobAdapter.onClaimNow)
+	assert.Equal(t, time.Minute, mockJobAdapter.onClaimLease)
+	assert.Equal(t, []*model.Job{job}, handler.handled)
+	assert.Equal(t, []*model.Job{job}, mockJobAdapter.completed)
+}
+
+func Test_should_process_nothing_if_no_job_is_due(t *testing.T) {
+	defer initAdapter()
+	service, handler := newProcessJobService()
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ProcessJobResponse{}, result)
+	assert.Empty(t, handler.handled)
+}
+
+func Test_should_not_claim_jobs_without_handlers(t *testing.T) {
+	defer initAdapter()
+	service := NewProcessJobService(mockJobAdapter, mockJobAdapter, time.Minute)
+	mockJobAdapter.returnsOnClaim = claimedJob(1)
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ProcessJobResponse{}, result)
+	assert.Nil(t, mockJobAdapter.onClaimTypes)
+}
+
+func Test_should_retry_failed_job_with_exponential_backoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		1: 10 * time.Second,
+		2: 20 * time.Second,
+	}
+
+	for attempts, expectedDelay := range tests {
+		t.Run(expectedDelay.String(), func(t *testing.T) {
+			defer initAdapter()
+			service, handler := newProcessJobService()
+			handler.failsWith = errors.New("some error")
+			mockJobAdapter.returnsOnClaim = claimedJob(attempts)
+
+			result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+			assert.Nil(t, err)
+			assert.Equal(t, &inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type", Failure: "some error"}, result)
+			assert.Len(t, mockJobAdapter.retried, 1)
+			assert.Equal(t, model.JobPending, mockJobAdapter.retried[0].Status)
+			assert.Equal(t, now.Add(expectedDelay), mockJobAdapter.retried[0].RunAt)
+			assert.Equal(t, "some error", mockJobAdapter.retried[0].LastError)
+			assert.Empty(t, mockJobAdapter.completed)
+		})
+	}
+}
+
+func Test_should_bury_job_failing_on_its_last_attempt(t *testing.T) {
+	defer initAdapter()
+	service, handler := newProcessJobService()
+	handler.failsWith = errors.New("some error")
+	mockJobAdapter.returnsOnClaim = claimedJob(3)
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type", Failure: "some error", Dead: true}, result)
+	assert.Len(t, mockJobAdapter.buried, 1)
+	assert.Equal(t, model.JobDead, mockJobAdapter.buried[0].Status)
+	assert.Empty(t, mockJobAdapter.retried)
+}
+
+func Test_should_bury_job_which_lost_its_worker_on_every_attempt(t *testing.T) {
+	defer initAdapter()
+	service, handler := newProcessJobService()
+	mockJobAdapter.returnsOnClaim = claimedJob(4)
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.True(t, result.Dead)
+	assert.Empty(t, handler.handled)
+	assert.Len(t, mockJobAdapter.buried, 1)
+}
+
+func Test_should_retry_job_whose_handler_panics(t *testing.T) {
+	defer initAdapter()
+	service, handler := newProcessJobService()
+	handler.panics = true
+	mockJobAdapter.returnsOnClaim = claimedJob(1)
+
+	result, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "panic: some panic", result.Failure)
+	assert.Len(t, mockJobAdapter.retried, 1)
+}
+
+func Test_should_propagate_errors_on_process_job(t *testing.T) {
+	expectedError := errors.New("some error")
+	tests := map[string]func(){
+		"claim":    func() { mockJobAdapter.withErrorOnClaim = expectedError },
+		"complete": func() { mockJobAdapter.withErrorOnComplete = expectedError },
+	}
+
+	for name, prepare := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+			service, _ := newProcessJobService()
+			mockJobAdapter.returnsOnClaim = claimedJob(1)
+			prepare()
+
+			_, err := service.ProcessNextJob(t.Context(), &inbound.ProcessJobCommand{Now: now})
+
+			assert.Equal(t, expectedError, err)
+		})
+	}
+}
+
+func Test_should_cap_retry_delay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, retryDelay(1))
+	assert.Equal(t, 80*time.Second, retryDelay(4))
+	assert.Equal(t, maxRetryDelay, retryDelay(20))
+}
@@ -0,0 +1,60 @@
+package metadata
+
+import (
+	"errors"
+	"io"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+var ErrUnrecognizedMedia = errors.New("media could not be recognized")
+
+type Metadata struct {
+	Duration   time.Duration
+	Bitrate    int
+	SampleRate int
+	Channels   int
+	Title      string
+	Artwork    *EmbeddedArtwork
+	Chapters   []model.MediaChapter
+}
+
+type EmbeddedArtwork struct {
+	MimeType string
+	Data     []byte
+}
+
+type Analyzer struct{}
+
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{}
+}
+
+// Analyze reads technical values and embedded tags of MP3 and MP4/M4A media.
+// Media of other types results in empty metadata.
+func (a *Analyzer) Analyze(content io.ReadSeeker, mimeType string) (*Metadata, error) {
+	switch mimeType {
+	case "audio/mpeg":
+		return analyzeMpeg(content)
+	case "audio/mp4", "audio/x-m4a", "video/mp4":
+		return analyzeMp4(content)
+	default:
+		return &Metadata{}, nil
+	}
+}
+
+func durationOf(units uint64, unitsPerSecond uint64) time.Duration {
+	if unitsPerSecond == 0 {
+		return 0
+	}
+	seconds := units / unitsPerSecond
+	remainder := units % unitsPerSecond
+	return time.Duration(seconds)*time.Second + time.Duration(remainder*uint64(time.Second)/unitsPerSecond)
+}
+
+func bitrateOf(bytes int64, duration time.Duration) int {
+	if duration <= 0 {
+		return 0
+	}
+	return int(float64(bytes*8) / duration.Seconds())
+}
@@ -0,0 +1,147 @@
+package metadata
+
+import (
+	"bytes"
+	"podGopher/core/domain/model"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var analyzer = NewAnalyzer()
+
+func Test_should_analyze_constant_bitrate_mp3(t *testing.T) {
+	tag := id3v2Tag(3,
+		latin1TextFrame(3, "TIT2", "First Episode"),
+		pictureFrame(3, 0, "image/jpeg", []byte("other")),
+		pictureFrame(3, id3FrontCoverPicture, "image/png", testPng),
+		chapterFrame(3, "ch0", 0, "Intro"),
+		chapterFrame(3, "ch1", 1500, "Topic"),
+	)
+	content := append(tag, mpegFrames(100, 0)...)
+
+	metadata, err := analyzer.Analyze(bytes.NewReader(content), "audio/mpeg")
+
+	assert.Nil(t, err)
+	assert.InDelta(t, 2.606, metadata.Duration.Seconds(), 0.001)
+	assert.Equal(t, 128000, metadata.Bitrate)
+	assert.Equal(t, 44100, metadata.SampleRate)
+	assert.Equal(t, 2, metadata.Channels)
+	assert.Equal(t, "First Episode", metadata.Title)
+	assert.Equal(t, &EmbeddedArtwork{MimeType: "image/png", Data: testPng}, metadata.Artwork)
+	assert.Equal(t, []model.MediaChapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 1500 * time.Millisecond, Title: "Topic"},
+	}, metadata.Chapters)
+}
+
+func Test_should_analyze_variable_bitrate_mp3(t *testing.T) {
+	tag := id3v2Tag(4, id3Frame(4, "TIT2", append([]byte{3}, "Über Podcasts"...)))
+	content := append(append(tag, xingFrame(1000)...), mpegFrames(10, mpegMono)...)
+
+	metadata, err := analyzer.Analyze(bytes.NewReader(content), "audio/mpeg")
+
+	assert.Nil(t, err)
+	assert.InDelta(t, 26.122, metadata.Duration.Seconds(), 0.001)
+	assert.Equal(t, 44100, metadata.SampleRate)
+	assert.Equal(t, "Über Podcasts", metadata.Title)
+	assert.Nil(t, metadata.Artwork)
+	assert.Empty(t, metadata.Chapters)
+}
+
+func Test_should_analyze_mp3_without_tag(t *testing.T) {
+	content := append(mpegFrames(50, mpegMono), append([]byte("TAG"), make([]byte, 125)...)...)
+
+	metadata, err := analyzer.Analyze(bytes.NewReader(content), "audio/mpeg")
+
+	assert.Nil(t, err)
+	assert.InDelta(t, 1.303, metadata.Duration.Seconds(), 0.001)
+	assert.Equal(t, 1, metadata.Channels)
+	assert.Empty(t, metadata.Title)
+}
+
+func Test_should_read_utf16_id3_text(t *testing.T) {
+	content := append(id3v2Tag(3, utf16TextFrame(3, "TIT2", "Folge 1 – Start")), mpegFrames(2, 0)...)
+
+	metadata, err := analyzer.Analyze(bytes.NewReader(content), "audio/mpeg")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Folge 1 – Start", metadata.Title)
+}
+
+func Test_should_analyze_m4a(t *testing.T) {
+	content := bytes.Join([][]byte{
+		mp4Atom("ftyp", []byte("M4A \x00\x00\x00\x00")),
+		mp4Atom("moov",
+			mp4MovieHeader(44100, 90*44100),
+			mp4SoundTrack(2, 44100),
+			mp4Atom("udta",
+				mp4ChapterList([]uint64{0, 450_000_000}, []string{"Intro", "Topic"}),
+				mp4Atom("meta", make([]byte, 4), mp4Atom("hdlr", make([]byte, 24)), mp4Atom("ilst",
+					mp4Item("\xa9nam", 1, []byte("First Episode")),
+					mp4Item("covr", mp4DataTypePng, testPng),
+				)),
+			),
+		),
+		mp4Atom("mdat", make([]byte, 1_440_000)),
+	}, nil)
+
+	metadata, err := analyzer.Analyze(bytes.NewReader(content), "audio/x-m4a")
+
+	assert.Nil(t, err)
+	assert.Equal(t, 90*time.Second, metadata.Duration)
+	assert.Equal(t, 128000, metadata.Bitrate)
+	assert.Equal(t, 44100, metadata.SampleRate)
+	assert.Equal(t, 2, metadata.Channels)
+	assert.Equal(t, "First Episode", metadata.Title)
+	assert.Equal(t, &EmbeddedArtwork{MimeType: "image/png", Data: testPng}, metadata.Artwork)
+	assert.Equal(t, []model.MediaChapter{
+		{Start: 0, Title: "Intro"},
+		{Start: 45 * time.Second, Title: "Topic"},
+	}, metadata.Chapters)
+}
+
+func Test_should_throw_error_on_unrecognized_media(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  []byte
+		mimeType string
+	}{
+		{"text as mp3", []byte("this is not audio at all"), "audio/mpeg"},
+		{"tag without audio", id3v2Tag(3, latin1TextFrame(3, "TIT2", "Title")), "audio/mpeg"},
+		{"text as m4a", []byte("this is not audio at all"), "audio/mp4"},
+		{"mp4 without movie", mp4Atom("ftyp", []byte("M4A ")), "audio/x-m4a"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			metadata, err := analyzer.Analyze(bytes.NewReader(test.content), test.mimeType)
+
+			assert.Nil(t, metadata)
+			assert.ErrorIs(t, err, ErrUnrecognizedMedia)
+		})
+	}
+}
+
+func Test_should_not_allocate_size_of_truncated_tag(t *testing.T) {
+	// a tag header which claims the largest size, without any tag data behind it
+	content := []byte{'I', 'D', '3', 3, 0, 0, 0x7F, 0x7F, 0x7F, 0x7F}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	tag, _, err := readId3v2(bytes.NewReader(content))
+
+	runtime.ReadMemStats(&after)
+	assert.Nil(t, tag)
+	assert.ErrorIs(t, err, ErrUnrecognizedMedia)
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
+
+func Test_should_return_empty_metadata_for_other_media_types(t *testing.T) {
+	metadata, err := analyzer.Analyze(bytes.NewReader([]byte("some audio")), "audio/ogg")
+
+	assert.Nil(t, err)
+	assert.Equal(t, &Metadata{}, metadata)
+}
@@ -0,0 +1,114 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+var testPng = []byte("\x89PNG\r\n\x1a\nsome image")
+
+func id3v2Tag(version byte, frames ...[]byte) []byte {
+	body := bytes.Join(frames, nil)
+	header := []byte{'I', 'D', '3', version, 0, 0}
+	return append(append(header, syncsafeBytes(len(body))...), body...)
+}
+
+func id3Frame(version byte, id string, body []byte) []byte {
+	frame := []byte(id)
+	if version == 4 {
+		frame = append(frame, syncsafeBytes(len(body))...)
+	} else {
+		frame = binary.BigEndian.AppendUint32(frame, uint32(len(body)))
+	}
+	frame = append(frame, 0, 0)
+	return append(frame, body...)
+}
+
+func latin1TextFrame(version byte, id string, text string) []byte {
+	return id3Frame(version, id, append([]byte{0}, text...))
+}
+
+func utf16TextFrame(version byte, id string, text string) []byte {
+	body := []byte{1, 0xFF, 0xFE}
+	for _, unit := range utf16.Encode([]rune(text)) {
+		body = binary.LittleEndian.AppendUint16(body, unit)
+	}
+	return id3Frame(version, id, body)
+}
+
+func pictureFrame(version byte, pictureType byte, mimeType string, data []byte) []byte {
+	body := append([]byte{0}, mimeType...)
+	body = append(body, 0, pictureType, 0)
+	return id3Frame(version, "APIC", append(body, data...))
+}
+
+func chapterFrame(version byte, id string, startMilliseconds uint32, title string) []byte {
+	body := append([]byte(id), 0)
+	body = binary.BigEndian.AppendUint32(body, startMilliseconds)
+	body = binary.BigEndian.AppendUint32(body, startMilliseconds+1000)
+	body = append(body, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF)
+	return id3Frame(version, "CHAP", append(body, latin1TextFrame(version, "TIT2", title)...))
+}
+
+func syncsafeBytes(size int) []byte {
+	return []byte{byte(size>>21) & 0x7F, byte(size>>14) & 0x7F, byte(size>>7) & 0x7F, byte(size) & 0x7F}
+}
+
+// mpegFrames builds MPEG-1 Layer III frames at 128 kbit/s and 44.1 kHz, each 417 bytes long.
+func mpegFrames(count int, channelMode byte) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, channelMode << 6})
+	return bytes.Repeat(frame, count)
+}
+
+func xingFrame(frameCount uint32) []byte {
+	frame := mpegFrames(1, 0)
+	copy(frame[36:], "Xing")
+	binary.BigEndian.PutUint32(frame[40:], 0x01)
+	binary.BigEndian.PutUint32(frame[44:], frameCount)
+	return frame
+}
+
+func mp4Atom(boxType string, children ...[]byte) []byte {
+	body := bytes.Join(children, nil)
+	box := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	return append(append(box, boxType...), body...)
+}
+
+func mp4MovieHeader(timescale uint32, duration uint32) []byte {
+	body := make([]byte, 20)
+	binary.BigEndian.PutUint32(body[12:], timescale)
+	binary.BigEndian.PutUint32(body[16:], duration)
+	return mp4Atom("mvhd", body)
+}
+
+func mp4SoundTrack(channels uint16, sampleRate uint32) []byte {
+	handler := make([]byte, 12)
+	copy(handler[8:], "soun")
+	entry := make([]byte, 28)
+	binary.BigEndian.PutUint16(entry[16:], channels)
+	binary.BigEndian.PutUint32(entry[24:], sampleRate<<16)
+	sampleDescription := append(make([]byte, 8), mp4Atom("mp4a", entry)...)
+	binary.BigEndian.PutUint32(sampleDescription[4:], 1)
+
+	return mp4Atom("trak",
+		mp4Atom("mdia",
+			mp4Atom("hdlr", handler),
+			mp4Atom("minf", mp4Atom("stbl", mp4Atom("stsd", sampleDescription)))))
+}
+
+func mp4Item(boxType string, dataType uint32, value []byte) []byte {
+	data := binary.BigEndian.AppendUint32(nil, dataType)
+	data = append(data, 0, 0, 0, 0)
+	return mp4Atom(boxType, mp4Atom("data", append(data, value...)))
+}
+
+func mp4ChapterList(starts []uint64, titles []string) []byte {
+	body := []byte{1, 0, 0, 0, 0, 0, 0, 0, byte(len(starts))}
+	for i, start := range starts {
+		body = binary.BigEndian.AppendUint64(body, start)
+		body = append(append(body, byte(len(titles[i]))), titles[i]...)
+	}
+	return mp4Atom("chpl", body)
+}
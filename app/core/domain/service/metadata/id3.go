@@ -0,0 +1,313 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"podGopher/core/domain/model"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	id3HeaderSize           = 10
+	id3FlagUnsynchronised   = 0x80
+	id3FlagExtendedHeader   = 0x40
+	id3FlagFooter           = 0x10
+	id3FrontCoverPicture    = 3
+	id3FrameFlagGrouping    = 0x40
+	id3FrameFlagCompressed  = 0x08
+	id3FrameFlagEncrypted   = 0x04
+	id3FrameFlagUnsync      = 0x02
+	id3FrameFlagDataLength  = 0x01
+	id3v23FrameFlagCompress = 0x80
+	id3v23FrameFlagEncrypt  = 0x40
+	id3v23FrameFlagGrouping = 0x20
+)
+
+// id3v22FrameIds maps the three character frame ids of ID3v2.2 to their successors.
+var id3v22FrameIds = map[string]string{
+	"TT2": "TIT2",
+	"PIC": "APIC",
+}
+
+type id3Tag struct {
+	title          string
+	artwork        *EmbeddedArtwork
+	artworkIsCover bool
+	chapters       []model.MediaChapter
+}
+
+// readId3v2 reads an ID3v2 tag at the start of content. It returns the total size of the tag
+// including header and footer, or zero if content does not start with a tag.
+func readId3v2(content io.ReadSeeker) (tag *id3Tag, tagSize int64, err error) {
+	if _, err = content.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+
+	header := make([]byte, id3HeaderSize)
+	if _, err = io.ReadFull(content, header); err != nil || string(header[:3]) != "ID3" {
+		return nil, 0, nil
+	}
+
+	version := header[3]
+	flags := header[5]
+	size := int64(syncsafe(header[6:10]))
+	tagSize = id3HeaderSize + size
+	if flags&id3FlagFooter != 0 {
+		tagSize += id3HeaderSize
+	}
+
+	// the size is read from the header, a tag larger than the content must not allocate its size
+	contentSize, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, 0, err
+	}
+	if size > contentSize-id3HeaderSize {
+		return nil, 0, ErrUnrecognizedMedia
+	}
+	if _, err = content.Seek(id3HeaderSize, io.SeekStart); err != nil {
+		return nil, 0, err
+	}
+
+	data := make([]byte, size)
+	if _, err = io.ReadFull(content, data); err != nil {
+		return nil, 0, ErrUnrecognizedMedia
+	}
+
+	if flags&id3FlagUnsynchronised != 0 && version < 4 {
+		data = removeUnsynchronisation(data)
+	}
+	if flags&id3FlagExtendedHeader != 0 {
+		data = skipExtendedHeader(data, version)
+	}
+
+	tag = &id3Tag{}
+	tag.parseFrames(data, version)
+	return tag, tagSize, nil
+}
+
+func skipExtendedHeader(data []byte, version byte) []byte {
+	if len(data) < 4 {
+		return nil
+	}
+	var size int
+	if version >= 4 {
+		size = int(syncsafe(data[:4]))
+	} else {
+		size = int(binary.BigEndian.Uint32(data[:4])) + 4
+	}
+	if size > len(data) {
+		return nil
+	}
+	return data[size:]
+}
+
+func (tag *id3Tag) parseFrames(data []byte, version byte) {
+	idLength, headerLength := 4, 10
+	if version == 2 {
+		idLength, headerLength = 3, 6
+	}
+
+	for position := 0; position+headerLength <= len(data); {
+		if data[position] == 0 {
+			return
+		}
+
+		id := string(data[position : position+idLength])
+		size, flags := frameSizeAndFlags(data[position:position+headerLength], version)
+		position += headerLength
+		if size < 0 || position+size > len(data) {
+			return
+		}
+
+		body := data[position : position+size]
+		position += size
+
+		if body = frameBody(body, flags, version); body == nil {
+			continue
+		}
+		if version == 2 {
+			id = id3v22FrameIds[id]
+		}
+		tag.parseFrame(id, body, version)
+	}
+}
+
+func frameSizeAndFlags(header []byte, version byte) (size int, flags byte) {
+	switch version {
+	case 2:
+		return int(header[3])<<16 | int(header[4])<<8 | int(header[5]), 0
+	case 3:
+		return int(binary.BigEndian.Uint32(header[4:8])), header[9]
+	default:
+		return int(syncsafe(header[4:8])), header[9]
+	}
+}
+
+// frameBody removes flag dependent additions from a frame body. It returns nil for frames which cannot be read.
+func frameBody(body []byte, flags byte, version byte) []byte {
+	switch version {
+	case 3:
+		if flags&(id3v23FrameFlagCompress|id3v23FrameFlagEncrypt) != 0 {
+			return nil
+		}
+		if flags&id3v23FrameFlagGrouping != 0 && len(body) > 0 {
+			body = body[1:]
+		}
+	case 4:
+		if flags&(id3FrameFlagCompressed|id3FrameFlagEncrypted) != 0 {
+			return nil
+		}
+		if flags&id3FrameFlagGrouping != 0 && len(body) > 0 {
+			body = body[1:]
+		}
+		if flags&id3FrameFlagDataLength != 0 && len(body) >= 4 {
+			body = body[4:]
+		}
+		if flags&id3FrameFlagUnsync != 0 {
+			body = removeUnsynchronisation(body)
+		}
+	}
+	return body
+}
+
+func (tag *id3Tag) parseFrame(id string, body []byte, version byte) {
+	if len(body) == 0 {
+		return
+	}
+	switch id {
+	case "TIT2":
+		tag.title = decodeText(body[0], body[1:])
+	case "APIC":
+		tag.parsePicture(body, version)
+	case "CHAP":
+		tag.parseChapter(body, version)
+	}
+}
+
+func (tag *id3Tag) parsePicture(body []byte, version byte) {
+	encoding := body[0]
+	rest := body[1:]
+
+	var mimeType string
+	if version == 2 {
+		if len(rest) < 3 {
+			return
+		}
+		mimeType = "image/" + strings.ToLower(string(rest[:3]))
+		if mimeType == "image/jpg" {
+			mimeType = "image/jpeg"
+		}
+		rest = rest[3:]
+	} else {
+		var found bool
+		if mimeType, rest, found = cutTerminated(rest, 0); !found {
+			return
+		}
+	}
+
+	if len(rest) < 1 {
+		return
+	}
+	pictureType := rest[0]
+	_, data, found := cutTerminated(rest[1:], encoding)
+	if !found || len(data) == 0 {
+		return
+	}
+
+	isCover := pictureType == id3FrontCoverPicture
+	if tag.artwork == nil || (isCover && !tag.artworkIsCover) {
+		tag.artwork = &EmbeddedArtwork{MimeType: mimeType, Data: data}
+		tag.artworkIsCover = isCover
+	}
+}
+
+func (tag *id3Tag) parseChapter(body []byte, version byte) {
+	_, rest, found := cutTerminated(body, 0)
+	if !found || len(rest) < 16 {
+		return
+	}
+
+	startMilliseconds := binary.BigEndian.Uint32(rest[0:4])
+	subFrames := &id3Tag{}
+	subFrames.parseFrames(rest[16:], version)
+
+	tag.chapters = append(tag.chapters, model.MediaChapter{
+		Start: time.Duration(startMilliseconds) * time.Millisecond,
+		Title: subFrames.title,
+	})
+}
+
+// cutTerminated splits data at the first string terminator of the given text encoding.
+// The returned value is decoded, rest starts after the terminator.
+func cutTerminated(data []byte, encoding byte) (value string, rest []byte, found bool) {
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return decodeText(encoding, data[:i]), data[i+2:], true
+			}
+		}
+		return "", nil, false
+	}
+
+	index := bytes.IndexByte(data, 0)
+	if index < 0 {
+		return "", nil, false
+	}
+	return decodeText(encoding, data[:index]), data[index+1:], true
+}
+
+func decodeText(encoding byte, data []byte) string {
+	var text string
+	switch encoding {
+	case 0:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		text = string(runes)
+	case 1, 2:
+		text = decodeUtf16(data, encoding == 2)
+	default:
+		text = string(data)
+	}
+	return strings.TrimRight(text, "\x00")
+}
+
+func decodeUtf16(data []byte, bigEndian bool) string {
+	if len(data) >= 2 {
+		switch {
+		case data[0] == 0xFE && data[1] == 0xFF:
+			bigEndian, data = true, data[2:]
+		case data[0] == 0xFF && data[1] == 0xFE:
+			bigEndian, data = false, data[2:]
+		}
+	}
+
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = binary.BigEndian.Uint16(data[2*i:])
+		} else {
+			units[i] = binary.LittleEndian.Uint16(data[2*i:])
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+func removeUnsynchronisation(data []byte) []byte {
+	result := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		result = append(result, data[i])
+		if data[i] == 0xFF && i+1 < len(data) && data[i+1] == 0x00 {
+			i++
+		}
+	}
+	return result
+}
+
+func syncsafe(data []byte) uint32 {
+	return uint32(data[0]&0x7F)<<21 | uint32(data[1]&0x7F)<<14 | uint32(data[2]&0x7F)<<7 | uint32(data[3]&0x7F)
+}
@@ -0,0 +1,266 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"io"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+const (
+	mp4MaxMovieBoxSize = 32 * 1024 * 1024
+	mp4DataTypeJpeg    = 13
+	mp4DataTypePng     = 14
+)
+
+type mp4Box struct {
+	boxType string
+	data    []byte
+}
+
+func analyzeMp4(content io.ReadSeeker) (*Metadata, error) {
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var movie []byte
+	var mediaDataSize int64
+	for {
+		boxType, size, headerSize, err := readMp4BoxHeader(content)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrUnrecognizedMedia
+		}
+
+		switch {
+		case boxType == "moov" && size-headerSize <= mp4MaxMovieBoxSize:
+			movie = make([]byte, size-headerSize)
+			if _, err = io.ReadFull(content, movie); err != nil {
+				return nil, ErrUnrecognizedMedia
+			}
+			continue
+		case boxType == "mdat":
+			mediaDataSize += size - headerSize
+		}
+
+		if _, err = content.Seek(size-headerSize, io.SeekCurrent); err != nil {
+			return nil, ErrUnrecognizedMedia
+		}
+	}
+
+	if movie == nil {
+		return nil, ErrUnrecognizedMedia
+	}
+
+	metadata := &Metadata{}
+	for _, box := range mp4Boxes(movie) {
+		switch box.boxType {
+		case "mvhd":
+			metadata.Duration = mp4HeaderDuration(box.data)
+		case "trak":
+			readSoundTrack(box.data, metadata)
+		case "udta":
+			readUserData(box.data, metadata)
+		}
+	}
+	metadata.Bitrate = bitrateOf(mediaDataSize, metadata.Duration)
+	return metadata, nil
+}
+
+// readMp4BoxHeader reads a box header from content. The size of a box reaching to the end of content is resolved.
+func readMp4BoxHeader(content io.ReadSeeker) (boxType string, size int64, headerSize int64, err error) {
+	header := make([]byte, 8)
+	if _, err = io.ReadFull(content, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return "", 0, 0, ErrUnrecognizedMedia
+		}
+		return "", 0, 0, err
+	}
+
+	boxType = string(header[4:8])
+	size, headerSize = int64(binary.BigEndian.Uint32(header[:4])), 8
+	switch size {
+	case 0:
+		current, err := content.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return "", 0, 0, err
+		}
+		end, err := content.Seek(0, io.SeekEnd)
+		if err != nil {
+			return "", 0, 0, err
+		}
+		if _, err = content.Seek(current, io.SeekStart); err != nil {
+			return "", 0, 0, err
+		}
+		return boxType, end - current + headerSize, headerSize, nil
+	case 1:
+		large := make([]byte, 8)
+		if _, err = io.ReadFull(content, large); err != nil {
+			return "", 0, 0, ErrUnrecognizedMedia
+		}
+		size, headerSize = int64(binary.BigEndian.Uint64(large)), 16
+	}
+
+	if size < headerSize {
+		return "", 0, 0, ErrUnrecognizedMedia
+	}
+	return boxType, size, headerSize, nil
+}
+
+// mp4Boxes splits data into its child boxes. Malformed trailing data is ignored.
+func mp4Boxes(data []byte) []mp4Box {
+	var boxes []mp4Box
+	for len(data) >= 8 {
+		size := uint64(binary.BigEndian.Uint32(data[:4]))
+		boxType := string(data[4:8])
+		headerSize := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return boxes
+			}
+			size, headerSize = binary.BigEndian.Uint64(data[8:16]), 16
+		}
+		if size < headerSize || size > uint64(len(data)) {
+			return boxes
+		}
+
+		boxes = append(boxes, mp4Box{boxType: boxType, data: data[headerSize:size]})
+		data = data[size:]
+	}
+	return boxes
+}
+
+func findMp4Box(data []byte, boxType string) []byte {
+	for _, box := range mp4Boxes(data) {
+		if box.boxType == boxType {
+			return box.data
+		}
+	}
+	return nil
+}
+
+// mp4HeaderDuration reads the duration of a mvhd or mdhd box, which share their layout up to the duration.
+func mp4HeaderDuration(data []byte) time.Duration {
+	if len(data) < 1 {
+		return 0
+	}
+	if data[0] == 1 {
+		if len(data) < 32 {
+			return 0
+		}
+		timescale := binary.BigEndian.Uint32(data[20:24])
+		return durationOf(binary.BigEndian.Uint64(data[24:32]), uint64(timescale))
+	}
+	if len(data) < 20 {
+		return 0
+	}
+	timescale := binary.BigEndian.Uint32(data[12:16])
+	return durationOf(uint64(binary.BigEndian.Uint32(data[16:20])), uint64(timescale))
+}
+
+func readSoundTrack(track []byte, metadata *Metadata) {
+	media := findMp4Box(track, "mdia")
+	handler := findMp4Box(media, "hdlr")
+	if len(handler) < 12 || string(handler[8:12]) != "soun" || metadata.SampleRate != 0 {
+		return
+	}
+
+	if metadata.Duration == 0 {
+		metadata.Duration = mp4HeaderDuration(findMp4Box(media, "mdhd"))
+	}
+
+	sampleDescription := findMp4Box(findMp4Box(findMp4Box(media, "minf"), "stbl"), "stsd")
+	if len(sampleDescription) < 8 {
+		return
+	}
+	entries := mp4Boxes(sampleDescription[8:])
+	if len(entries) == 0 || len(entries[0].data) < 28 {
+		return
+	}
+	entry := entries[0].data
+	metadata.Channels = int(binary.BigEndian.Uint16(entry[16:18]))
+	metadata.SampleRate = int(binary.BigEndian.Uint32(entry[24:28]) >> 16)
+}
+
+func readUserData(userData []byte, metadata *Metadata) {
+	if chapters := findMp4Box(userData, "chpl"); chapters != nil {
+		metadata.Chapters = readChapterList(chapters)
+	}
+
+	meta := findMp4Box(userData, "meta")
+	if meta == nil {
+		return
+	}
+	// Apple writes meta as a full box with version and flags, QuickTime files omit them.
+	if len(meta) >= 8 && string(meta[4:8]) != "hdlr" {
+		meta = meta[4:]
+	}
+
+	for _, item := range mp4Boxes(findMp4Box(meta, "ilst")) {
+		value, dataType := mp4ItemValue(item.data)
+		switch item.boxType {
+		case "\xa9nam":
+			metadata.Title = string(value)
+		case "covr":
+			if mimeType := coverMimeType(dataType); mimeType != "" && len(value) > 0 {
+				metadata.Artwork = &EmbeddedArtwork{MimeType: mimeType, Data: value}
+			}
+		}
+	}
+}
+
+func mp4ItemValue(item []byte) (value []byte, dataType uint32) {
+	data := findMp4Box(item, "data")
+	if len(data) < 8 {
+		return nil, 0
+	}
+	return data[8:], binary.BigEndian.Uint32(data[:4]) & 0x00FFFFFF
+}
+
+func coverMimeType(dataType uint32) string {
+	switch dataType {
+	case mp4DataTypeJpeg:
+		return "image/jpeg"
+	case mp4DataTypePng:
+		return "image/png"
+	default:
+		return ""
+	}
+}
+
+// readChapterList reads a Nero chapter list, whose start times are given in units of 100 nanoseconds.
+func readChapterList(data []byte) []model.MediaChapter {
+	if len(data) < 5 {
+		return nil
+	}
+	position := 4
+	if data[0] == 1 {
+		position += 4
+	}
+	if position >= len(data) {
+		return nil
+	}
+	count := int(data[position])
+	position++
+
+	chapters := make([]model.MediaChapter, 0, count)
+	for i := 0; i < count && position+9 <= len(data); i++ {
+		start := binary.BigEndian.Uint64(data[position : position+8])
+		titleLength := int(data[position+8])
+		position += 9
+		if position+titleLength > len(data) {
+			break
+		}
+		chapters = append(chapters, model.MediaChapter{
+			Start: time.Duration(start) * 100 * time.Nanosecond,
+			Title: string(data[position : position+titleLength]),
+		})
+		position += titleLength
+	}
+	return chapters
+}
@@ -0,0 +1,213 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+const (
+	mpegVersion25 = 0
+	mpegVersion2  = 2
+	mpegVersion1  = 3
+	mpegLayer3    = 1
+	mpegLayer2    = 2
+	mpegLayer1    = 3
+	mpegMono      = 3
+
+	mpegSyncSearchLimit = 64 * 1024
+	id3v1TagSize        = 128
+)
+
+// mpegBitrates holds the bitrates in kbit/s indexed by [version == 1][layer][bitrate index].
+var mpegBitrates = [2][4][16]int{
+	{
+		{},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256, 0},
+	},
+	{
+		{},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384, 0},
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448, 0},
+	},
+}
+
+// mpegSampleRates holds the sample rates in Hz indexed by [version][sample rate index].
+var mpegSampleRates = [4][3]int{
+	mpegVersion25: {11025, 12000, 8000},
+	mpegVersion2:  {22050, 24000, 16000},
+	mpegVersion1:  {44100, 48000, 32000},
+}
+
+type mpegFrameHeader struct {
+	version     byte
+	layer       byte
+	bitrate     int
+	sampleRate  int
+	padding     int
+	channelMode byte
+}
+
+func parseMpegFrameHeader(data []byte) (header mpegFrameHeader, valid bool) {
+	if len(data) < 4 || data[0] != 0xFF || data[1]&0xE0 != 0xE0 {
+		return header, false
+	}
+
+	header.version = (data[1] >> 3) & 0x03
+	header.layer = (data[1] >> 1) & 0x03
+	bitrateIndex := data[2] >> 4
+	sampleRateIndex := (data[2] >> 2) & 0x03
+	if header.version == 1 || header.layer == 0 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return header, false
+	}
+
+	versionIndex := 0
+	if header.version == mpegVersion1 {
+		versionIndex = 1
+	}
+	header.bitrate = mpegBitrates[versionIndex][header.layer][bitrateIndex] * 1000
+	header.sampleRate = mpegSampleRates[header.version][sampleRateIndex]
+	header.padding = int(data[2]>>1) & 0x01
+	header.channelMode = data[3] >> 6
+	return header, true
+}
+
+func (h mpegFrameHeader) samplesPerFrame() int {
+	switch {
+	case h.layer == mpegLayer1:
+		return 384
+	case h.layer == mpegLayer3 && h.version != mpegVersion1:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+func (h mpegFrameHeader) frameLength() int {
+	if h.layer == mpegLayer1 {
+		return (12*h.bitrate/h.sampleRate + h.padding) * 4
+	}
+	return h.samplesPerFrame()/8*h.bitrate/h.sampleRate + h.padding
+}
+
+func (h mpegFrameHeader) channels() int {
+	if h.channelMode == mpegMono {
+		return 1
+	}
+	return 2
+}
+
+// xingOffset returns the position of a Xing or Info header relative to the frame start.
+func (h mpegFrameHeader) xingOffset() int {
+	switch {
+	case h.version == mpegVersion1 && h.channelMode != mpegMono:
+		return 4 + 32
+	case h.version == mpegVersion1, h.channelMode != mpegMono:
+		return 4 + 17
+	default:
+		return 4 + 9
+	}
+}
+
+func analyzeMpeg(content io.ReadSeeker) (*Metadata, error) {
+	tag, audioStart, err := readId3v2(content)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if hasId3v1Tag(content, end) {
+		end -= id3v1TagSize
+	}
+
+	if _, err = content.Seek(audioStart, io.SeekStart); err != nil {
+		return nil, err
+	}
+	window := make([]byte, mpegSyncSearchLimit)
+	read, err := io.ReadFull(content, window)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, ErrUnrecognizedMedia
+	}
+	window = window[:read]
+
+	offset, header, found := findFirstMpegFrame(window)
+	if !found {
+		return nil, ErrUnrecognizedMedia
+	}
+	frame := window[offset:]
+	audioBytes := end - audioStart - int64(offset)
+
+	metadata := &Metadata{SampleRate: header.sampleRate, Channels: header.channels()}
+	if frames, ok := vbrFrameCount(frame, header); ok {
+		metadata.Duration = durationOf(uint64(frames)*uint64(header.samplesPerFrame()), uint64(header.sampleRate))
+		metadata.Bitrate = bitrateOf(audioBytes, metadata.Duration)
+	} else {
+		metadata.Bitrate = header.bitrate
+		metadata.Duration = durationOf(uint64(audioBytes)*8, uint64(header.bitrate))
+	}
+
+	if tag != nil {
+		metadata.Title = tag.title
+		metadata.Artwork = tag.artwork
+		metadata.Chapters = tag.chapters
+	}
+	return metadata, nil
+}
+
+// findFirstMpegFrame searches a frame header which is directly followed by another valid frame header,
+// to avoid mistaking random data for a frame sync.
+func findFirstMpegFrame(window []byte) (offset int, header mpegFrameHeader, found bool) {
+	for offset = 0; offset+4 <= len(window); offset++ {
+		var valid bool
+		if header, valid = parseMpegFrameHeader(window[offset:]); !valid {
+			continue
+		}
+
+		next := offset + header.frameLength()
+		if next+4 > len(window) {
+			return offset, header, next == len(window)
+		}
+		if following, valid := parseMpegFrameHeader(window[next:]); valid &&
+			following.version == header.version && following.layer == header.layer && following.sampleRate == header.sampleRate {
+			return offset, header, true
+		}
+	}
+	return 0, header, false
+}
+
+// vbrFrameCount reads the total frame count from a Xing, Info or VBRI header in the first frame.
+func vbrFrameCount(frame []byte, header mpegFrameHeader) (uint32, bool) {
+	xing := header.xingOffset()
+	if len(frame) >= xing+12 {
+		id := string(frame[xing : xing+4])
+		hasFrameCount := frame[xing+7]&0x01 != 0
+		if (id == "Xing" || id == "Info") && hasFrameCount {
+			return binary.BigEndian.Uint32(frame[xing+8 : xing+12]), true
+		}
+	}
+
+	const vbri = 4 + 32
+	if len(frame) >= vbri+18 && string(frame[vbri:vbri+4]) == "VBRI" {
+		return binary.BigEndian.Uint32(frame[vbri+14 : vbri+18]), true
+	}
+	return 0, false
+}
+
+func hasId3v1Tag(content io.ReadSeeker, end int64) bool {
+	if end < id3v1TagSize {
+		return false
+	}
+	if _, err := content.Seek(end-id3v1TagSize, io.SeekStart); err != nil {
+		return false
+	}
+	marker := make([]byte, 3)
+	if _, err := io.ReadFull(content, marker); err != nil {
+		return false
+	}
+	return string(marker) == "TAG"
+}
@@ -0,0 +1,43 @@
+// Package pagination provides the page sizes and opaque cursors of keyset paginated listings.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	error2 "podGopher/core/domain/error"
+)
+
+const (
+	DefaultPageSize = 20
+	MaximumPageSize = 100
+)
+
+// PageSize returns the default size if no limit was requested and caps limits at the maximum size.
+func PageSize(limit int) int {
+	switch {
+	case limit <= 0:
+		return DefaultPageSize
+	case limit > MaximumPageSize:
+		return MaximumPageSize
+	default:
+		return limit
+	}
+}
+
+// EncodeCursor turns the sort values of the last item of a page into an opaque token.
+func EncodeCursor(key any) string {
+	data, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor reads a token of EncodeCursor into key.
+func DecodeCursor(token string, key any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, key)
+	}
+	if err != nil {
+		return error2.NewInvalidCursorError(token)
+	}
+	return nil
+}
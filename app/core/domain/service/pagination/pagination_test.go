@@ -0,0 +1,58 @@
+package pagination
+
+import (
+	error2 "podGopher/core/domain/error"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type someKey struct {
+	Id    string `json:"i"`
+	Value int    `json:"v"`
+}
+
+func Test_should_limit_page_size(t *testing.T) {
+	tests := map[string]struct {
+		limit    int
+		expected int
+	}{
+		"no limit":  {0, DefaultPageSize},
+		"negative":  {-1, DefaultPageSize},
+		"limit":     {5, 5},
+		"maximum":   {MaximumPageSize, MaximumPageSize},
+		"too large": {MaximumPageSize + 1, MaximumPageSize},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PageSize(test.limit))
+		})
+	}
+}
+
+func Test_should_decode_encoded_cursor(t *testing.T) {
+	token := EncodeCursor(someKey{Id: "some-id", Value: 42})
+
+	var key someKey
+	err := DecodeCursor(token, &key)
+
+	assert.Nil(t, err)
+	assert.Equal(t, someKey{Id: "some-id", Value: 42}, key)
+}
+
+func Test_should_reject_invalid_cursor(t *testing.T) {
+	tests := map[string]string{
+		"no base64": "not a cursor!",
+		"no json":   "bm8ganNvbg",
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			var key someKey
+			err := DecodeCursor(token, &key)
+
+			assert.Equal(t, error2.NewInvalidCursorError(token), err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package show
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type BackfillShowGuidsService struct {
+	backfillShowGuidsOutPort outbound.BackfillShowGuidsPort
+	feedUrlOf                func(showId string) string
+}
+
+func NewBackfillShowGuidsService(repository outbound.BackfillShowGuidsPort, feedUrlOf func(showId string) string) *BackfillShowGuidsService {
+	return &BackfillShowGuidsService{
+		backfillShowGuidsOutPort: repository,
+		feedUrlOf:                feedUrlOf,
+	}
+}
+
+// BackfillShowGuids persists the podcast:guid which feeds of shows created without one derived on every request,
+// from the canonical feed url instead of the host of the request.
+func (service *BackfillShowGuidsService) BackfillShowGuids(ctx context.Context, _ *inbound.BackfillShowGuidsCommand) (response *inbound.BackfillShowGuidsResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BackfillShowGuids")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	showIds, err := service.backfillShowGuidsOutPort.GetShowIdsWithoutGuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, showId := range showIds {
+		if err = service.backfillShowGuidsOutPort.SetShowGuidIfMissing(ctx, showId, model.NewPodcastGuid(service.feedUrlOf(showId))); err != nil {
+			return nil, err
+		}
+	}
+	return &inbound.BackfillShowGuidsResponse{ShowIds: showIds}, nil
+}
@@ -0,0 +1,57 @@
+package show
+
+import (
+	"errors"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var backfillShowGuidsService = NewBackfillShowGuidsService(mockBackfillShowGuidsAdapter, func(showId string) string {
+	return "https://example.com/show/" + showId + "/feed.xml"
+})
+
+func Test_should_implement_BackfillShowGuidsInPort(t *testing.T) {
+	assert.NotNil(t, backfillShowGuidsService)
+	assert.Implements(t, (*inbound.BackfillShowGuidsPort)(nil), backfillShowGuidsService)
+}
+
+func Test_should_store_guid_derived_from_feed_url_of_shows_without_guid(t *testing.T) {
+	defer initAdapter()
+	mockBackfillShowGuidsAdapter.returnsOnGetShowIdsWithoutGuid = []string{"first-show-id", "second-show-id"}
+
+	result, err := backfillShowGuidsService.BackfillShowGuids(t.Context(), &inbound.BackfillShowGuidsCommand{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first-show-id", "second-show-id"}, result.ShowIds)
+	assert.Equal(t, map[string]string{
+		"first-show-id":  "d2c988ac-dc03-5109-a415-a3ddc76ec699",
+		"second-show-id": "07b69c54-ed64-5426-9aff-29779cc3a78d",
+	}, mockBackfillShowGuidsAdapter.setGuids)
+}
+
+func Test_should_propagate_errors_on_backfill_show_guids(t *testing.T) {
+	expectedError := errors.New("some error")
+
+	t.Run("get", func(t *testing.T) {
+		defer initAdapter()
+		mockBackfillShowGuidsAdapter.withErrorOnGet = expectedError
+
+		result, err := backfillShowGuidsService.BackfillShowGuids(t.Context(), &inbound.BackfillShowGuidsCommand{})
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		defer initAdapter()
+		mockBackfillShowGuidsAdapter.returnsOnGetShowIdsWithoutGuid = []string{"some-show-id"}
+		mockBackfillShowGuidsAdapter.withErrorOnSet = expectedError
+
+		result, err := backfillShowGuidsService.BackfillShowGuids(t.Context(), &inbound.BackfillShowGuidsCommand{})
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+}
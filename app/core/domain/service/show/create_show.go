@@ -1,33 +1,130 @@
 package show
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
 	"podGopher/core/port/inbound"
 	"podGopher/core/port/outbound"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
+// maxSlugAttempts limits how often a show with generated slug is saved again after a concurrent request took it.
+const maxSlugAttempts = 3
+
+// maxSlugSuffix bounds the lookups of a free numbered slug, a slug taken that often gets a random suffix instead.
+const maxSlugSuffix = 10
+
 type CreateShowService struct {
 	saveShowPort outbound.SaveShowPort
+	feedUrlOf    func(showId string) string
 }
 
-func NewCreateShowService(repository outbound.SaveShowPort) *CreateShowService {
+// NewCreateShowService derives the podcast:guid of shows without one from the canonical feed url given by feedUrlOf.
+func NewCreateShowService(repository outbound.SaveShowPort, feedUrlOf func(showId string) string) *CreateShowService {
 	return &CreateShowService{
 		saveShowPort: repository,
+		feedUrlOf:    feedUrlOf,
 	}
 }
 
-func (service *CreateShowService) CreateShow(command *inbound.CreateShowCommand) (*inbound.CreateShowResponse, error) {
-	if exists := service.saveShowPort.ExistsByTitleOrSlug(command.Title, command.Slug); exists != false {
-		return nil, error2.NewShowAlreadyExistsError(command.Title)
+func (service *CreateShowService) CreateShow(ctx context.Context, command *inbound.CreateShowCommand) (response *inbound.CreateShowResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CreateShow")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if command.Slug != "" && !model.IsValidSlug(command.Slug) {
+		return nil, error2.NewInvalidSlugError(command.Slug)
+	}
+
+	show := &model.Show{
+		Id:                          uuid.NewString(),
+		Title:                       command.Title,
+		Slug:                        command.Slug,
+		Guid:                        command.Guid,
+		Locked:                      command.Locked,
+		AllowDuplicateEpisodeTitles: command.AllowDuplicateEpisodeTitles,
+		Funding:                     command.Funding,
+		Persons:                     command.Persons,
+		Description:                 command.Description,
+		Language:                    command.Language,
+		Author:                      command.Author,
+		ImageUrl:                    command.ImageUrl,
+		Category:                    command.Category,
+		Subcategory:                 command.Subcategory,
+		Explicit:                    command.Explicit,
+	}
+	assignPodcastGuid(show, service.feedUrlOf)
+	generateSlug := command.Slug == ""
+	err = service.saveShow(ctx, show, generateSlug)
+	var alreadyExists *error2.ShowAlreadyExistsError
+	// a concurrent request may have taken the generated slug, the next attempt derives another one
+	for attempt := 2; generateSlug && errors.As(err, &alreadyExists) && attempt <= maxSlugAttempts; attempt++ {
+		err = service.saveShow(ctx, show, generateSlug)
 	}
-	id := uuid.NewString()
-	show := &model.Show{Id: id, Title: command.Title, Slug: command.Slug}
-	err := service.saveShowPort.SaveShow(show)
 	if err != nil {
 		return nil, err
 	}
-	return &inbound.CreateShowResponse{Id: show.Id, Title: show.Title, Slug: show.Slug}, nil
+	return &inbound.CreateShowResponse{
+		Id:                          show.Id,
+		Title:                       show.Title,
+		Slug:                        show.Slug,
+		Guid:                        show.Guid,
+		Locked:                      show.Locked,
+		AllowDuplicateEpisodeTitles: show.AllowDuplicateEpisodeTitles,
+		Funding:                     show.Funding,
+		Persons:                     show.Persons,
+		Description:                 show.Description,
+		Language:                    show.Language,
+		Author:                      show.Author,
+		ImageUrl:                    show.ImageUrl,
+		Category:                    show.Category,
+		Subcategory:                 show.Subcategory,
+		Explicit:                    show.Explicit,
+	}, nil
+}
+
+// assignPodcastGuid gives a show without podcast:guid the one derived from its feed url by id, which stays when
+// the slug of the show or the host of a request changes.
+func assignPodcastGuid(show *model.Show, feedUrlOf func(showId string) string) {
+	if show.Guid == "" {
+		show.Guid = model.NewPodcastGuid(feedUrlOf(show.Id))
+	}
+}
+
+func (service *CreateShowService) saveShow(ctx context.Context, show *model.Show, generateSlug bool) (err error) {
+	if generateSlug {
+		if show.Slug, err = service.freeSlug(ctx, model.NewSlug(show.Title)); err != nil {
+			return err
+		}
+	}
+	// answers the usual case early, the repository refuses a title or slug taken by a concurrent request on saving
+	exists, err := service.saveShowPort.ExistsByTitleOrSlug(ctx, show.Title, show.Slug)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return error2.NewShowAlreadyExistsError(show.Title)
+	}
+	return service.saveShowPort.SaveShow(ctx, show)
+}
+
+// freeSlug returns the slug, or the first of slug-2 to slug-10 which no show has or had. Once these are taken,
+// it returns the slug with a random suffix, which the saving checks like any other.
+func (service *CreateShowService) freeSlug(ctx context.Context, slug string) (string, error) {
+	for suffix := 1; suffix <= maxSlugSuffix; suffix++ {
+		candidate := slug
+		if suffix > 1 {
+			candidate = fmt.Sprintf("%s-%d", slug, suffix)
+		}
+		exists, err := service.saveShowPort.ExistsBySlug(ctx, candidate)
+		if err != nil || !exists {
+			return candidate, err
+		}
+	}
+	return slug + "-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:8], nil
 }
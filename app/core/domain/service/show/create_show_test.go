@@ -2,6 +2,7 @@ package show
 
 import (
 	"errors"
+	"fmt"
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
@@ -10,7 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-var createShowService = NewCreateShowService(mockSaveAndGetShowAdapter)
+var createShowService = NewCreateShowService(mockSaveAndGetShowAdapter, podnewsFeedUrl)
 
 func Test_should_implement_CreateShowInPort(t *testing.T) {
 	assert.NotNil(t, createShowService)
@@ -20,17 +21,23 @@ func Test_should_implement_CreateShowInPort(t *testing.T) {
 func Test_should_save_a_new_show(t *testing.T) {
 	defer initAdapter()
 
-	mockSaveAndGetShowAdapter.everyExistsByTitleOrSlugReturns("Test", "Test-Slug", false)
+	mockSaveAndGetShowAdapter.everyExistsByTitleOrSlugReturns("Test", "test-slug", false)
 	createShowCommand := newTestCreateShowCommand("Test")
 
-	result, err := createShowService.CreateShow(createShowCommand)
+	result, err := createShowService.CreateShow(t.Context(), createShowCommand)
 
 	savedShow := mockSaveAndGetShowAdapter.onSave["show"]
 
 	expectedSavedShow := &model.Show{
-		Id:    savedShow.Id,
-		Title: "Test",
-		Slug:  "Test-Slug",
+		Id:       savedShow.Id,
+		Title:    "Test",
+		Slug:     "test-slug",
+		Guid:     "Test-Guid",
+		Locked:   true,
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support Test"}},
+		Persons:  []model.Person{{Name: "Test Host", Role: "host"}},
+		Author:   "Test Author",
+		Explicit: true,
 	}
 	assert.NotNil(t, savedShow)
 	assert.Equal(t, 1, mockSaveAndGetShowAdapter.calledSave)
@@ -41,17 +48,39 @@ func Test_should_save_a_new_show(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.IsType(t, (*inbound.CreateShowResponse)(nil), result)
 
-	expectedCreatedShow := &inbound.CreateShowResponse{Id: savedShow.Id, Title: "Test", Slug: "Test-Slug"}
+	expectedCreatedShow := &inbound.CreateShowResponse{
+		Id:       savedShow.Id,
+		Title:    "Test",
+		Slug:     "test-slug",
+		Guid:     "Test-Guid",
+		Locked:   true,
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support Test"}},
+		Persons:  []model.Person{{Name: "Test Host", Role: "host"}},
+		Author:   "Test Author",
+		Explicit: true,
+	}
 	assert.Equal(t, expectedCreatedShow, result)
 }
 
+func Test_should_derive_guid_from_feed_url_if_missing_on_create_show(t *testing.T) {
+	defer initAdapter()
+	createShowCommand := newTestCreateShowCommand("Test")
+	createShowCommand.Guid = ""
+
+	result, err := createShowService.CreateShow(t.Context(), createShowCommand)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "9b024349-ccf0-5f69-a609-6b82873eab3c", mockSaveAndGetShowAdapter.onSave["show"].Guid)
+	assert.Equal(t, "9b024349-ccf0-5f69-a609-6b82873eab3c", result.Guid)
+}
+
 func Test_should_throw_error_if_show_with_name_already_exists(t *testing.T) {
 	defer initAdapter()
 
-	mockSaveAndGetShowAdapter.everyExistsByTitleOrSlugReturns("Test", "Test-Slug", true)
+	mockSaveAndGetShowAdapter.everyExistsByTitleOrSlugReturns("Test", "test-slug", true)
 
 	show := newTestCreateShowCommand("Test")
-	result, err := createShowService.CreateShow(show)
+	result, err := createShowService.CreateShow(t.Context(), show)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -66,10 +95,106 @@ func Test_should_propagate_errors_from_adapter_on_create_show(t *testing.T) {
 	mockSaveAndGetShowAdapter.withErrorOnSaveShow = expectedError
 
 	show := newTestCreateShowCommand("Fake")
-	result, err := createShowService.CreateShow(show)
+	result, err := createShowService.CreateShow(t.Context(), show)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, 1, mockSaveAndGetShowAdapter.calledSave)
 }
+
+func Test_should_not_save_show_if_uniqueness_could_not_be_checked(t *testing.T) {
+	defer initAdapter()
+
+	expectedError := error2.NewRepositoryError(error2.ConnectionFailure, errors.New("connection refused"))
+	mockSaveAndGetShowAdapter.withErrorOnExists = expectedError
+
+	result, err := createShowService.CreateShow(t.Context(), newTestCreateShowCommand("Test"))
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+	assert.Equal(t, 0, mockSaveAndGetShowAdapter.calledSave)
+}
+
+func Test_should_refuse_invalid_slug_on_create_show(t *testing.T) {
+	defer initAdapter()
+
+	command := newTestCreateShowCommand("Test")
+	command.Slug = "Test Slug"
+
+	result, err := createShowService.CreateShow(t.Context(), command)
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.InvalidSlugError{Slug: "Test Slug"}, err)
+	assert.Equal(t, 0, mockSaveAndGetShowAdapter.calledSave)
+}
+
+func Test_should_generate_slug_from_title_on_create_show(t *testing.T) {
+	defer initAdapter()
+
+	command := newTestCreateShowCommand("Café Crème")
+	command.Slug = ""
+
+	result, err := createShowService.CreateShow(t.Context(), command)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "cafe-creme", result.Slug)
+	assert.Equal(t, "cafe-creme", mockSaveAndGetShowAdapter.onSave["show"].Slug)
+}
+
+func Test_should_append_suffix_to_taken_slug_on_create_show(t *testing.T) {
+	defer initAdapter()
+
+	mockSaveAndGetShowAdapter.returnsOnExistsBySlug["test"] = true
+	mockSaveAndGetShowAdapter.returnsOnExistsBySlug["test-2"] = true
+	command := newTestCreateShowCommand("Test")
+	command.Slug = ""
+
+	result, err := createShowService.CreateShow(t.Context(), command)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "test-3", result.Slug)
+}
+
+func Test_should_append_random_suffix_to_slug_taken_too_often_on_create_show(t *testing.T) {
+	defer initAdapter()
+
+	mockSaveAndGetShowAdapter.returnsOnExistsBySlug["test"] = true
+	for suffix := 2; suffix <= maxSlugSuffix; suffix++ {
+		mockSaveAndGetShowAdapter.returnsOnExistsBySlug[fmt.Sprintf("test-%d", suffix)] = true
+	}
+	command := newTestCreateShowCommand("Test")
+	command.Slug = ""
+
+	result, err := createShowService.CreateShow(t.Context(), command)
+
+	assert.Nil(t, err)
+	assert.Regexp(t, "^test-[0-9a-f]{8}$", result.Slug)
+}
+
+func Test_should_not_append_suffix_to_supplied_slug_on_create_show(t *testing.T) {
+	defer initAdapter()
+
+	mockSaveAndGetShowAdapter.returnsOnExistsBySlug["test-slug"] = true
+	mockSaveAndGetShowAdapter.everyExistsByTitleOrSlugReturns("Test", "test-slug", true)
+
+	result, err := createShowService.CreateShow(t.Context(), newTestCreateShowCommand("Test"))
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowAlreadyExistsError{Name: "Test"}, err)
+	assert.Equal(t, 0, mockSaveAndGetShowAdapter.calledSave)
+}
+
+func Test_should_retry_saving_show_with_generated_slug_taken_concurrently(t *testing.T) {
+	defer initAdapter()
+
+	mockSaveAndGetShowAdapter.withErrorOnSaveShow = error2.NewShowAlreadyExistsError("Test")
+	command := newTestCreateShowCommand("Test")
+	command.Slug = ""
+
+	result, err := createShowService.CreateShow(t.Context(), command)
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowAlreadyExistsError{Name: "Test"}, err)
+	assert.Equal(t, maxSlugAttempts, mockSaveAndGetShowAdapter.calledSave)
+}
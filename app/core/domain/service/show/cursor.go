@@ -0,0 +1,39 @@
+package show
+
+import (
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"time"
+)
+
+// showCursor remembers the sort it was created for, so it cannot continue a listing of another order.
+type showCursor struct {
+	Sort      model.ShowSort  `json:"s"`
+	Order     model.SortOrder `json:"o"`
+	Id        string          `json:"i"`
+	Title     string          `json:"t,omitempty"`
+	CreatedAt time.Time       `json:"c,omitempty"`
+}
+
+func encodeCursor(sort model.ShowSort, order model.SortOrder, show *model.Show) string {
+	cursor := showCursor{Sort: sort, Order: order, Id: show.Id}
+	switch sort {
+	case model.ShowSortTitle:
+		cursor.Title = show.Title
+	case model.ShowSortCreatedAt:
+		cursor.CreatedAt = show.CreatedAt
+	}
+	return pagination.EncodeCursor(cursor)
+}
+
+func decodeCursor(token string, sort model.ShowSort, order model.SortOrder) (*model.ShowKey, error) {
+	var cursor showCursor
+	if err := pagination.DecodeCursor(token, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.Id == "" || cursor.Sort != sort || cursor.Order != order {
+		return nil, error2.NewInvalidCursorError(token)
+	}
+	return &model.ShowKey{Id: cursor.Id, Title: cursor.Title, CreatedAt: cursor.CreatedAt}, nil
+}
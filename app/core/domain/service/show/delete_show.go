@@ -0,0 +1,62 @@
+package show
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type DeleteShowService struct {
+	getShowOutPort         outbound.GetShowPort
+	getShowEpisodesOutPort outbound.GetShowEpisodesPort
+	deleteShowOutPort      outbound.DeleteShowPort
+	mediaStorageOutPort    outbound.MediaStoragePort
+}
+
+func NewDeleteShowService(
+	showRepository outbound.GetShowPort,
+	episodeRepository outbound.GetShowEpisodesPort,
+	deleteShowRepository outbound.DeleteShowPort,
+	mediaStorage outbound.MediaStoragePort,
+) *DeleteShowService {
+	return &DeleteShowService{
+		getShowOutPort:         showRepository,
+		getShowEpisodesOutPort: episodeRepository,
+		deleteShowOutPort:      deleteShowRepository,
+		mediaStorageOutPort:    mediaStorage,
+	}
+}
+
+// DeleteShow deletes a show with all of its episodes and their media. Recorded downloads are kept.
+func (service *DeleteShowService) DeleteShow(ctx context.Context, command *inbound.DeleteShowCommand) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "DeleteShow")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, err := service.getShowOutPort.GetShowOrNil(ctx, command.Id)
+	if err != nil {
+		return err
+	}
+	if show == nil {
+		return error2.NewShowNotFoundError(command.Id)
+	}
+
+	episodes, err := service.getShowEpisodesOutPort.GetEpisodesOfShow(ctx, show.Id)
+	if err != nil {
+		return err
+	}
+	if err = service.deleteShowOutPort.DeleteShow(ctx, show.Id); err != nil {
+		return err
+	}
+
+	// media is removed after the episodes, so a failure leaves unreferenced files rather than broken episodes
+	for _, episode := range episodes {
+		if episode.Media != nil {
+			for _, key := range episode.Media.StorageKeys() {
+				_ = service.mediaStorageOutPort.DeleteMedia(ctx, key)
+			}
+		}
+	}
+	return nil
+}
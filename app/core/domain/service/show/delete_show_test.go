@@ -0,0 +1,70 @@
+package show
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var deleteShowService = NewDeleteShowService(mockGetShowAdapter, mockGetShowEpisodesAdapter, mockUpdateAndDeleteShowAdapter, mockMediaStorageAdapter)
+
+func Test_should_implement_DeleteShowInPort(t *testing.T) {
+	assert.NotNil(t, deleteShowService)
+	assert.Implements(t, (*inbound.DeleteShowPort)(nil), deleteShowService)
+}
+
+func Test_should_throw_error_if_show_does_not_exist_on_delete_show(t *testing.T) {
+	defer initAdapter()
+
+	err := deleteShowService.DeleteShow(t.Context(), &inbound.DeleteShowCommand{Id: "some-show-id"})
+
+	assert.Equal(t, error2.NewShowNotFoundError("some-show-id"), err)
+	assert.Empty(t, mockUpdateAndDeleteShowAdapter.deleted)
+}
+
+func Test_should_delete_show_with_media_of_its_episodes(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	mockGetShowEpisodesAdapter.returnsOnGetEpisodesOfShow["some-show-id"] = []*model.Episode{
+		{Id: "first-id", Media: &model.Media{Key: "first-id/episode.mp3", Artwork: &model.Artwork{Key: "first-id/artwork.jpg"}}},
+		{Id: "second-id"},
+		{Id: "third-id", Media: &model.Media{Key: "third-id/episode.m4a"}},
+	}
+
+	err := deleteShowService.DeleteShow(t.Context(), &inbound.DeleteShowCommand{Id: "some-show-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"some-show-id"}, mockUpdateAndDeleteShowAdapter.deleted)
+	assert.Equal(t, []string{"first-id/episode.mp3", "first-id/artwork.jpg", "third-id/episode.m4a"}, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_keep_media_if_show_could_not_be_deleted(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	expectedError := errors.New("some error")
+	mockGetShowEpisodesAdapter.returnsOnGetEpisodesOfShow["some-show-id"] = []*model.Episode{
+		{Id: "first-id", Media: &model.Media{Key: "first-id/episode.mp3"}},
+	}
+	mockUpdateAndDeleteShowAdapter.withErrorOnDelete = expectedError
+
+	err := deleteShowService.DeleteShow(t.Context(), &inbound.DeleteShowCommand{Id: "some-show-id"})
+
+	assert.Equal(t, expectedError, err)
+	assert.Empty(t, mockMediaStorageAdapter.deleted)
+}
+
+func Test_should_propagate_error_of_episodes_on_delete_show(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	expectedError := errors.New("some error")
+	mockGetShowEpisodesAdapter.withErrorOnGetEpisodesOfShow = expectedError
+
+	err := deleteShowService.DeleteShow(t.Context(), &inbound.DeleteShowCommand{Id: "some-show-id"})
+
+	assert.Equal(t, expectedError, err)
+	assert.Empty(t, mockUpdateAndDeleteShowAdapter.deleted)
+}
@@ -1,8 +1,10 @@
 package show
 
 import (
+	"context"
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
 	"podGopher/core/port/inbound"
 	"podGopher/core/port/outbound"
 )
@@ -17,19 +19,38 @@ func NewGetShowService(repository outbound.GetShowPort) *GetShowService {
 	}
 }
 
-func (s *GetShowService) GetShow(command *inbound.GetShowCommand) (showResponse *inbound.GetShowResponse, err error) {
+func (s *GetShowService) GetShow(ctx context.Context, command *inbound.GetShowCommand) (showResponse *inbound.GetShowResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetShow")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var show *model.Show
-	if show, err = s.repository.GetShowOrNil(command.Id); err != nil {
+	if show, err = s.repository.GetShowOrNil(ctx, command.Id); err != nil {
 		return nil, err
 	}
 
 	if show == nil {
 		return nil, error2.NewShowNotFoundError(command.Id)
 	}
+	return showResponseOf(show), nil
+}
+
+func showResponseOf(show *model.Show) *inbound.GetShowResponse {
 	return &inbound.GetShowResponse{
-		Id:       show.Id,
-		Title:    show.Title,
-		Slug:     show.Slug,
-		Episodes: show.Episodes,
-	}, nil
+		Id:                          show.Id,
+		Title:                       show.Title,
+		Slug:                        show.Slug,
+		Guid:                        show.Guid,
+		Locked:                      show.Locked,
+		AllowDuplicateEpisodeTitles: show.AllowDuplicateEpisodeTitles,
+		Funding:                     show.Funding,
+		Persons:                     show.Persons,
+		Description:                 show.Description,
+		Language:                    show.Language,
+		Author:                      show.Author,
+		ImageUrl:                    show.ImageUrl,
+		Category:                    show.Category,
+		Subcategory:                 show.Subcategory,
+		Explicit:                    show.Explicit,
+		Episodes:                    show.Episodes,
+	}
 }
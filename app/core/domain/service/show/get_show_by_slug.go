@@ -0,0 +1,33 @@
+package show
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type GetShowBySlugService struct {
+	repository outbound.GetShowBySlugPort
+}
+
+func NewGetShowBySlugService(repository outbound.GetShowBySlugPort) *GetShowBySlugService {
+	return &GetShowBySlugService{
+		repository: repository,
+	}
+}
+
+func (s *GetShowBySlugService) GetShowBySlug(ctx context.Context, command *inbound.GetShowBySlugCommand) (response *inbound.GetShowResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetShowBySlug")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, err := s.repository.GetShowBySlugOrNil(ctx, command.Slug)
+	if err != nil {
+		return nil, err
+	}
+	if show == nil {
+		return nil, error2.NewShowSlugNotFoundError(command.Slug)
+	}
+	return showResponseOf(show), nil
+}
@@ -0,0 +1,60 @@
+package show
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var getShowBySlugService = NewGetShowBySlugService(mockGetShowAdapter)
+
+func Test_should_implement_GetShowBySlugInPort(t *testing.T) {
+	assert.NotNil(t, getShowBySlugService)
+	assert.Implements(t, (*inbound.GetShowBySlugPort)(nil), getShowBySlugService)
+}
+
+func Test_should_return_not_found_if_slug_was_not_found(t *testing.T) {
+	defer initAdapter()
+
+	result, err := getShowBySlugService.GetShowBySlug(t.Context(), &inbound.GetShowBySlugCommand{Slug: "unknown-slug"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowSlugNotFoundError{Slug: "unknown-slug"}, err)
+}
+
+func Test_should_propagate_errors_from_adapter_on_get_by_slug(t *testing.T) {
+	defer initAdapter()
+
+	expectedError := errors.New("some error")
+	mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+	result, err := getShowBySlugService.GetShowBySlug(t.Context(), &inbound.GetShowBySlugCommand{Slug: "some-slug"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
+
+func Test_retrieve_show_from_repository_on_get_by_slug(t *testing.T) {
+	defer initAdapter()
+
+	mockGetShowAdapter.returnsOnGetBySlugOrNil["former-slug"] = &model.Show{
+		Id:       "some-id",
+		Title:    "some title",
+		Slug:     "some-slug",
+		Episodes: []string{"some-episode-id"},
+	}
+
+	result, err := getShowBySlugService.GetShowBySlug(t.Context(), &inbound.GetShowBySlugCommand{Slug: "former-slug"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.GetShowResponse{
+		Id:       "some-id",
+		Title:    "some title",
+		Slug:     "some-slug",
+		Episodes: []string{"some-episode-id"},
+	}, result)
+}
@@ -0,0 +1,96 @@
+package show
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type GetShowFeedService struct {
+	getShowOutPort         outbound.GetShowPort
+	getShowBySlugOutPort   outbound.GetShowBySlugPort
+	getShowEpisodesOutPort outbound.GetShowEpisodesPort
+}
+
+func NewGetShowFeedService(
+	showRepository outbound.GetShowPort,
+	showBySlugRepository outbound.GetShowBySlugPort,
+	episodeRepository outbound.GetShowEpisodesPort,
+) *GetShowFeedService {
+	return &GetShowFeedService{
+		getShowOutPort:         showRepository,
+		getShowBySlugOutPort:   showBySlugRepository,
+		getShowEpisodesOutPort: episodeRepository,
+	}
+}
+
+func (s *GetShowFeedService) GetShowFeed(ctx context.Context, command *inbound.GetShowFeedCommand) (feed *inbound.GetShowFeedResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "GetShowFeed")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	var show *model.Show
+	if show, err = s.findShow(ctx, command); err != nil {
+		return nil, err
+	}
+
+	var episodes []*model.Episode
+	if episodes, err = s.getShowEpisodesOutPort.GetEpisodesOfShow(ctx, show.Id); err != nil {
+		return nil, err
+	}
+
+	feed = &inbound.GetShowFeedResponse{
+		Id:          show.Id,
+		Title:       show.Title,
+		Slug:        show.Slug,
+		Guid:        show.Guid,
+		Locked:      show.Locked,
+		Funding:     show.Funding,
+		Persons:     show.Persons,
+		Description: show.Description,
+		Language:    show.Language,
+		Author:      show.Author,
+		ImageUrl:    show.ImageUrl,
+		Category:    show.Category,
+		Subcategory: show.Subcategory,
+		Explicit:    show.Explicit,
+		Episodes:    []*inbound.FeedEpisode{},
+	}
+	for _, episode := range episodes {
+		// drafts, scheduled and unpublished episodes stay out of the feed
+		if episode.Status != model.EpisodePublished {
+			continue
+		}
+		feed.Episodes = append(feed.Episodes, &inbound.FeedEpisode{
+			Id:            episode.Id,
+			Title:         episode.Title,
+			Description:   episode.Description,
+			PublishedAt:   episode.PublishedAt,
+			Season:        episode.Season,
+			EpisodeNumber: episode.EpisodeNumber,
+			Transcripts:   episode.Transcripts,
+			Chapters:      episode.Chapters,
+			Persons:       episode.Persons,
+			Media:         episode.Media,
+		})
+	}
+	return feed, nil
+}
+
+func (s *GetShowFeedService) findShow(ctx context.Context, command *inbound.GetShowFeedCommand) (*model.Show, error) {
+	if command.ShowId == "" {
+		show, err := s.getShowBySlugOutPort.GetShowBySlugOrNil(ctx, command.Slug)
+		if err == nil && show == nil {
+			err = error2.NewShowSlugNotFoundError(command.Slug)
+		}
+		return show, err
+	}
+
+	show, err := s.getShowOutPort.GetShowOrNil(ctx, command.ShowId)
+	if err == nil && show == nil {
+		err = error2.NewShowNotFoundError(command.ShowId)
+	}
+	return show, err
+}
@@ -0,0 +1,162 @@
+package show
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var getShowFeedService = NewGetShowFeedService(mockGetShowAdapter, mockGetShowAdapter, mockGetShowEpisodesAdapter)
+
+func Test_should_implement_GetShowFeedInPort(t *testing.T) {
+	assert.NotNil(t, getShowFeedService)
+	assert.Implements(t, (*inbound.GetShowFeedPort)(nil), getShowFeedService)
+}
+
+func Test_should_return_not_found_if_show_was_not_found_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	command := &inbound.GetShowFeedCommand{ShowId: "non-existing-show-id"}
+	result, err := getShowFeedService.GetShowFeed(t.Context(), command)
+
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+	assert.Equal(t, &error2.ShowNotFoundError{Id: "non-existing-show-id"}, err)
+	assert.Equal(t, 0, mockGetShowEpisodesAdapter.called)
+}
+
+func Test_should_propagate_errors_from_adapters_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	expectedError := errors.New("some error")
+
+	t.Run("show adapter", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+		result, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{ShowId: "some-id"})
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("episode adapter", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.returnsOnGetOrNilShow["some-id"] = &model.Show{Id: "some-id"}
+		mockGetShowEpisodesAdapter.withErrorOnGetEpisodesOfShow = expectedError
+
+		result, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{ShowId: "some-id"})
+
+		assert.Nil(t, result)
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, 1, mockGetShowEpisodesAdapter.called)
+	})
+}
+
+func Test_retrieve_show_with_episodes_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	somePublishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	persons := []model.Person{{Name: "some host", Role: "host"}}
+	funding := []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}}
+	transcripts := []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt"}}
+	chapters := &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"}
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-id"] = &model.Show{
+		Id:          "some-id",
+		Title:       "some title",
+		Slug:        "some-slug",
+		Guid:        "some-guid",
+		Locked:      true,
+		Funding:     funding,
+		Persons:     persons,
+		Description: "some description",
+		Language:    "en",
+		Category:    "Technology",
+		Explicit:    true,
+		Episodes:    []string{"first-episode-id", "second-episode-id"},
+	}
+	mockGetShowEpisodesAdapter.returnsOnGetEpisodesOfShow["some-id"] = []*model.Episode{
+		{Id: "first-episode-id", ShowId: "some-id", Title: "first episode", Season: 1, EpisodeNumber: 1, Status: model.EpisodePublished, Transcripts: transcripts, Chapters: chapters, Persons: persons},
+		{Id: "second-episode-id", ShowId: "some-id", Title: "second episode", Description: "some notes", Status: model.EpisodePublished, PublishedAt: somePublishedAt},
+	}
+	expectedFeed := &inbound.GetShowFeedResponse{
+		Id:          "some-id",
+		Title:       "some title",
+		Slug:        "some-slug",
+		Guid:        "some-guid",
+		Locked:      true,
+		Funding:     funding,
+		Persons:     persons,
+		Description: "some description",
+		Language:    "en",
+		Category:    "Technology",
+		Explicit:    true,
+		Episodes: []*inbound.FeedEpisode{
+			{Id: "first-episode-id", Title: "first episode", Season: 1, EpisodeNumber: 1, Transcripts: transcripts, Chapters: chapters, Persons: persons},
+			{Id: "second-episode-id", Title: "second episode", Description: "some notes", PublishedAt: somePublishedAt},
+		},
+	}
+
+	feed, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{ShowId: "some-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, expectedFeed, feed)
+	assert.Equal(t, 1, mockGetShowEpisodesAdapter.called)
+}
+
+func Test_should_include_only_published_episodes_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-id"] = &model.Show{Id: "some-id", Guid: "some-guid"}
+	mockGetShowEpisodesAdapter.returnsOnGetEpisodesOfShow["some-id"] = []*model.Episode{
+		{Id: "draft-id", Status: model.EpisodeDraft},
+		{Id: "scheduled-id", Status: model.EpisodeScheduled},
+		{Id: "published-id", Status: model.EpisodePublished},
+		{Id: "unpublished-id", Status: model.EpisodeUnpublished},
+	}
+
+	feed, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{ShowId: "some-id"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*inbound.FeedEpisode{{Id: "published-id"}}, feed.Episodes)
+}
+
+func Test_should_return_empty_episode_list_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	mockGetShowAdapter.returnsOnGetOrNilShow["some-id"] = &model.Show{Id: "some-id", Guid: "some-guid"}
+
+	feed, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{ShowId: "some-id"})
+
+	assert.Nil(t, err)
+	assert.NotNil(t, feed.Episodes)
+	assert.Empty(t, feed.Episodes)
+}
+
+func Test_should_find_show_by_slug_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	mockGetShowAdapter.returnsOnGetBySlugOrNil["former-slug"] = &model.Show{Id: "some-id", Slug: "some-slug", Guid: "some-guid"}
+
+	feed, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{Slug: "former-slug"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "some-id", feed.Id)
+	assert.Equal(t, "some-slug", feed.Slug)
+	assert.Equal(t, "some-guid", feed.Guid)
+}
+
+func Test_should_return_not_found_if_slug_was_not_found_on_get_feed(t *testing.T) {
+	defer initAdapter()
+
+	result, err := getShowFeedService.GetShowFeed(t.Context(), &inbound.GetShowFeedCommand{Slug: "unknown-slug"})
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.ShowSlugNotFoundError{Slug: "unknown-slug"}, err)
+	assert.Equal(t, 0, mockGetShowEpisodesAdapter.called)
+}
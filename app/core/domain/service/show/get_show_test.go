@@ -25,7 +25,7 @@ func Test_should_return_not_found_if_show_was_not_found(t *testing.T) {
 	assert.Nil(t, show)
 
 	command := &inbound.GetShowCommand{Id: "non-existing-show-id"}
-	result, err := getShowService.GetShow(command)
+	result, err := getShowService.GetShow(t.Context(), command)
 
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
@@ -39,7 +39,7 @@ func Test_should_propagate_errors_from_adapter_on_get(t *testing.T) {
 	expectedError := errors.New("some error")
 	mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
 
-	foundShow, err := getShowService.GetShow(&inbound.GetShowCommand{Id: "id-with-error"})
+	foundShow, err := getShowService.GetShow(t.Context(), &inbound.GetShowCommand{Id: "id-with-error"})
 
 	assert.Nil(t, foundShow)
 	assert.NotNil(t, err)
@@ -54,18 +54,26 @@ func Test_retrieve_show_from_repository_on_get(t *testing.T) {
 		Id:       "some-id",
 		Title:    "some title",
 		Slug:     "some-slug",
+		Guid:     "some-guid",
+		Locked:   true,
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons:  []model.Person{{Name: "some host", Role: "host"}},
 		Episodes: []string{"some-episode-id"},
 	}
 	expectedShowResponse := &inbound.GetShowResponse{
 		Id:       "some-id",
 		Title:    "some title",
 		Slug:     "some-slug",
+		Guid:     "some-guid",
+		Locked:   true,
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons:  []model.Person{{Name: "some host", Role: "host"}},
 		Episodes: []string{"some-episode-id"},
 	}
 	mockGetShowAdapter.withErrorOnGetOrNilShow = nil
 	mockGetShowAdapter.returnsOnGetOrNilShow["some-id"] = expectedShow
 
-	foundShow, err := getShowService.GetShow(&inbound.GetShowCommand{Id: "some-id"})
+	foundShow, err := getShowService.GetShow(t.Context(), &inbound.GetShowCommand{Id: "some-id"})
 
 	assert.Nil(t, err)
 	assert.NotNil(t, foundShow)
@@ -0,0 +1,68 @@
+package show
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type ListShowsService struct {
+	repository outbound.ListShowsPort
+}
+
+func NewListShowsService(repository outbound.ListShowsPort) *ListShowsService {
+	return &ListShowsService{
+		repository: repository,
+	}
+}
+
+func (s *ListShowsService) ListShows(ctx context.Context, command *inbound.ListShowsCommand) (response *inbound.ListShowsResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ListShows")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	query := &model.ShowQuery{
+		Title: command.Query,
+		Sort:  command.Sort,
+		Order: command.Order,
+		Limit: pagination.PageSize(command.Limit),
+	}
+	if query.Sort == "" {
+		query.Sort = model.ShowSortTitle
+	}
+	if query.Order == "" {
+		query.Order = model.Ascending
+	}
+	if command.Cursor != "" {
+		after, err := decodeCursor(command.Cursor, query.Sort, query.Order)
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	// one show more than requested tells whether another page follows
+	pageSize := query.Limit
+	query.Limit++
+	shows, err := s.repository.ListShows(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &inbound.ListShowsResponse{Shows: []inbound.ShowSummary{}}
+	if len(shows) > pageSize {
+		shows = shows[:pageSize]
+		response.NextCursor = encodeCursor(query.Sort, query.Order, shows[pageSize-1])
+	}
+	for _, show := range shows {
+		response.Shows = append(response.Shows, inbound.ShowSummary{
+			Id:        show.Id,
+			Title:     show.Title,
+			Slug:      show.Slug,
+			CreatedAt: show.CreatedAt,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,149 @@
+package show
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/pagination"
+	"podGopher/core/port/inbound"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var listShowsService = NewListShowsService(mockListShowsAdapter)
+
+var someCreatedAt = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func someShows(count int) []*model.Show {
+	var shows []*model.Show
+	for i := 0; i < count; i++ {
+		shows = append(shows, &model.Show{
+			Id:        string(rune('a'+i)) + "-id",
+			Title:     string(rune('A'+i)) + " Title",
+			Slug:      string(rune('a'+i)) + "-slug",
+			CreatedAt: someCreatedAt.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return shows
+}
+
+func Test_should_implement_ListShowsInPort(t *testing.T) {
+	assert.NotNil(t, listShowsService)
+	assert.Implements(t, (*inbound.ListShowsPort)(nil), listShowsService)
+}
+
+func Test_should_default_query_on_list_shows(t *testing.T) {
+	tests := map[string]struct {
+		command  *inbound.ListShowsCommand
+		expected *model.ShowQuery
+	}{
+		"defaults": {
+			&inbound.ListShowsCommand{},
+			&model.ShowQuery{Sort: model.ShowSortTitle, Order: model.Ascending, Limit: pagination.DefaultPageSize + 1},
+		},
+		"given values": {
+			&inbound.ListShowsCommand{Query: "some", Sort: model.ShowSortCreatedAt, Order: model.Descending, Limit: 5},
+			&model.ShowQuery{Title: "some", Sort: model.ShowSortCreatedAt, Order: model.Descending, Limit: 6},
+		},
+		"limit too large": {
+			&inbound.ListShowsCommand{Limit: 1000},
+			&model.ShowQuery{Sort: model.ShowSortTitle, Order: model.Ascending, Limit: pagination.MaximumPageSize + 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+
+			_, err := listShowsService.ListShows(t.Context(), test.command)
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, mockListShowsAdapter.query)
+		})
+	}
+}
+
+func Test_should_return_last_page_without_cursor(t *testing.T) {
+	defer initAdapter()
+	mockListShowsAdapter.returnsOnListShows = someShows(2)
+
+	result, err := listShowsService.ListShows(t.Context(), &inbound.ListShowsCommand{Limit: 2})
+
+	assert.Nil(t, err)
+	assert.Empty(t, result.NextCursor)
+	assert.Equal(t, []inbound.ShowSummary{
+		{Id: "a-id", Title: "A Title", Slug: "a-slug", CreatedAt: someCreatedAt},
+		{Id: "b-id", Title: "B Title", Slug: "b-slug", CreatedAt: someCreatedAt.Add(time.Hour)},
+	}, result.Shows)
+}
+
+func Test_should_return_empty_page(t *testing.T) {
+	defer initAdapter()
+
+	result, err := listShowsService.ListShows(t.Context(), &inbound.ListShowsCommand{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.ListShowsResponse{Shows: []inbound.ShowSummary{}}, result)
+}
+
+func Test_should_continue_listing_after_cursor(t *testing.T) {
+	tests := map[model.ShowSort]*model.ShowKey{
+		model.ShowSortTitle:     {Id: "b-id", Title: "B Title"},
+		model.ShowSortCreatedAt: {Id: "b-id", CreatedAt: someCreatedAt.Add(time.Hour)},
+	}
+
+	for sort, expectedKey := range tests {
+		t.Run(string(sort), func(t *testing.T) {
+			defer initAdapter()
+			mockListShowsAdapter.returnsOnListShows = someShows(3)
+
+			firstPage, err := listShowsService.ListShows(t.Context(), &inbound.ListShowsCommand{Sort: sort, Order: model.Descending, Limit: 2})
+
+			assert.Nil(t, err)
+			assert.Len(t, firstPage.Shows, 2)
+			assert.NotEmpty(t, firstPage.NextCursor)
+
+			_, err = listShowsService.ListShows(t.Context(), &inbound.ListShowsCommand{Sort: sort, Order: model.Descending, Limit: 2, Cursor: firstPage.NextCursor})
+
+			assert.Nil(t, err)
+			assert.Equal(t, expectedKey, mockListShowsAdapter.query.After)
+		})
+	}
+}
+
+func Test_should_reject_invalid_cursor_on_list_shows(t *testing.T) {
+	titleCursor := encodeCursor(model.ShowSortTitle, model.Ascending, someShows(1)[0])
+
+	tests := map[string]*inbound.ListShowsCommand{
+		"no base64":   {Cursor: "not a cursor!"},
+		"no json":     {Cursor: "bm8ganNvbg"},
+		"other sort":  {Cursor: titleCursor, Sort: model.ShowSortCreatedAt},
+		"other order": {Cursor: titleCursor, Order: model.Descending},
+		"without id":  {Cursor: "eyJzIjoidGl0bGUiLCJvIjoiYXNjIn0"},
+	}
+
+	for name, command := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer initAdapter()
+
+			result, err := listShowsService.ListShows(t.Context(), command)
+
+			assert.Nil(t, result)
+			assert.Equal(t, error2.NewInvalidCursorError(command.Cursor), err)
+			assert.Equal(t, 0, mockListShowsAdapter.called)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_list_shows(t *testing.T) {
+	defer initAdapter()
+	expectedError := errors.New("some error")
+	mockListShowsAdapter.withErrorOnListShow = expectedError
+
+	result, err := listShowsService.ListShows(t.Context(), &inbound.ListShowsCommand{})
+
+	assert.Nil(t, result)
+	assert.Equal(t, expectedError, err)
+}
@@ -1,14 +1,19 @@
 package show
 
 import (
+	"context"
+	"io"
 	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
+	"strings"
 )
 
 type saveAndGetShowTestAdapter struct {
 	calledSave                   int
 	onSave                       map[string]*model.Show
 	returnsOnExistsByTitleOrSlug map[string]bool
+	returnsOnExistsBySlug        map[string]bool
+	withErrorOnExists            error
 	withErrorOnSaveShow          error
 }
 
@@ -20,8 +25,14 @@ func newSaveAndGetShowTestAdapter() *saveAndGetShowTestAdapter {
 
 func newTestCreateShowCommand(title string) *inbound.CreateShowCommand {
 	show := &inbound.CreateShowCommand{
-		Title: title,
-		Slug:  title + "-Slug",
+		Title:    title,
+		Slug:     strings.ToLower(title) + "-slug",
+		Guid:     title + "-Guid",
+		Locked:   true,
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support " + title}},
+		Persons:  []model.Person{{Name: title + " Host", Role: "host"}},
+		Author:   title + " Author",
+		Explicit: true,
 	}
 	return show
 }
@@ -35,9 +46,10 @@ func (a *getShowTestAdapter) init() {
 	a.called = 0
 	a.returnsOnGetOrNilShow = make(map[string]*model.Show)
 	a.withErrorOnGetOrNilShow = nil
+	a.returnsOnGetBySlugOrNil = make(map[string]*model.Show)
 }
 
-func (adapter *saveAndGetShowTestAdapter) SaveShow(show *model.Show) error {
+func (adapter *saveAndGetShowTestAdapter) SaveShow(_ context.Context, show *model.Show) error {
 	adapter.calledSave++
 	adapter.onSave["show"] = show
 	return adapter.withErrorOnSaveShow
@@ -47,6 +59,8 @@ func (adapter *saveAndGetShowTestAdapter) init() {
 	adapter.calledSave = 0
 	adapter.onSave = make(map[string]*model.Show)
 	adapter.returnsOnExistsByTitleOrSlug = make(map[string]bool)
+	adapter.returnsOnExistsBySlug = make(map[string]bool)
+	adapter.withErrorOnExists = nil
 	adapter.withErrorOnSaveShow = nil
 }
 
@@ -54,27 +68,193 @@ func (adapter *saveAndGetShowTestAdapter) everyExistsByTitleOrSlugReturns(title
 	adapter.returnsOnExistsByTitleOrSlug[title+slug] = returnValue
 }
 
-func (adapter *saveAndGetShowTestAdapter) ExistsByTitleOrSlug(title string, slug string) bool {
-	return adapter.returnsOnExistsByTitleOrSlug[title+slug]
+func (adapter *saveAndGetShowTestAdapter) ExistsByTitleOrSlug(_ context.Context, title string, slug string) (bool, error) {
+	return adapter.returnsOnExistsByTitleOrSlug[title+slug], adapter.withErrorOnExists
+}
+
+func (adapter *saveAndGetShowTestAdapter) ExistsBySlug(_ context.Context, slug string) (bool, error) {
+	return adapter.returnsOnExistsBySlug[slug], adapter.withErrorOnExists
 }
 
 type getShowTestAdapter struct {
 	called                  int
 	returnsOnGetOrNilShow   map[string]*model.Show
 	withErrorOnGetOrNilShow error
+	returnsOnGetBySlugOrNil map[string]*model.Show
 }
 
-func (a *getShowTestAdapter) GetShowOrNil(id string) (*model.Show, error) {
+func (a *getShowTestAdapter) GetShowOrNil(_ context.Context, id string) (*model.Show, error) {
 	a.called++
 	show := a.returnsOnGetOrNilShow[id]
 	return show, a.withErrorOnGetOrNilShow
 }
 
+func (a *getShowTestAdapter) GetShowBySlugOrNil(_ context.Context, slug string) (*model.Show, error) {
+	a.called++
+	return a.returnsOnGetBySlugOrNil[slug], a.withErrorOnGetOrNilShow
+}
+
+type getShowEpisodesTestAdapter struct {
+	called                       int
+	returnsOnGetEpisodesOfShow   map[string][]*model.Episode
+	withErrorOnGetEpisodesOfShow error
+}
+
+func newGetShowEpisodesTestAdapter() *getShowEpisodesTestAdapter {
+	adapter := &getShowEpisodesTestAdapter{}
+	adapter.init()
+	return adapter
+}
+
+func (a *getShowEpisodesTestAdapter) init() {
+	a.called = 0
+	a.returnsOnGetEpisodesOfShow = make(map[string][]*model.Episode)
+	a.withErrorOnGetEpisodesOfShow = nil
+}
+
+func (a *getShowEpisodesTestAdapter) GetEpisodesOfShow(_ context.Context, showId string) ([]*model.Episode, error) {
+	a.called++
+	return a.returnsOnGetEpisodesOfShow[showId], a.withErrorOnGetEpisodesOfShow
+}
+
+type listShowsTestAdapter struct {
+	called              int
+	query               *model.ShowQuery
+	returnsOnListShows  []*model.Show
+	withErrorOnListShow error
+}
+
+func newListShowsTestAdapter() *listShowsTestAdapter {
+	adapter := &listShowsTestAdapter{}
+	adapter.init()
+	return adapter
+}
+
+func (a *listShowsTestAdapter) init() {
+	a.called = 0
+	a.query = nil
+	a.returnsOnListShows = nil
+	a.withErrorOnListShow = nil
+}
+
+func (a *listShowsTestAdapter) ListShows(_ context.Context, query *model.ShowQuery) ([]*model.Show, error) {
+	a.called++
+	a.query = query
+	return a.returnsOnListShows, a.withErrorOnListShow
+}
+
+type updateAndDeleteShowTestAdapter struct {
+	calledUpdate                      int
+	onUpdate                          *model.Show
+	returnsOnExistsOtherByTitleOrSlug bool
+	withErrorOnExistsOther            error
+	withErrorOnUpdate                 error
+	deleted                           []string
+	withErrorOnDelete                 error
+}
+
+func newUpdateAndDeleteShowTestAdapter() *updateAndDeleteShowTestAdapter {
+	adapter := &updateAndDeleteShowTestAdapter{}
+	adapter.init()
+	return adapter
+}
+
+func (a *updateAndDeleteShowTestAdapter) init() {
+	a.calledUpdate = 0
+	a.onUpdate = nil
+	a.returnsOnExistsOtherByTitleOrSlug = false
+	a.withErrorOnExistsOther = nil
+	a.withErrorOnUpdate = nil
+	a.deleted = nil
+	a.withErrorOnDelete = nil
+}
+
+func (a *updateAndDeleteShowTestAdapter) UpdateShow(_ context.Context, show *model.Show) error {
+	a.calledUpdate++
+	a.onUpdate = show
+	return a.withErrorOnUpdate
+}
+
+func (a *updateAndDeleteShowTestAdapter) ExistsOtherByTitleOrSlug(context.Context, string, string, string) (bool, error) {
+	return a.returnsOnExistsOtherByTitleOrSlug, a.withErrorOnExistsOther
+}
+
+func (a *updateAndDeleteShowTestAdapter) DeleteShow(_ context.Context, id string) error {
+	a.deleted = append(a.deleted, id)
+	return a.withErrorOnDelete
+}
+
+type backfillShowGuidsTestAdapter struct {
+	returnsOnGetShowIdsWithoutGuid []string
+	withErrorOnGet                 error
+	setGuids                       map[string]string
+	withErrorOnSet                 error
+}
+
+func newBackfillShowGuidsTestAdapter() *backfillShowGuidsTestAdapter {
+	adapter := &backfillShowGuidsTestAdapter{}
+	adapter.init()
+	return adapter
+}
+
+func (a *backfillShowGuidsTestAdapter) init() {
+	a.returnsOnGetShowIdsWithoutGuid = nil
+	a.withErrorOnGet = nil
+	a.setGuids = map[string]string{}
+	a.withErrorOnSet = nil
+}
+
+func (a *backfillShowGuidsTestAdapter) GetShowIdsWithoutGuid(context.Context) ([]string, error) {
+	return a.returnsOnGetShowIdsWithoutGuid, a.withErrorOnGet
+}
+
+func (a *backfillShowGuidsTestAdapter) SetShowGuidIfMissing(_ context.Context, id string, guid string) error {
+	a.setGuids[id] = guid
+	return a.withErrorOnSet
+}
+
+type mediaStorageTestAdapter struct {
+	deleted []string
+}
+
+func (a *mediaStorageTestAdapter) init() {
+	a.deleted = nil
+}
+
+func (a *mediaStorageTestAdapter) SaveMedia(context.Context, string, io.Reader, string) (int64, error) {
+	return 0, nil
+}
+
+func (a *mediaStorageTestAdapter) DeleteMedia(_ context.Context, key string) error {
+	a.deleted = append(a.deleted, key)
+	return nil
+}
+
 func initAdapter() {
 	mockGetShowAdapter.init()
 	mockSaveAndGetShowAdapter.init()
+	mockGetShowEpisodesAdapter.init()
+	mockListShowsAdapter.init()
+	mockUpdateAndDeleteShowAdapter.init()
+	mockMediaStorageAdapter.init()
+	mockBackfillShowGuidsAdapter.init()
 }
 
 var mockGetShowAdapter = newGetShowTestAdapter()
 
 var mockSaveAndGetShowAdapter = newSaveAndGetShowTestAdapter()
+
+var mockGetShowEpisodesAdapter = newGetShowEpisodesTestAdapter()
+
+var mockListShowsAdapter = newListShowsTestAdapter()
+
+var mockUpdateAndDeleteShowAdapter = newUpdateAndDeleteShowTestAdapter()
+
+var mockMediaStorageAdapter = new(mediaStorageTestAdapter)
+
+var mockBackfillShowGuidsAdapter = newBackfillShowGuidsTestAdapter()
+
+// podnewsFeedUrl is the feed url of the example of the podcast namespace, whose guid is known.
+func podnewsFeedUrl(string) string {
+	return "https://podnews.net/rss"
+}
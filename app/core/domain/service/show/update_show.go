@@ -0,0 +1,105 @@
+package show
+
+import (
+	"context"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/tracing"
+	"podGopher/core/port/inbound"
+	"podGopher/core/port/outbound"
+)
+
+type UpdateShowService struct {
+	getShowOutPort    outbound.GetShowPort
+	updateShowOutPort outbound.UpdateShowPort
+	feedUrlOf         func(showId string) string
+}
+
+func NewUpdateShowService(showRepository outbound.GetShowPort, updateShowRepository outbound.UpdateShowPort, feedUrlOf func(showId string) string) *UpdateShowService {
+	return &UpdateShowService{
+		getShowOutPort:    showRepository,
+		updateShowOutPort: updateShowRepository,
+		feedUrlOf:         feedUrlOf,
+	}
+}
+
+func (service *UpdateShowService) UpdateShow(ctx context.Context, command *inbound.UpdateShowCommand) (response *inbound.GetShowResponse, err error) {
+	ctx, span := tracing.StartSpan(ctx, "UpdateShow")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	show, err := service.getShowOutPort.GetShowOrNil(ctx, command.Id)
+	if err != nil {
+		return nil, err
+	}
+	if show == nil {
+		return nil, error2.NewShowNotFoundError(command.Id)
+	}
+
+	title, slug := show.Title, show.Slug
+	applyShowChanges(show, command)
+	assignPodcastGuid(show, service.feedUrlOf)
+	// slugs of shows created before validation stay valid until they change
+	if show.Slug != slug && !model.IsValidSlug(show.Slug) {
+		return nil, error2.NewInvalidSlugError(show.Slug)
+	}
+	if show.Title != title || show.Slug != slug {
+		// the same rules as on creation apply, the show itself does not count
+		exists, err := service.updateShowOutPort.ExistsOtherByTitleOrSlug(ctx, show.Id, show.Title, show.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, error2.NewShowAlreadyExistsError(show.Title)
+		}
+	}
+
+	if err = service.updateShowOutPort.UpdateShow(ctx, show); err != nil {
+		return nil, err
+	}
+	return showResponseOf(show), nil
+}
+
+func applyShowChanges(show *model.Show, command *inbound.UpdateShowCommand) {
+	if command.Title != nil {
+		show.Title = *command.Title
+	}
+	if command.Slug != nil {
+		show.Slug = *command.Slug
+	}
+	if command.Guid != nil {
+		show.Guid = *command.Guid
+	}
+	if command.Locked != nil {
+		show.Locked = *command.Locked
+	}
+	if command.AllowDuplicateEpisodeTitles != nil {
+		show.AllowDuplicateEpisodeTitles = *command.AllowDuplicateEpisodeTitles
+	}
+	if command.Funding != nil {
+		show.Funding = *command.Funding
+	}
+	if command.Persons != nil {
+		show.Persons = *command.Persons
+	}
+	if command.Description != nil {
+		show.Description = *command.Description
+	}
+	if command.Language != nil {
+		show.Language = *command.Language
+	}
+	if command.Author != nil {
+		show.Author = *command.Author
+	}
+	if command.ImageUrl != nil {
+		show.ImageUrl = *command.ImageUrl
+	}
+	if command.Category != nil {
+		show.Category = *command.Category
+	}
+	if command.Subcategory != nil {
+		show.Subcategory = *command.Subcategory
+	}
+	if command.Explicit != nil {
+		show.Explicit = *command.Explicit
+	}
+}
@@ -0,0 +1,168 @@
+package show
+
+import (
+	"errors"
+	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateShowService = NewUpdateShowService(mockGetShowAdapter, mockUpdateAndDeleteShowAdapter, podnewsFeedUrl)
+
+func givenExistingShow() *model.Show {
+	show := &model.Show{
+		Id:       "some-show-id",
+		Title:    "Some Title",
+		Slug:     "some-slug",
+		Guid:     "some-guid",
+		Funding:  []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Episodes: []string{"some-episode-id"},
+	}
+	mockGetShowAdapter.returnsOnGetOrNilShow[show.Id] = show
+	return show
+}
+
+func Test_should_implement_UpdateShowInPort(t *testing.T) {
+	assert.NotNil(t, updateShowService)
+	assert.Implements(t, (*inbound.UpdateShowPort)(nil), updateShowService)
+}
+
+func Test_should_throw_error_if_show_does_not_exist_on_update_show(t *testing.T) {
+	defer initAdapter()
+	title := "Other Title"
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Title: &title})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewShowNotFoundError("some-show-id"), err)
+	assert.Equal(t, 0, mockUpdateAndDeleteShowAdapter.calledUpdate)
+}
+
+func Test_should_update_only_given_fields_of_a_show(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	title := "Other Title"
+	locked, allowDuplicates, explicit := true, true, true
+	language := "en"
+	persons := []model.Person{{Name: "some host", Role: "host"}}
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{
+		Id: "some-show-id", Title: &title, Locked: &locked, AllowDuplicateEpisodeTitles: &allowDuplicates, Persons: &persons,
+		Language: &language, Explicit: &explicit,
+	})
+
+	expectedShow := &model.Show{
+		Id:                          "some-show-id",
+		Title:                       "Other Title",
+		Slug:                        "some-slug",
+		Guid:                        "some-guid",
+		Locked:                      true,
+		AllowDuplicateEpisodeTitles: true,
+		Funding:                     []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons:                     persons,
+		Language:                    "en",
+		Explicit:                    true,
+		Episodes:                    []string{"some-episode-id"},
+	}
+	assert.Nil(t, err)
+	assert.Equal(t, expectedShow, mockUpdateAndDeleteShowAdapter.onUpdate)
+	assert.Equal(t, showResponseOf(expectedShow), result)
+}
+
+func Test_should_derive_guid_from_feed_url_if_reset_on_update_show(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	guid := ""
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Guid: &guid})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "9b024349-ccf0-5f69-a609-6b82873eab3c", mockUpdateAndDeleteShowAdapter.onUpdate.Guid)
+	assert.Equal(t, "9b024349-ccf0-5f69-a609-6b82873eab3c", result.Guid)
+}
+
+func Test_should_not_update_show_to_title_or_slug_of_other_show(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	mockUpdateAndDeleteShowAdapter.returnsOnExistsOtherByTitleOrSlug = true
+	slug := "other-slug"
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Slug: &slug})
+
+	assert.Nil(t, result)
+	assert.Equal(t, error2.NewShowAlreadyExistsError("Some Title"), err)
+	assert.Equal(t, 0, mockUpdateAndDeleteShowAdapter.calledUpdate)
+}
+
+func Test_should_refuse_invalid_slug_on_update_show(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	slug := "Other Slug"
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Slug: &slug})
+
+	assert.Nil(t, result)
+	assert.Equal(t, &error2.InvalidSlugError{Slug: "Other Slug"}, err)
+	assert.Equal(t, 0, mockUpdateAndDeleteShowAdapter.calledUpdate)
+}
+
+func Test_should_keep_slug_which_does_not_match_current_rules_on_update_show(t *testing.T) {
+	defer initAdapter()
+	mockGetShowAdapter.returnsOnGetOrNilShow["legacy-show-id"] = &model.Show{Id: "legacy-show-id", Title: "Legacy", Slug: "Legacy Slug"}
+	title := "Other Title"
+
+	result, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "legacy-show-id", Title: &title})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Legacy Slug", result.Slug)
+}
+
+func Test_should_not_check_uniqueness_if_title_and_slug_are_kept(t *testing.T) {
+	defer initAdapter()
+	givenExistingShow()
+	mockUpdateAndDeleteShowAdapter.returnsOnExistsOtherByTitleOrSlug = true
+	title, guid := "Some Title", "other-guid"
+
+	_, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Title: &title, Guid: &guid})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, mockUpdateAndDeleteShowAdapter.calledUpdate)
+}
+
+func Test_should_propagate_errors_on_update_show(t *testing.T) {
+	expectedError := errors.New("some error")
+
+	t.Run("get", func(t *testing.T) {
+		defer initAdapter()
+		mockGetShowAdapter.withErrorOnGetOrNilShow = expectedError
+
+		_, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id"})
+
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("exists", func(t *testing.T) {
+		defer initAdapter()
+		givenExistingShow()
+		mockUpdateAndDeleteShowAdapter.withErrorOnExistsOther = expectedError
+		title := "some other title"
+
+		_, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id", Title: &title})
+
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, 0, mockUpdateAndDeleteShowAdapter.calledUpdate)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		defer initAdapter()
+		givenExistingShow()
+		mockUpdateAndDeleteShowAdapter.withErrorOnUpdate = expectedError
+
+		_, err := updateShowService.UpdateShow(t.Context(), &inbound.UpdateShowCommand{Id: "some-show-id"})
+
+		assert.Equal(t, expectedError, err)
+	})
+}
@@ -0,0 +1,28 @@
+// Package tracing spans the calls of inbound ports. The services depend on the OpenTelemetry API only, which
+// records nothing until the app installs a tracer provider.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("podGopher/core/domain/service")
+
+// StartSpan starts the span of a call of an inbound port, which EndSpan ends.
+func StartSpan(ctx context.Context, port string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, port, trace.WithAttributes(attribute.String("podgopher.port", port)))
+}
+
+// EndSpan marks the span as failed by err, unless err is nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var spans = tracetest.NewSpanRecorder()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+}
+
+func Test_should_span_call_of_port(t *testing.T) {
+	ctx, span := StartSpan(t.Context(), "CreateShow")
+	EndSpan(span, nil)
+
+	ended := spans.Ended()[len(spans.Ended())-1]
+	assert.Equal(t, span.SpanContext(), ended.SpanContext())
+	assert.NotEqual(t, t.Context(), ctx)
+	assert.Equal(t, "CreateShow", ended.Name())
+	assert.Contains(t, ended.Attributes(), attribute.String("podgopher.port", "CreateShow"))
+	assert.Equal(t, codes.Unset, ended.Status().Code)
+}
+
+func Test_should_mark_span_of_failed_call(t *testing.T) {
+	_, span := StartSpan(t.Context(), "CreateShow")
+	EndSpan(span, errors.New("some error"))
+
+	ended := spans.Ended()[len(spans.Ended())-1]
+	assert.Equal(t, codes.Error, ended.Status().Code)
+	assert.Equal(t, "some error", ended.Status().Description)
+	assert.Len(t, ended.Events(), 1)
+}
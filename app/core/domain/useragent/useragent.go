@@ -0,0 +1,88 @@
+// Package useragent attributes requests to podcast apps, device classes and platforms
+// by a bundled rule set in the style of the open podcast analytics user-agents database.
+package useragent
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+)
+
+// Unknown names app, device or platform of user agents no rule matches.
+const Unknown = "unknown"
+
+//go:embed rules.json
+var bundledRules []byte
+
+var rules = mustLoadRules(bundledRules)
+
+type Client struct {
+	App      string
+	Device   string
+	Platform string
+}
+
+type ruleSet struct {
+	Version   string `json:"version"`
+	Apps      []rule `json:"apps"`
+	Devices   []rule `json:"devices"`
+	Platforms []rule `json:"platforms"`
+}
+
+type rule struct {
+	Name       string   `json:"name"`
+	UserAgents []string `json:"userAgents"`
+	patterns   []*regexp.Regexp
+}
+
+func mustLoadRules(data []byte) *ruleSet {
+	loaded, err := loadRules(data)
+	if err != nil {
+		panic(err)
+	}
+	return loaded
+}
+
+func loadRules(data []byte) (*ruleSet, error) {
+	var loaded ruleSet
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return nil, err
+	}
+	for _, list := range [][]rule{loaded.Apps, loaded.Devices, loaded.Platforms} {
+		for i := range list {
+			for _, userAgent := range list[i].UserAgents {
+				pattern, err := regexp.Compile(userAgent)
+				if err != nil {
+					return nil, err
+				}
+				list[i].patterns = append(list[i].patterns, pattern)
+			}
+		}
+	}
+	return &loaded, nil
+}
+
+// Version returns the version of the bundled rule set, which changes whenever its rules do.
+func Version() string {
+	return rules.Version
+}
+
+// Detect matches the user agent against the rules in their order, so specific rules precede general ones.
+func Detect(userAgent string) Client {
+	return Client{
+		App:      firstMatch(rules.Apps, userAgent),
+		Device:   firstMatch(rules.Devices, userAgent),
+		Platform: firstMatch(rules.Platforms, userAgent),
+	}
+}
+
+func firstMatch(list []rule, userAgent string) string {
+	for _, candidate := range list {
+		for _, pattern := range candidate.patterns {
+			if pattern.MatchString(userAgent) {
+				return candidate.Name
+			}
+		}
+	}
+	return Unknown
+}
@@ -0,0 +1,74 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_detect_clients(t *testing.T) {
+	tests := map[string]Client{
+		"Podcasts/1555.2.1 CFNetwork/1404.0.5 Darwin/22.3.0":                          {"Apple Podcasts", "phone", "iOS"},
+		"AppleCoreMedia/1.0.0.20G75 (iPhone; U; CPU OS 16_6 like Mac OS X; de_de)":    {"Apple Podcasts", "phone", "iOS"},
+		"AppleCoreMedia/1.0.0.20E247 (iPad; U; CPU OS 16_4 like Mac OS X; en_us)":     {"Apple Podcasts", "tablet", "iOS"},
+		"Podcasts/1.1.0 (Macintosh; OS X 13.2; 22D49)":                                {"Apple Podcasts", "computer", "macOS"},
+		"atc/1.0 watchOS/9.4 model/Watch6,2 hwp/t8301 build/20T250 (6; dt:251) AMS/1": {"Apple Podcasts", "watch", "watchOS"},
+		"AppleCoreMedia/1.0.0.20L563 (HomePod; U; CPU OS 16_3 like Mac OS X; en_us)":  {"Apple Podcasts", "smart_speaker", "iOS"},
+		"Spotify/8.8.56 Android/33 (Pixel 7)":                                         {"Spotify", "phone", "Android"},
+		"Spotify/8.8.56 iOS/17.0 (iPhone15,2)":                                        {"Spotify", "phone", "iOS"},
+		"Overcast/3.0 (+http://overcast.fm/; iOS podcast app)":                        {"Overcast", "phone", "iOS"},
+		"Pocket Casts": {"Pocket Casts", Unknown, Unknown},
+		"PocketCasts/1.0 (Pocket Casts Feed Parser; +http://pocketcasts.com/)": {"Pocket Casts", Unknown, Unknown},
+		"AntennaPod/3.2.0":                {"AntennaPod", Unknown, Unknown},
+		"Castro 2022.11/1234 Like iTunes": {"Castro", Unknown, Unknown},
+		"PodcastAddict/v5 (+https://podcastaddict.com/; Android podcast app)":  {"Podcast Addict", "phone", "Android"},
+		"CastBox/9.7.1-220530101 (Linux;Android 12) ExoPlayerLib/2.10.4":       {"Castbox", "phone", "Android"},
+		"AlexaMediaPlayer/2.1.4676.0 (Linux;Android 5.1.1) ExoPlayerLib/1.5.9": {"Alexa", "smart_speaker", "Android"},
+		"Sonos/75.1-39020 (ZPS29)": {"Sonos", "smart_speaker", Unknown},
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36":                         {"Chrome", "computer", "Windows"},
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36 Edg/120.0":               {"Microsoft Edge", "computer", "Windows"},
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Safari/605.1.15":                   {"Safari", "computer", "macOS"},
+		"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0":                                                                  {"Firefox", "computer", "Linux"},
+		"Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36":                          {"Chrome", "tablet", "Android"},
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_1 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.1 Mobile/15E148 Safari/604.1": {"Safari", "phone", "iOS"},
+		"some unknown client": {Unknown, Unknown, Unknown},
+		"":                    {Unknown, Unknown, Unknown},
+	}
+
+	for userAgent, expected := range tests {
+		t.Run(userAgent, func(t *testing.T) {
+			assert.Equal(t, expected, Detect(userAgent))
+		})
+	}
+}
+
+func Test_should_version_bundled_rules(t *testing.T) {
+	assert.NotEmpty(t, Version())
+}
+
+func Test_should_compile_all_bundled_rules(t *testing.T) {
+	loaded, err := loadRules(bundledRules)
+
+	assert.Nil(t, err)
+	for _, list := range [][]rule{loaded.Apps, loaded.Devices, loaded.Platforms} {
+		for _, candidate := range list {
+			assert.NotEmpty(t, candidate.Name)
+			assert.Len(t, candidate.patterns, len(candidate.UserAgents), candidate.Name)
+		}
+	}
+}
+
+func Test_should_reject_invalid_rules(t *testing.T) {
+	tests := map[string]string{
+		"malformed json":  `{"apps": [`,
+		"invalid pattern": `{"apps": [{"name": "some app", "userAgents": ["(unclosed"]}]}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadRules([]byte(data))
+
+			assert.NotNil(t, err)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package inbound
+
+import (
+	"context"
+)
+
+// BackfillShowGuidsCommand assigns the shows stored without podcast:guid the guid derived from their feed url.
+type BackfillShowGuidsCommand struct{}
+
+type BackfillShowGuidsResponse struct {
+	ShowIds []string
+}
+
+type BackfillShowGuidsPort interface {
+	BackfillShowGuids(ctx context.Context, command *BackfillShowGuidsCommand) (backfilled *BackfillShowGuidsResponse, err error)
+}
@@ -0,0 +1,19 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type CheckReadinessCommand struct{}
+
+// CheckReadinessResponse is ready if all components are up. Components are in the order their checks were
+// registered.
+type CheckReadinessResponse struct {
+	Ready      bool
+	Components []model.ComponentHealth
+}
+
+type CheckReadinessPort interface {
+	CheckReadiness(ctx context.Context, command *CheckReadinessCommand) (readiness *CheckReadinessResponse, err error)
+}
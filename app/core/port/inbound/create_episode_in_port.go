@@ -1,16 +1,37 @@
 package inbound
 
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// CreateEpisodeCommand creates a draft, which stays out of the feed until it is published or its scheduled date comes.
 type CreateEpisodeCommand struct {
-	ShowId string
-	Title  string
+	ShowId        string
+	Title         string
+	Description   string
+	Season        int
+	EpisodeNumber int
+	Transcripts   []model.Transcript
+	Chapters      *model.Chapters
+	Persons       []model.Person
 }
 
 type CreateEpisodeResponse struct {
-	Id     string
-	ShowId string
-	Title  string
+	Id            string
+	ShowId        string
+	Title         string
+	Description   string
+	Season        int
+	EpisodeNumber int
+	Status        model.EpisodeStatus
+	PublishedAt   time.Time
+	Transcripts   []model.Transcript
+	Chapters      *model.Chapters
+	Persons       []model.Person
 }
 
 type CreateEpisodePort interface {
-	CreateEpisode(command *CreateEpisodeCommand) (episode *CreateEpisodeResponse, err error)
+	CreateEpisode(ctx context.Context, command *CreateEpisodeCommand) (episode *CreateEpisodeResponse, err error)
 }
@@ -1,16 +1,47 @@
 package inbound
 
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// CreateShowCommand creates a show. Without slug, the show gets one derived from its title. Without guid, it gets
+// the podcast:guid derived from its canonical feed url.
 type CreateShowCommand struct {
-	Title string
-	Slug  string
+	Title                       string
+	Slug                        string
+	Guid                        string
+	Locked                      bool
+	AllowDuplicateEpisodeTitles bool
+	Funding                     []model.Funding
+	Persons                     []model.Person
+	Description                 string
+	Language                    string
+	Author                      string
+	ImageUrl                    string
+	Category                    string
+	Subcategory                 string
+	Explicit                    bool
 }
 
 type CreateShowResponse struct {
-	Id    string
-	Title string
-	Slug  string
+	Id                          string
+	Title                       string
+	Slug                        string
+	Guid                        string
+	Locked                      bool
+	AllowDuplicateEpisodeTitles bool
+	Funding                     []model.Funding
+	Persons                     []model.Person
+	Description                 string
+	Language                    string
+	Author                      string
+	ImageUrl                    string
+	Category                    string
+	Subcategory                 string
+	Explicit                    bool
 }
 
 type CreateShowPort interface {
-	CreateShow(command *CreateShowCommand) (show *CreateShowResponse, err error)
+	CreateShow(ctx context.Context, command *CreateShowCommand) (show *CreateShowResponse, err error)
 }
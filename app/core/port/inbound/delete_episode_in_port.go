@@ -0,0 +1,12 @@
+package inbound
+
+import "context"
+
+type DeleteEpisodeCommand struct {
+	ShowId    string
+	EpisodeId string
+}
+
+type DeleteEpisodePort interface {
+	DeleteEpisode(ctx context.Context, command *DeleteEpisodeCommand) (err error)
+}
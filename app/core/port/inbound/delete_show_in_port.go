@@ -0,0 +1,11 @@
+package inbound
+
+import "context"
+
+type DeleteShowCommand struct {
+	Id string
+}
+
+type DeleteShowPort interface {
+	DeleteShow(ctx context.Context, command *DeleteShowCommand) (err error)
+}
@@ -0,0 +1,26 @@
+package inbound
+
+import (
+	"context"
+	"time"
+)
+
+// EnqueueJobCommand queues a job of a type with a payload, which is encoded as JSON. The job runs at RunAt, or
+// at once without, and is attempted MaxAttempts times, or a default number of times without. A job with a
+// UniqueKey is left out while another job with the key waits or runs.
+type EnqueueJobCommand struct {
+	Type        string
+	UniqueKey   string
+	Payload     any
+	RunAt       time.Time
+	MaxAttempts int
+}
+
+// EnqueueJobResponse has no Id if the job was left out for its unique key.
+type EnqueueJobResponse struct {
+	Id string
+}
+
+type EnqueueJobPort interface {
+	EnqueueJob(ctx context.Context, command *EnqueueJobCommand) (job *EnqueueJobResponse, err error)
+}
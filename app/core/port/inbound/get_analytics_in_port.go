@@ -0,0 +1,54 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// AnalyticsGroup names the client attribute downloads are grouped by.
+type AnalyticsGroup string
+
+const (
+	GroupByNone   AnalyticsGroup = ""
+	GroupByApp    AnalyticsGroup = "app"
+	GroupByDevice AnalyticsGroup = "device"
+)
+
+type GetAnalyticsCommand struct {
+	ShowId  string
+	From    time.Time
+	To      time.Time
+	GroupBy AnalyticsGroup
+}
+
+type GetAnalyticsResponse struct {
+	ShowId   string
+	From     time.Time
+	To       time.Time
+	Total    int
+	Episodes []EpisodeDownloads
+	Days     []DayDownloads
+	Groups   []GroupDownloads
+	Counts   []model.DownloadCount
+}
+
+type EpisodeDownloads struct {
+	EpisodeId string
+	Title     string
+	Downloads int
+}
+
+type DayDownloads struct {
+	Day       time.Time
+	Downloads int
+}
+
+type GroupDownloads struct {
+	Name      string
+	Downloads int
+}
+
+type GetAnalyticsPort interface {
+	GetAnalytics(ctx context.Context, command *GetAnalyticsCommand) (analytics *GetAnalyticsResponse, err error)
+}
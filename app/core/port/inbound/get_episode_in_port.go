@@ -1,16 +1,31 @@
 package inbound
 
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
 type GetEpisodeCommand struct {
 	EpisodeId string
 	ShowId    string
 }
 
 type GetEpisodeResponse struct {
-	Id     string
-	ShowId string
-	Title  string
+	Id            string
+	ShowId        string
+	Title         string
+	Description   string
+	Season        int
+	EpisodeNumber int
+	Status        model.EpisodeStatus
+	PublishedAt   time.Time
+	Transcripts   []model.Transcript
+	Chapters      *model.Chapters
+	Persons       []model.Person
+	Media         *model.Media
 }
 
 type GetEpisodePort interface {
-	GetEpisode(command *GetEpisodeCommand) (episode *GetEpisodeResponse, err error)
+	GetEpisode(ctx context.Context, command *GetEpisodeCommand) (episode *GetEpisodeResponse, err error)
 }
@@ -0,0 +1,25 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type GetEpisodeMediaCommand struct {
+	EpisodeId     string
+	FileName      string
+	Method        string
+	RemoteAddress string
+	UserAgent     string
+	Referrer      string
+	Range         string
+}
+
+type GetEpisodeMediaResponse struct {
+	FileName string
+	Media    *model.MediaContent
+}
+
+type GetEpisodeMediaPort interface {
+	GetEpisodeMedia(ctx context.Context, command *GetEpisodeMediaCommand) (media *GetEpisodeMediaResponse, err error)
+}
@@ -0,0 +1,14 @@
+package inbound
+
+import (
+	"context"
+)
+
+// GetShowBySlugCommand finds a show by its slug or a former one. The slug of the response tells which.
+type GetShowBySlugCommand struct {
+	Slug string
+}
+
+type GetShowBySlugPort interface {
+	GetShowBySlug(ctx context.Context, command *GetShowBySlugCommand) (show *GetShowResponse, err error)
+}
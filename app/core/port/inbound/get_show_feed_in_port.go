@@ -0,0 +1,48 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// GetShowFeedCommand finds the show by id, or by slug if the id is empty.
+type GetShowFeedCommand struct {
+	ShowId string
+	Slug   string
+}
+
+type GetShowFeedResponse struct {
+	Id          string
+	Title       string
+	Slug        string
+	Guid        string
+	Locked      bool
+	Funding     []model.Funding
+	Persons     []model.Person
+	Description string
+	Language    string
+	Author      string
+	ImageUrl    string
+	Category    string
+	Subcategory string
+	Explicit    bool
+	Episodes    []*FeedEpisode
+}
+
+type FeedEpisode struct {
+	Id            string
+	Title         string
+	Description   string
+	PublishedAt   time.Time
+	Season        int
+	EpisodeNumber int
+	Transcripts   []model.Transcript
+	Chapters      *model.Chapters
+	Persons       []model.Person
+	Media         *model.Media
+}
+
+type GetShowFeedPort interface {
+	GetShowFeed(ctx context.Context, command *GetShowFeedCommand) (feed *GetShowFeedResponse, err error)
+}
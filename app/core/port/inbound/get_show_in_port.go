@@ -1,16 +1,33 @@
 package inbound
 
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
 type GetShowCommand struct {
 	Id string
 }
 
 type GetShowResponse struct {
-	Id       string
-	Title    string
-	Slug     string
-	Episodes []string
+	Id                          string
+	Title                       string
+	Slug                        string
+	Guid                        string
+	Locked                      bool
+	AllowDuplicateEpisodeTitles bool
+	Funding                     []model.Funding
+	Persons                     []model.Person
+	Description                 string
+	Language                    string
+	Author                      string
+	ImageUrl                    string
+	Category                    string
+	Subcategory                 string
+	Explicit                    bool
+	Episodes                    []string
 }
 
 type GetShowPort interface {
-	GetShow(command *GetShowCommand) (show *GetShowResponse, err error)
+	GetShow(ctx context.Context, command *GetShowCommand) (show *GetShowResponse, err error)
 }
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// ListEpisodesCommand lists the published episodes of a show, as the listing is public.
+type ListEpisodesCommand struct {
+	ShowId string
+	Sort   model.EpisodeSort
+	Order  model.SortOrder
+	Cursor string
+	Limit  int
+}
+
+type ListEpisodesResponse struct {
+	Episodes   []*GetEpisodeResponse
+	NextCursor string
+}
+
+type ListEpisodesPort interface {
+	ListEpisodes(ctx context.Context, command *ListEpisodesCommand) (episodes *ListEpisodesResponse, err error)
+}
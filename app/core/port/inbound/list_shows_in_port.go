@@ -0,0 +1,31 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type ListShowsCommand struct {
+	Query  string
+	Sort   model.ShowSort
+	Order  model.SortOrder
+	Cursor string
+	Limit  int
+}
+
+type ListShowsResponse struct {
+	Shows      []ShowSummary
+	NextCursor string
+}
+
+type ShowSummary struct {
+	Id        string
+	Title     string
+	Slug      string
+	CreatedAt time.Time
+}
+
+type ListShowsPort interface {
+	ListShows(ctx context.Context, command *ListShowsCommand) (shows *ListShowsResponse, err error)
+}
@@ -10,4 +10,23 @@ const (
 	GetShow
 	CreateEpisode
 	GetEpisode
+	GetShowFeed
+	UploadEpisodeMedia
+	GetEpisodeMedia
+	GetAnalytics
+	ListShows
+	ListEpisodes
+	UpdateShow
+	DeleteShow
+	UpdateEpisode
+	DeleteEpisode
+	GetShowBySlug
+	PublishEpisode
+	ScheduleEpisode
+	UnpublishEpisode
+	PublishDueEpisodes
+	EnqueueJob
+	ProcessJob
+	CheckReadiness
+	BackfillShowGuids
 )
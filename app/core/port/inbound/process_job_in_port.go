@@ -0,0 +1,33 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// JobHandler processes the jobs of a type. A failed job is retried, so a handler has to tolerate running twice.
+type JobHandler interface {
+	HandleJob(ctx context.Context, job *model.Job) error
+}
+
+// ProcessJobCommand processes the next job which is due at Now.
+type ProcessJobCommand struct {
+	Now time.Time
+}
+
+// ProcessJobResponse names the processed job, or none if no job was due. Failure holds the error of a failed
+// job, which is retried later unless it is Dead.
+type ProcessJobResponse struct {
+	JobId   string
+	Type    string
+	Failure string
+	Dead    bool
+}
+
+type ProcessJobPort interface {
+	// RegisterJobHandler has to be called for every job type before jobs are processed. Jobs of types without
+	// handler stay in the queue.
+	RegisterJobHandler(jobType string, handler JobHandler)
+	ProcessNextJob(ctx context.Context, command *ProcessJobCommand) (processed *ProcessJobResponse, err error)
+}
@@ -0,0 +1,19 @@
+package inbound
+
+import (
+	"context"
+	"time"
+)
+
+// PublishDueEpisodesCommand publishes all scheduled episodes whose publish date is not after Now.
+type PublishDueEpisodesCommand struct {
+	Now time.Time
+}
+
+type PublishDueEpisodesResponse struct {
+	EpisodeIds []string
+}
+
+type PublishDueEpisodesPort interface {
+	PublishDueEpisodes(ctx context.Context, command *PublishDueEpisodesCommand) (published *PublishDueEpisodesResponse, err error)
+}
@@ -0,0 +1,13 @@
+package inbound
+
+import "context"
+
+// PublishEpisodeCommand publishes a draft, scheduled or unpublished episode right away.
+type PublishEpisodeCommand struct {
+	ShowId    string
+	EpisodeId string
+}
+
+type PublishEpisodePort interface {
+	PublishEpisode(ctx context.Context, command *PublishEpisodeCommand) (episode *GetEpisodeResponse, err error)
+}
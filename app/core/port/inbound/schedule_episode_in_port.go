@@ -0,0 +1,17 @@
+package inbound
+
+import (
+	"context"
+	"time"
+)
+
+// ScheduleEpisodeCommand plans to publish an episode at a future date. A scheduled episode may be scheduled again.
+type ScheduleEpisodeCommand struct {
+	ShowId    string
+	EpisodeId string
+	PublishAt time.Time
+}
+
+type ScheduleEpisodePort interface {
+	ScheduleEpisode(ctx context.Context, command *ScheduleEpisodeCommand) (episode *GetEpisodeResponse, err error)
+}
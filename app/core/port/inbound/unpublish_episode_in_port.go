@@ -0,0 +1,13 @@
+package inbound
+
+import "context"
+
+// UnpublishEpisodeCommand removes a published episode from the feed, it keeps its publish date for a later return.
+type UnpublishEpisodeCommand struct {
+	ShowId    string
+	EpisodeId string
+}
+
+type UnpublishEpisodePort interface {
+	UnpublishEpisode(ctx context.Context, command *UnpublishEpisodeCommand) (episode *GetEpisodeResponse, err error)
+}
@@ -0,0 +1,24 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// UpdateEpisodeCommand changes the fields of an episode which are not nil. Empty chapters remove the
+// chapters of the episode.
+type UpdateEpisodeCommand struct {
+	ShowId        string
+	EpisodeId     string
+	Title         *string
+	Description   *string
+	Season        *int
+	EpisodeNumber *int
+	Transcripts   *[]model.Transcript
+	Chapters      *model.Chapters
+	Persons       *[]model.Person
+}
+
+type UpdateEpisodePort interface {
+	UpdateEpisode(ctx context.Context, command *UpdateEpisodeCommand) (episode *GetEpisodeResponse, err error)
+}
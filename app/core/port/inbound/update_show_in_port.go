@@ -0,0 +1,30 @@
+package inbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// UpdateShowCommand changes the fields of a show which are not nil. An empty guid is replaced by the podcast:guid
+// derived from the canonical feed url of the show.
+type UpdateShowCommand struct {
+	Id                          string
+	Title                       *string
+	Slug                        *string
+	Guid                        *string
+	Locked                      *bool
+	AllowDuplicateEpisodeTitles *bool
+	Funding                     *[]model.Funding
+	Persons                     *[]model.Person
+	Description                 *string
+	Language                    *string
+	Author                      *string
+	ImageUrl                    *string
+	Category                    *string
+	Subcategory                 *string
+	Explicit                    *bool
+}
+
+type UpdateShowPort interface {
+	UpdateShow(ctx context.Context, command *UpdateShowCommand) (show *GetShowResponse, err error)
+}
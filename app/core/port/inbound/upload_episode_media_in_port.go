@@ -0,0 +1,25 @@
+package inbound
+
+import (
+	"context"
+	"io"
+	"podGopher/core/domain/model"
+)
+
+type UploadEpisodeMediaCommand struct {
+	ShowId    string
+	EpisodeId string
+	FileName  string
+	MimeType  string
+	Content   io.ReadSeeker
+}
+
+type UploadEpisodeMediaResponse struct {
+	EpisodeId string
+	ShowId    string
+	Media     *model.Media
+}
+
+type UploadEpisodeMediaPort interface {
+	UploadEpisodeMedia(ctx context.Context, command *UploadEpisodeMediaCommand) (media *UploadEpisodeMediaResponse, err error)
+}
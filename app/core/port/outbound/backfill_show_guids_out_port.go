@@ -0,0 +1,12 @@
+package outbound
+
+import (
+	"context"
+)
+
+// BackfillShowGuidsPort finds the shows stored without podcast:guid and assigns them one. SetShowGuidIfMissing
+// keeps a guid which was set in the meantime.
+type BackfillShowGuidsPort interface {
+	GetShowIdsWithoutGuid(ctx context.Context) (showIds []string, err error)
+	SetShowGuidIfMissing(ctx context.Context, id string, guid string) (err error)
+}
@@ -0,0 +1,17 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// ChangeEpisodeStatusPort moves stored episodes through their lifecycle. Changes apply only to episodes which
+// still have the expected status, so a change decided on a stale episode does not undo a concurrent one.
+type ChangeEpisodeStatusPort interface {
+	// ChangeEpisodeStatus stores status and publish date of the episode if its stored status is still from.
+	// Otherwise it fails with a serialization failure, or with not found if the episode is gone.
+	ChangeEpisodeStatus(ctx context.Context, episode *model.Episode, from model.EpisodeStatus) (err error)
+	// PublishDueEpisodes publishes the scheduled episodes whose publish date is not after now and returns their ids.
+	PublishDueEpisodes(ctx context.Context, now time.Time) (episodeIds []string, err error)
+}
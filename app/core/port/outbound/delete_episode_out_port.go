@@ -0,0 +1,7 @@
+package outbound
+
+import "context"
+
+type DeleteEpisodePort interface {
+	DeleteEpisode(ctx context.Context, id string) (err error)
+}
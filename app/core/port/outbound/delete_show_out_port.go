@@ -0,0 +1,8 @@
+package outbound
+
+import "context"
+
+type DeleteShowPort interface {
+	// DeleteShow deletes the show together with all of its episodes.
+	DeleteShow(ctx context.Context, id string) (err error)
+}
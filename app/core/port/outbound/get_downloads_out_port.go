@@ -0,0 +1,11 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+type GetDownloadsPort interface {
+	GetDownloadsOfShow(ctx context.Context, showId string, from time.Time, to time.Time) (downloads []*model.DownloadEvent, err error)
+}
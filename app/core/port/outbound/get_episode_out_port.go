@@ -1,7 +1,10 @@
 package outbound
 
-import "podGopher/core/domain/model"
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
 
 type GetEpisodePort interface {
-	GetEpisodeOrNil(id string) (*model.Episode, error)
+	GetEpisodeOrNil(ctx context.Context, id string) (*model.Episode, error)
 }
@@ -0,0 +1,11 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// GetShowBySlugPort finds shows by their slug, or by a former slug the show kept as alias.
+type GetShowBySlugPort interface {
+	GetShowBySlugOrNil(ctx context.Context, slug string) (*model.Show, error)
+}
@@ -0,0 +1,11 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type GetShowEpisodesPort interface {
+	// GetEpisodesOfShow gets all episodes of a show, the latest published first and episodes of one date by id.
+	GetEpisodesOfShow(ctx context.Context, showId string) ([]*model.Episode, error)
+}
@@ -1,7 +1,10 @@
 package outbound
 
-import "podGopher/core/domain/model"
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
 
 type GetShowPort interface {
-	GetShowOrNil(id string) (*model.Show, error)
+	GetShowOrNil(ctx context.Context, id string) (*model.Show, error)
 }
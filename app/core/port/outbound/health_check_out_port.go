@@ -0,0 +1,11 @@
+package outbound
+
+import "context"
+
+// HealthCheckPort is implemented by the adapters of components which have to work for the app to be ready.
+type HealthCheckPort interface {
+	// ComponentName names the checked component in reports, like 'database'.
+	ComponentName() string
+	// CheckHealth fails if the component can not serve the app. Details describe its state, also if it fails.
+	CheckHealth(ctx context.Context) (details map[string]any, err error)
+}
@@ -0,0 +1,35 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+	"time"
+)
+
+// EnqueueJobPort queues jobs. EnqueueJob leaves a job with a unique key out without failing, if another job with
+// the key waits or runs, even if it was queued concurrently, and then reports the job as not queued.
+type EnqueueJobPort interface {
+	EnqueueJob(ctx context.Context, job *model.Job) (queued bool, err error)
+}
+
+type GetJobPort interface {
+	GetJobOrNil(ctx context.Context, id string) (*model.Job, error)
+}
+
+// ClaimJobPort hands every due job to a single worker, even if several workers claim at the same time.
+type ClaimJobPort interface {
+	// ClaimJob marks the job of the given types which is due longest as running until now plus lease and counts
+	// the attempt. Running jobs whose lease ended are due again. It returns nil if no job is due.
+	ClaimJob(ctx context.Context, types []string, now time.Time, lease time.Duration) (*model.Job, error)
+}
+
+// FinishJobPort ends an attempt of a claimed job. It fails with a serialization failure if the job is no longer
+// running with the attempts of the caller, since another worker took it over once the lease ended.
+type FinishJobPort interface {
+	// CompleteJob removes a job which was processed.
+	CompleteJob(ctx context.Context, job *model.Job) error
+	// RetryJob returns a failed job to the queue, so it runs again at its RunAt.
+	RetryJob(ctx context.Context, job *model.Job) error
+	// BuryJob keeps a job which failed for good as dead letter.
+	BuryJob(ctx context.Context, job *model.Job) error
+}
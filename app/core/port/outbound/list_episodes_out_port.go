@@ -0,0 +1,10 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type ListEpisodesPort interface {
+	ListEpisodes(ctx context.Context, query *model.EpisodeQuery) ([]*model.Episode, error)
+}
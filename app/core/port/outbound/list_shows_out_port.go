@@ -0,0 +1,10 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type ListShowsPort interface {
+	ListShows(ctx context.Context, query *model.ShowQuery) ([]*model.Show, error)
+}
@@ -0,0 +1,11 @@
+package outbound
+
+import (
+	"context"
+	"io"
+)
+
+type MediaStoragePort interface {
+	SaveMedia(ctx context.Context, key string, content io.Reader, mimeType string) (size int64, err error)
+	DeleteMedia(ctx context.Context, key string) (err error)
+}
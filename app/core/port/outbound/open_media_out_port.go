@@ -0,0 +1,10 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type OpenMediaPort interface {
+	OpenMediaOrNil(ctx context.Context, key string) (media *model.MediaContent, err error)
+}
@@ -0,0 +1,10 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type RecordDownloadPort interface {
+	RecordDownload(ctx context.Context, event *model.DownloadEvent) (err error)
+}
@@ -0,0 +1,10 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+type SaveEpisodeMediaPort interface {
+	SaveEpisodeMedia(ctx context.Context, episodeId string, media *model.Media) (err error)
+}
@@ -1,8 +1,13 @@
 package outbound
 
-import "podGopher/core/domain/model"
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
 
+// SaveEpisodePort stores new episodes. SaveEpisode fails with an EpisodeAlreadyExistsError if another episode of
+// the show has the title, even if it was saved concurrently, unless the show allows duplicate titles.
 type SaveEpisodePort interface {
-	SaveEpisode(episode *model.Episode) (err error)
-	ExistsByTitle(title string) (exist bool)
+	SaveEpisode(ctx context.Context, episode *model.Episode) (err error)
+	ExistsByTitle(ctx context.Context, showId string, title string) (exists bool, err error)
 }
@@ -1,8 +1,15 @@
 package outbound
 
-import "podGopher/core/domain/model"
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
 
+// SaveShowPort stores new shows. SaveShow fails with a ShowAlreadyExistsError if another show has or had the title
+// or slug, even if it was saved or renamed concurrently. The exists queries count former slugs of shows as taken, so their
+// redirects keep working.
 type SaveShowPort interface {
-	SaveShow(show *model.Show) (err error)
-	ExistsByTitleOrSlug(title string, slug string) bool
+	SaveShow(ctx context.Context, show *model.Show) (err error)
+	ExistsByTitleOrSlug(ctx context.Context, title string, slug string) (exists bool, err error)
+	ExistsBySlug(ctx context.Context, slug string) (exists bool, err error)
 }
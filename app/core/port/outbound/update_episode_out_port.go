@@ -0,0 +1,13 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// UpdateEpisodePort changes stored episodes. UpdateEpisode fails with an EpisodeAlreadyExistsError if another
+// episode of the show has the title, unless the show allows duplicate titles.
+type UpdateEpisodePort interface {
+	UpdateEpisode(ctx context.Context, episode *model.Episode) (err error)
+	ExistsOtherByTitle(ctx context.Context, showId string, id string, title string) (exists bool, err error)
+}
@@ -0,0 +1,14 @@
+package outbound
+
+import (
+	"context"
+	"podGopher/core/domain/model"
+)
+
+// UpdateShowPort changes stored shows. UpdateShow keeps a changed slug as alias of the show, so
+// GetShowBySlugPort still finds the show by it. It refuses a slug another show had before with a
+// ShowAlreadyExistsError. ExistsOtherByTitleOrSlug counts aliases of other shows.
+type UpdateShowPort interface {
+	UpdateShow(ctx context.Context, show *model.Show) (err error)
+	ExistsOtherByTitleOrSlug(ctx context.Context, id string, title string, slug string) (exists bool, err error)
+}
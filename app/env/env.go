@@ -14,10 +14,25 @@ func Load(filename string) error {
 }
 
 const (
-	DBName       Name = "DBName"
-	DBUser       Name = "DBUser"
-	DBPassword   Name = "DBPassword"
-	DBHost       Name = "DBHost"
-	DBPort       Name = "DBPort"
-	MigrationDir Name = "MigrationDir"
+	DBName            Name = "DBName"
+	DBUser            Name = "DBUser"
+	DBPassword        Name = "DBPassword"
+	DBHost            Name = "DBHost"
+	DBPort            Name = "DBPort"
+	MigrationDir      Name = "MigrationDir"
+	MediaDir          Name = "MediaDir"
+	AnalyticsSecret   Name = "AnalyticsSecret"
+	BaseUrl           Name = "BaseUrl"
+	Repository        Name = "Repository"
+	SqlitePath        Name = "SqlitePath"
+	SchedulerInterval Name = "SchedulerInterval"
+	ListenAddress     Name = "ListenAddress"
+	ReadHeaderTimeout Name = "ReadHeaderTimeout"
+	ReadTimeout       Name = "ReadTimeout"
+	WriteTimeout      Name = "WriteTimeout"
+	IdleTimeout       Name = "IdleTimeout"
+	ShutdownTimeout   Name = "ShutdownTimeout"
+	TraceExporter     Name = "TraceExporter"
+	LogLevel          Name = "LogLevel"
+	LogFormat         Name = "LogFormat"
 )
@@ -0,0 +1,76 @@
+// Package logging creates the structured logger of the app. Records logged with a context carry the ids of its
+// request and trace, so the log lines of a request can be found by either.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	JsonFormat = "json"
+	TextFormat = "text"
+)
+
+type requestIdKey struct{}
+
+// WithRequestId returns a context whose log records carry the id of its request.
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey{}, requestId)
+}
+
+// RequestId is the id of the request of ctx, or empty outside of requests.
+func RequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey{}).(string)
+	return requestId
+}
+
+// NewLogger logs records from level on, like 'debug' or 'warn', as JSON or as text of key=value pairs. Without
+// level or format it logs from info on as JSON.
+func NewLogger(out io.Writer, level string, format string) (*slog.Logger, error) {
+	var minimum slog.Level
+	if level != "" {
+		if err := minimum.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("log level '%s' is unknown, use 'debug', 'info', 'warn' or 'error'", level)
+		}
+	}
+
+	options := &slog.HandlerOptions{Level: minimum}
+	var handler slog.Handler
+	switch format {
+	case JsonFormat, "":
+		handler = slog.NewJSONHandler(out, options)
+	case TextFormat:
+		handler = slog.NewTextHandler(out, options)
+	default:
+		return nil, fmt.Errorf("log format '%s' is unknown, use '%s' or '%s'", format, JsonFormat, TextFormat)
+	}
+	return slog.New(&contextHandler{handler}), nil
+}
+
+// contextHandler adds the ids of the request and trace of the context of a record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestId := RequestId(ctx); requestId != "" {
+		record.AddAttrs(slog.String("requestId", requestId))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(slog.String("traceId", spanContext.TraceID().String()), slog.String("spanId", spanContext.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{h.Handler.WithGroup(name)}
+}
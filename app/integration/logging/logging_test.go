@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Test_should_log_ids_of_request_and_trace(t *testing.T) {
+	var out bytes.Buffer
+	logger, err := NewLogger(&out, "", "")
+	assert.Nil(t, err)
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceId, SpanID: spanId}))
+	ctx = WithRequestId(ctx, "some-request-id")
+
+	logger.With("component", "test").InfoContext(ctx, "some message", "key", "value")
+
+	var record map[string]any
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, "INFO", record["level"])
+	assert.Equal(t, "some message", record["msg"])
+	assert.Equal(t, "test", record["component"])
+	assert.Equal(t, "value", record["key"])
+	assert.Equal(t, "some-request-id", record["requestId"])
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", record["traceId"])
+	assert.Equal(t, "00f067aa0ba902b7", record["spanId"])
+}
+
+func Test_should_log_without_ids_outside_of_requests(t *testing.T) {
+	var out bytes.Buffer
+	logger, _ := NewLogger(&out, "", TextFormat)
+
+	logger.InfoContext(context.Background(), "some message")
+
+	assert.Contains(t, out.String(), `level=INFO msg="some message"`)
+	assert.NotContains(t, out.String(), "requestId")
+	assert.NotContains(t, out.String(), "traceId")
+}
+
+func Test_should_log_from_configured_level_on(t *testing.T) {
+	var out bytes.Buffer
+	logger, _ := NewLogger(&out, "warn", TextFormat)
+
+	logger.Info("some info")
+	logger.Warn("some warning")
+
+	assert.NotContains(t, out.String(), "some info")
+	assert.Contains(t, out.String(), "some warning")
+}
+
+func Test_should_fail_on_unknown_configuration(t *testing.T) {
+	_, err := NewLogger(&bytes.Buffer{}, "verbose", "")
+	assert.EqualError(t, err, "log level 'verbose' is unknown, use 'debug', 'info', 'warn' or 'error'")
+
+	_, err = NewLogger(&bytes.Buffer{}, "", "xml")
+	assert.EqualError(t, err, "log format 'xml' is unknown, use 'json' or 'text'")
+}
@@ -0,0 +1,81 @@
+// Package scheduler publishes scheduled episodes once their date comes, independent of any request.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"time"
+)
+
+// PublishDueEpisodesJob is the job type which publishes the scheduled episodes which are due.
+const PublishDueEpisodesJob = "publish-due-episodes"
+
+// publishAttempts covers an outage of the database of a few minutes, a later tick queues the next job anyway.
+const publishAttempts = 4
+
+// Scheduler only queues the publishing on every tick, so the job workers retry it with backoff if it fails. The
+// publishing is queued with the job type as unique key, so the ticks of all replicas keep one job at most waiting.
+type Scheduler struct {
+	port     inbound.EnqueueJobPort
+	interval time.Duration
+}
+
+func NewScheduler(portMap inbound.PortMap, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		port:     portMap[inbound.EnqueueJob].(inbound.EnqueueJobPort),
+		interval: interval,
+	}
+}
+
+// Run queues the publishing on every tick until the context is done. A failed tick is only logged, since
+// the next one queues the publishing again.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.enqueuePublishing(ctx, now)
+		}
+	}
+}
+
+func (s *Scheduler) enqueuePublishing(ctx context.Context, now time.Time) {
+	_, err := s.port.EnqueueJob(ctx, &inbound.EnqueueJobCommand{
+		Type:        PublishDueEpisodesJob,
+		UniqueKey:   PublishDueEpisodesJob,
+		RunAt:       now.UTC(),
+		MaxAttempts: publishAttempts,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "scheduling the publishing of episodes failed", "error", err)
+	}
+}
+
+// PublishDueEpisodesJobHandler publishes the episodes which are due when the job runs, not when it was queued,
+// so a retried job catches up with the episodes which became due meanwhile.
+type PublishDueEpisodesJobHandler struct {
+	port inbound.PublishDueEpisodesPort
+}
+
+func NewPublishDueEpisodesJobHandler(portMap inbound.PortMap) *PublishDueEpisodesJobHandler {
+	return &PublishDueEpisodesJobHandler{
+		port: portMap[inbound.PublishDueEpisodes].(inbound.PublishDueEpisodesPort),
+	}
+}
+
+func (h *PublishDueEpisodesJobHandler) HandleJob(ctx context.Context, _ *model.Job) error {
+	response, err := h.port.PublishDueEpisodes(ctx, &inbound.PublishDueEpisodesCommand{Now: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	if len(response.EpisodeIds) > 0 {
+		slog.InfoContext(ctx, "published scheduled episodes", "episodeIds", response.EpisodeIds)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type enqueueJobTestService struct {
+	mutex     sync.Mutex
+	commands  []*inbound.EnqueueJobCommand
+	failsWith error
+}
+
+func (s *enqueueJobTestService) EnqueueJob(_ context.Context, command *inbound.EnqueueJobCommand) (*inbound.EnqueueJobResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.commands = append(s.commands, command)
+	if s.failsWith != nil {
+		return nil, s.failsWith
+	}
+	return &inbound.EnqueueJobResponse{Id: "some-job-id"}, nil
+}
+
+func (s *enqueueJobTestService) calls() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.commands)
+}
+
+type publishDueEpisodesTestService struct {
+	command   *inbound.PublishDueEpisodesCommand
+	failsWith error
+}
+
+func (s *publishDueEpisodesTestService) PublishDueEpisodes(_ context.Context, command *inbound.PublishDueEpisodesCommand) (*inbound.PublishDueEpisodesResponse, error) {
+	s.command = command
+	if s.failsWith != nil {
+		return nil, s.failsWith
+	}
+	return &inbound.PublishDueEpisodesResponse{EpisodeIds: []string{"some-episode-id"}}, nil
+}
+
+func Test_should_panic_if_no_port_was_found_on_scheduler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: new(enqueueJobTestService),
+	}
+
+	assert.Panics(t, func() {
+		NewScheduler(invalidPortMap, time.Millisecond)
+	})
+	assert.Panics(t, func() {
+		NewPublishDueEpisodesJobHandler(invalidPortMap)
+	})
+}
+
+func Test_should_enqueue_publishing_on_every_tick(t *testing.T) {
+	service := new(enqueueJobTestService)
+	scheduler := NewScheduler(inbound.PortMap{inbound.EnqueueJob: service}, time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool { return service.calls() >= 2 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, PublishDueEpisodesJob, service.commands[0].Type)
+	assert.Equal(t, PublishDueEpisodesJob, service.commands[0].UniqueKey)
+	assert.Equal(t, publishAttempts, service.commands[0].MaxAttempts)
+	assert.Equal(t, time.UTC, service.commands[0].RunAt.Location())
+}
+
+func Test_should_keep_running_if_enqueueing_fails(t *testing.T) {
+	service := &enqueueJobTestService{failsWith: errors.New("some error")}
+	scheduler := NewScheduler(inbound.PortMap{inbound.EnqueueJob: service}, time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	go scheduler.Run(ctx)
+
+	assert.Eventually(t, func() bool { return service.calls() >= 2 }, time.Second, time.Millisecond)
+}
+
+func Test_should_stop_when_context_is_done(t *testing.T) {
+	service := new(enqueueJobTestService)
+	scheduler := NewScheduler(inbound.PortMap{inbound.EnqueueJob: service}, time.Hour)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	scheduler.Run(ctx)
+
+	assert.Equal(t, 0, service.calls())
+}
+
+func Test_should_publish_episodes_due_when_job_runs(t *testing.T) {
+	service := new(publishDueEpisodesTestService)
+	handler := NewPublishDueEpisodesJobHandler(inbound.PortMap{inbound.PublishDueEpisodes: service})
+	before := time.Now()
+
+	err := handler.HandleJob(t.Context(), &model.Job{Type: PublishDueEpisodesJob, RunAt: before.Add(-time.Hour)})
+
+	assert.Implements(t, (*inbound.JobHandler)(nil), handler)
+	assert.Nil(t, err)
+	assert.WithinRange(t, service.command.Now, before, time.Now())
+	assert.Equal(t, time.UTC, service.command.Now.Location())
+}
+
+func Test_should_fail_job_if_publishing_fails(t *testing.T) {
+	expectedError := errors.New("some error")
+	service := &publishDueEpisodesTestService{failsWith: expectedError}
+	handler := NewPublishDueEpisodesJobHandler(inbound.PortMap{inbound.PublishDueEpisodes: service})
+
+	err := handler.HandleJob(t.Context(), &model.Job{Type: PublishDueEpisodesJob})
+
+	assert.Equal(t, expectedError, err)
+}
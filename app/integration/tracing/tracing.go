@@ -0,0 +1,58 @@
+// Package tracing installs the exporter of the spans of requests, inbound ports and SQL statements.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const (
+	NoExporter     = "none"
+	StdoutExporter = "stdout"
+	OtlpExporter   = "otlp"
+
+	serviceName = "podgopher"
+)
+
+// Install exports spans to stdout, or by OTLP over HTTP to the endpoint of the OTEL_EXPORTER_OTLP_ENDPOINT
+// environment variable. Traces of callers are continued by W3C trace context headers. The tracer provider is
+// installed also without exporter, so requests get trace ids for their logs and responses.
+//
+// The returned provider has to be shut down to export the last spans.
+func Install(ctx context.Context, exporter string, stdout io.Writer) (*sdktrace.TracerProvider, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	}
+	var spanExporter sdktrace.SpanExporter
+	var err error
+	switch exporter {
+	case NoExporter, "":
+	case StdoutExporter:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(stdout))
+	case OtlpExporter:
+		spanExporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("trace exporter '%s' is unknown, use '%s', '%s' or '%s'", exporter, NoExporter, StdoutExporter, OtlpExporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if spanExporter != nil {
+		options = append(options, sdktrace.WithBatcher(spanExporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	return provider, nil
+}
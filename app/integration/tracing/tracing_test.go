@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+)
+
+func Test_should_export_spans_to_stdout(t *testing.T) {
+	var stdout bytes.Buffer
+	provider, err := Install(t.Context(), StdoutExporter, &stdout)
+	assert.Nil(t, err)
+
+	_, span := otel.Tracer("test").Start(t.Context(), "some span")
+	span.End()
+	assert.Nil(t, provider.Shutdown(t.Context()))
+
+	assert.Contains(t, stdout.String(), `"Name":"some span"`)
+	assert.Contains(t, stdout.String(), `"Value":"podgopher"`)
+}
+
+func Test_should_generate_trace_ids_without_exporter(t *testing.T) {
+	provider, err := Install(t.Context(), NoExporter, nil)
+	assert.Nil(t, err)
+
+	_, span := otel.Tracer("test").Start(t.Context(), "some span")
+	span.End()
+
+	assert.True(t, span.SpanContext().TraceID().IsValid())
+	assert.Nil(t, provider.Shutdown(t.Context()))
+}
+
+func Test_should_fail_on_unknown_exporter(t *testing.T) {
+	_, err := Install(t.Context(), "jaeger", nil)
+
+	assert.EqualError(t, err, "trace exporter 'jaeger' is unknown, use 'none', 'stdout' or 'otlp'")
+}
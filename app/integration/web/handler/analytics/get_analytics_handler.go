@@ -0,0 +1,159 @@
+package analytics
+
+import (
+	"errors"
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	dateLayout    = time.DateOnly
+	defaultPeriod = 30
+	maximumPeriod = 366
+)
+
+type GetAnalyticsHandler struct {
+	route *handler.Route
+	port  inbound.GetAnalyticsPort
+}
+
+type GetAnalyticsRequestDto struct {
+	From    string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To      string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+	GroupBy string `form:"groupBy" binding:"omitempty,oneof=app device"`
+}
+
+type analyticsResponseDto struct {
+	ShowId   string                `json:"showId"`
+	From     string                `json:"from"`
+	To       string                `json:"to"`
+	Total    int                   `json:"total"`
+	Episodes []episodeDownloadsDto `json:"episodes"`
+	Days     []dayDownloadsDto     `json:"days"`
+	Groups   []groupDownloadsDto   `json:"groups"`
+	Counts   []downloadCountDto    `json:"counts"`
+}
+
+type episodeDownloadsDto struct {
+	EpisodeId string `json:"episodeId"`
+	Title     string `json:"title"`
+	Downloads int    `json:"downloads"`
+}
+
+type dayDownloadsDto struct {
+	Day       string `json:"day"`
+	Downloads int    `json:"downloads"`
+}
+
+type groupDownloadsDto struct {
+	Name      string `json:"name"`
+	Downloads int    `json:"downloads"`
+}
+
+type downloadCountDto struct {
+	EpisodeId string `json:"episodeId"`
+	Day       string `json:"day"`
+	App       string `json:"app"`
+	Device    string `json:"device"`
+	Downloads int    `json:"downloads"`
+}
+
+func NewGetAnalyticsHandler(portMap inbound.PortMap) *GetAnalyticsHandler {
+	return &GetAnalyticsHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/show/:showId/analytics",
+		},
+		port: portMap[inbound.GetAnalytics].(inbound.GetAnalyticsPort),
+	}
+}
+
+func (h *GetAnalyticsHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *GetAnalyticsHandler) Handle(context *gin.Context) {
+	var request GetAnalyticsRequestDto
+	if err := context.ShouldBindQuery(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	from, to, err := periodOf(&request, time.Now().UTC())
+	if err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	analytics, err := h.port.GetAnalytics(context.Request.Context(), &inbound.GetAnalyticsCommand{
+		ShowId:  context.Param("showId"),
+		From:    from,
+		To:      to,
+		GroupBy: inbound.AnalyticsGroup(request.GroupBy),
+	})
+	if err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, analyticsToDto(analytics))
+	}
+}
+
+// periodOf defaults to the 30 days up to today. Both days of the period are included.
+func periodOf(request *GetAnalyticsRequestDto, now time.Time) (from time.Time, to time.Time, err error) {
+	to = now.Truncate(24 * time.Hour)
+	if request.To != "" {
+		if to, err = time.Parse(dateLayout, request.To); err != nil {
+			return
+		}
+	}
+	from = to.AddDate(0, 0, 1-defaultPeriod)
+	if request.From != "" {
+		if from, err = time.Parse(dateLayout, request.From); err != nil {
+			return
+		}
+	}
+
+	switch {
+	case from.After(to):
+		err = errors.New("from must not be after to")
+	case to.Sub(from) >= maximumPeriod*24*time.Hour:
+		err = errors.New("period must not exceed 366 days")
+	}
+	return
+}
+
+func analyticsToDto(analytics *inbound.GetAnalyticsResponse) *analyticsResponseDto {
+	responseDto := &analyticsResponseDto{
+		ShowId:   analytics.ShowId,
+		From:     analytics.From.Format(dateLayout),
+		To:       analytics.To.Format(dateLayout),
+		Total:    analytics.Total,
+		Episodes: []episodeDownloadsDto{},
+		Days:     []dayDownloadsDto{},
+		Groups:   []groupDownloadsDto{},
+		Counts:   []downloadCountDto{},
+	}
+	for _, episode := range analytics.Episodes {
+		responseDto.Episodes = append(responseDto.Episodes, episodeDownloadsDto(episode))
+	}
+	for _, day := range analytics.Days {
+		responseDto.Days = append(responseDto.Days, dayDownloadsDto{Day: day.Day.Format(dateLayout), Downloads: day.Downloads})
+	}
+	for _, group := range analytics.Groups {
+		responseDto.Groups = append(responseDto.Groups, groupDownloadsDto(group))
+	}
+	for _, count := range analytics.Counts {
+		responseDto.Counts = append(responseDto.Counts, downloadCountDto{
+			EpisodeId: count.EpisodeId,
+			Day:       count.Day.Format(dateLayout),
+			App:       count.App,
+			Device:    count.Device,
+			Downloads: count.Downloads,
+		})
+	}
+	return responseDto
+}
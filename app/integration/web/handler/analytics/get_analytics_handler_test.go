@@ -0,0 +1,186 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type getAnalyticsTestService struct {
+	called                int
+	ctx                   context.Context
+	command               *inbound.GetAnalyticsCommand
+	returnsOnGetAnalytics *inbound.GetAnalyticsResponse
+	failsWith             error
+}
+
+func (s *getAnalyticsTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnGetAnalytics = nil
+	s.failsWith = nil
+}
+
+func (s *getAnalyticsTestService) GetAnalytics(ctx context.Context, command *inbound.GetAnalyticsCommand) (*inbound.GetAnalyticsResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnGetAnalytics, s.failsWith
+}
+
+var mockGetAnalyticsService = new(getAnalyticsTestService)
+
+var getAnalyticsHandler = NewGetAnalyticsHandler(inbound.PortMap{
+	inbound.GetAnalytics: mockGetAnalyticsService,
+})
+
+var firstOfMay = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func requestAnalytics(t *testing.T, query string) (*httptest.ResponseRecorder, []error) {
+	context, recorder := handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("GET", "/show/some-show-id/analytics"+query, nil)
+	context.AddParam("showId", "some-show-id")
+
+	getAnalyticsHandler.Handle(context)
+	// gin sends the status at the end of a request, unless a response was written before
+	context.Writer.WriteHeaderNow()
+
+	var errs []error
+	for _, err := range context.Errors {
+		errs = append(errs, err.Err)
+	}
+	return recorder, errs
+}
+
+func Test_should_implement_handler_for_get_analytics(t *testing.T) {
+	assert.NotNil(t, getAnalyticsHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getAnalyticsHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_analytics_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockGetAnalyticsService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetAnalyticsHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_analytics(t *testing.T) {
+	var route = getAnalyticsHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/show/:showId/analytics",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_analytics(t *testing.T) {
+	defer mockGetAnalyticsService.init()
+	expectedError := errors.New("some error")
+	mockGetAnalyticsService.failsWith = expectedError
+
+	_, errs := requestAnalytics(t, "")
+
+	assert.Equal(t, []error{expectedError}, errs)
+}
+
+func Test_should_reject_invalid_periods_on_get_analytics(t *testing.T) {
+	tests := map[string]string{
+		"malformed date": "?from=01.05.2024",
+		"reversed":       "?from=2024-05-31&to=2024-05-01",
+		"too long":       "?from=2023-01-01&to=2024-05-01",
+		"unknown group":  "?groupBy=country",
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockGetAnalyticsService.init()
+
+			recorder, errs := requestAnalytics(t, query)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Len(t, errs, 1)
+			assert.Equal(t, 0, mockGetAnalyticsService.called)
+		})
+	}
+}
+
+func Test_should_pass_period_to_get_analytics(t *testing.T) {
+	defer mockGetAnalyticsService.init()
+	mockGetAnalyticsService.returnsOnGetAnalytics = &inbound.GetAnalyticsResponse{}
+
+	_, errs := requestAnalytics(t, "?from=2024-05-01&to=2024-05-31&groupBy=device")
+
+	assert.Empty(t, errs)
+	assert.Equal(t, &inbound.GetAnalyticsCommand{
+		ShowId:  "some-show-id",
+		From:    firstOfMay,
+		To:      firstOfMay.AddDate(0, 0, 30),
+		GroupBy: inbound.GroupByDevice,
+	}, mockGetAnalyticsService.command)
+}
+
+func Test_should_default_to_last_30_days(t *testing.T) {
+	now := time.Date(2024, 5, 31, 15, 30, 0, 0, time.UTC)
+
+	from, to, err := periodOf(&GetAnalyticsRequestDto{}, now)
+
+	assert.Nil(t, err)
+	assert.Equal(t, firstOfMay.AddDate(0, 0, 1), from)
+	assert.Equal(t, firstOfMay.AddDate(0, 0, 30), to)
+}
+
+func Test_should_return_analytics(t *testing.T) {
+	defer mockGetAnalyticsService.init()
+	mockGetAnalyticsService.returnsOnGetAnalytics = &inbound.GetAnalyticsResponse{
+		ShowId:   "some-show-id",
+		From:     firstOfMay,
+		To:       firstOfMay.AddDate(0, 0, 1),
+		Total:    3,
+		Episodes: []inbound.EpisodeDownloads{{EpisodeId: "some-episode-id", Title: "Some Episode", Downloads: 3}},
+		Days:     []inbound.DayDownloads{{Day: firstOfMay, Downloads: 3}},
+		Groups:   []inbound.GroupDownloads{{Name: "Overcast", Downloads: 3}},
+		Counts:   []model.DownloadCount{{EpisodeId: "some-episode-id", Day: firstOfMay, App: "Overcast", Device: "phone", Downloads: 3}},
+	}
+
+	recorder, errs := requestAnalytics(t, "?from=2024-05-01&to=2024-05-02")
+
+	var responseDto *analyticsResponseDto
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &responseDto))
+	assert.Empty(t, errs)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, &analyticsResponseDto{
+		ShowId:   "some-show-id",
+		From:     "2024-05-01",
+		To:       "2024-05-02",
+		Total:    3,
+		Episodes: []episodeDownloadsDto{{EpisodeId: "some-episode-id", Title: "Some Episode", Downloads: 3}},
+		Days:     []dayDownloadsDto{{Day: "2024-05-01", Downloads: 3}},
+		Groups:   []groupDownloadsDto{{Name: "Overcast", Downloads: 3}},
+		Counts:   []downloadCountDto{{EpisodeId: "some-episode-id", Day: "2024-05-01", App: "Overcast", Device: "phone", Downloads: 3}},
+	}, responseDto)
+}
+
+func Test_should_return_empty_lists_without_downloads(t *testing.T) {
+	defer mockGetAnalyticsService.init()
+	mockGetAnalyticsService.returnsOnGetAnalytics = &inbound.GetAnalyticsResponse{ShowId: "some-show-id"}
+
+	recorder, _ := requestAnalytics(t, "")
+
+	assert.Contains(t, recorder.Body.String(), `"episodes":[],"days":[],"groups":[],"counts":[]`)
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"podGopher/core/domain/model"
+	"podGopher/integration/web/handler"
+)
+
+type MediaDto struct {
+	Url        string            `json:"url"`
+	FileName   string            `json:"fileName"`
+	Type       string            `json:"type"`
+	Size       int64             `json:"size"`
+	Duration   float64           `json:"duration,omitempty"`
+	Bitrate    int               `json:"bitrate,omitempty"`
+	SampleRate int               `json:"sampleRate,omitempty"`
+	Channels   int               `json:"channels,omitempty"`
+	Title      string            `json:"title,omitempty"`
+	ArtworkUrl string            `json:"artworkUrl,omitempty"`
+	Chapters   []MediaChapterDto `json:"chapters,omitempty"`
+}
+
+type MediaChapterDto struct {
+	Start float64 `json:"start"`
+	Title string  `json:"title"`
+}
+
+func MediaFromModel(media *model.Media, baseUrl string) *MediaDto {
+	if media == nil {
+		return nil
+	}
+	mediaDto := &MediaDto{
+		Url:        handler.MediaUrl(baseUrl, media.Key),
+		FileName:   media.FileName,
+		Type:       media.MimeType,
+		Size:       media.Size,
+		Duration:   media.Duration.Seconds(),
+		Bitrate:    media.Bitrate,
+		SampleRate: media.SampleRate,
+		Channels:   media.Channels,
+		Title:      media.Title,
+	}
+	if media.Artwork != nil {
+		mediaDto.ArtworkUrl = handler.MediaUrl(baseUrl, media.Artwork.Key)
+	}
+	for _, chapter := range media.Chapters {
+		mediaDto.Chapters = append(mediaDto.Chapters, MediaChapterDto{Start: chapter.Start.Seconds(), Title: chapter.Title})
+	}
+	return mediaDto
+}
@@ -0,0 +1,90 @@
+package dto
+
+import "podGopher/core/domain/model"
+
+type PersonDto struct {
+	Name  string `json:"name" binding:"required"`
+	Role  string `json:"role,omitempty"`
+	Group string `json:"group,omitempty"`
+	Img   string `json:"img,omitempty"`
+	Href  string `json:"href,omitempty"`
+}
+
+type FundingDto struct {
+	Url  string `json:"url" binding:"required"`
+	Text string `json:"text,omitempty"`
+}
+
+type TranscriptDto struct {
+	Url      string `json:"url" binding:"required"`
+	Type     string `json:"type" binding:"required"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+type ChaptersDto struct {
+	Url  string `json:"url" binding:"required"`
+	Type string `json:"type" binding:"required"`
+}
+
+func PersonsToModel(persons []PersonDto) []model.Person {
+	var result []model.Person
+	for _, person := range persons {
+		result = append(result, model.Person(person))
+	}
+	return result
+}
+
+func PersonsFromModel(persons []model.Person) []PersonDto {
+	var result []PersonDto
+	for _, person := range persons {
+		result = append(result, PersonDto(person))
+	}
+	return result
+}
+
+func FundingToModel(funding []FundingDto) []model.Funding {
+	var result []model.Funding
+	for _, entry := range funding {
+		result = append(result, model.Funding(entry))
+	}
+	return result
+}
+
+func FundingFromModel(funding []model.Funding) []FundingDto {
+	var result []FundingDto
+	for _, entry := range funding {
+		result = append(result, FundingDto(entry))
+	}
+	return result
+}
+
+func TranscriptsToModel(transcripts []TranscriptDto) []model.Transcript {
+	var result []model.Transcript
+	for _, transcript := range transcripts {
+		result = append(result, model.Transcript(transcript))
+	}
+	return result
+}
+
+func TranscriptsFromModel(transcripts []model.Transcript) []TranscriptDto {
+	var result []TranscriptDto
+	for _, transcript := range transcripts {
+		result = append(result, TranscriptDto(transcript))
+	}
+	return result
+}
+
+func ChaptersToModel(chapters *ChaptersDto) *model.Chapters {
+	if chapters == nil {
+		return nil
+	}
+	return &model.Chapters{Url: chapters.Url, Type: chapters.Type}
+}
+
+func ChaptersFromModel(chapters *model.Chapters) *ChaptersDto {
+	if chapters == nil {
+		return nil
+	}
+	return &ChaptersDto{Url: chapters.Url, Type: chapters.Type}
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,13 +16,28 @@ type CreateEpisodeHandler struct {
 }
 
 type CreateEpisodeRequestDto struct {
-	Title string `json:"title" binding:"required"`
+	Title         string              `json:"title" binding:"required"`
+	Description   string              `json:"description" binding:"max=4000"`
+	Season        int                 `json:"season" binding:"min=0"`
+	EpisodeNumber int                 `json:"episode" binding:"min=0"`
+	Transcripts   []dto.TranscriptDto `json:"transcripts" binding:"dive"`
+	Chapters      *dto.ChaptersDto    `json:"chapters"`
+	Persons       []dto.PersonDto     `json:"persons" binding:"dive"`
 }
 
 type episodeResponseDto struct {
-	Id     string `json:"id" binding:"required"`
-	ShowId string `json:"showId" binding:"required"`
-	Title  string `json:"title" binding:"required"`
+	Id            string              `json:"id" binding:"required"`
+	ShowId        string              `json:"showId" binding:"required"`
+	Title         string              `json:"title" binding:"required"`
+	Description   string              `json:"description,omitempty"`
+	Season        int                 `json:"season,omitempty"`
+	EpisodeNumber int                 `json:"episode,omitempty"`
+	Status        string              `json:"status,omitempty"`
+	PublishedAt   string              `json:"publishedAt,omitempty"`
+	Transcripts   []dto.TranscriptDto `json:"transcripts,omitempty"`
+	Chapters      *dto.ChaptersDto    `json:"chapters,omitempty"`
+	Persons       []dto.PersonDto     `json:"persons,omitempty"`
+	Media         *dto.MediaDto       `json:"media,omitempty"`
 }
 
 func (h *CreateEpisodeHandler) GetRoute() *handler.Route {
@@ -39,8 +56,8 @@ func NewCreateEpisodeHandler(portMap inbound.PortMap) *CreateEpisodeHandler {
 
 func (h *CreateEpisodeHandler) Handle(context *gin.Context) {
 	var request *CreateEpisodeRequestDto
-	if err := context.BindJSON(&request); err != nil {
-		context.Abort()
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
 		return
 	}
 
@@ -48,11 +65,39 @@ func (h *CreateEpisodeHandler) Handle(context *gin.Context) {
 }
 
 func (h *CreateEpisodeHandler) handleCreateEpisode(context *gin.Context, request *CreateEpisodeRequestDto) {
-	createEpisodeCommand := &inbound.CreateEpisodeCommand{ShowId: context.Param("showId"), Title: request.Title}
-	if createdEpisode, err := h.port.CreateEpisode(createEpisodeCommand); err != nil {
+	createEpisodeCommand := &inbound.CreateEpisodeCommand{
+		ShowId:        context.Param("showId"),
+		Title:         request.Title,
+		Description:   request.Description,
+		Season:        request.Season,
+		EpisodeNumber: request.EpisodeNumber,
+		Transcripts:   dto.TranscriptsToModel(request.Transcripts),
+		Chapters:      dto.ChaptersToModel(request.Chapters),
+		Persons:       dto.PersonsToModel(request.Persons),
+	}
+	if createdEpisode, err := h.port.CreateEpisode(context.Request.Context(), createEpisodeCommand); err != nil {
 		_ = context.Error(err)
 	} else {
-		responseDto := episodeResponseDto{Id: createdEpisode.Id, ShowId: createdEpisode.ShowId, Title: createdEpisode.Title}
+		responseDto := episodeResponseDto{
+			Id:            createdEpisode.Id,
+			ShowId:        createdEpisode.ShowId,
+			Title:         createdEpisode.Title,
+			Description:   createdEpisode.Description,
+			Season:        createdEpisode.Season,
+			EpisodeNumber: createdEpisode.EpisodeNumber,
+			Status:        string(createdEpisode.Status),
+			PublishedAt:   publishedAtToDto(createdEpisode.PublishedAt),
+			Transcripts:   dto.TranscriptsFromModel(createdEpisode.Transcripts),
+			Chapters:      dto.ChaptersFromModel(createdEpisode.Chapters),
+			Persons:       dto.PersonsFromModel(createdEpisode.Persons),
+		}
 		context.JSON(http.StatusCreated, responseDto)
 	}
 }
+
+func publishedAtToDto(publishedAt time.Time) string {
+	if publishedAt.IsZero() {
+		return ""
+	}
+	return publishedAt.Format(time.RFC3339)
+}
@@ -2,12 +2,15 @@ package episode
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 	"podGopher/integration/web/handler/handlerTestSetup"
 	"testing"
 
@@ -16,6 +19,7 @@ import (
 
 type createEpisodeTestService struct {
 	called                 int
+	ctx                    context.Context
 	command                *inbound.CreateEpisodeCommand
 	returnsOnCreateEpisode *inbound.CreateEpisodeResponse
 	failsWith              error
@@ -23,13 +27,15 @@ type createEpisodeTestService struct {
 
 func (s *createEpisodeTestService) init() {
 	s.called = 0
+	s.ctx = nil
 	s.command = nil
 	s.returnsOnCreateEpisode = nil
 	s.failsWith = nil
 }
 
-func (s *createEpisodeTestService) CreateEpisode(command *inbound.CreateEpisodeCommand) (episode *inbound.CreateEpisodeResponse, err error) {
+func (s *createEpisodeTestService) CreateEpisode(ctx context.Context, command *inbound.CreateEpisodeCommand) (episode *inbound.CreateEpisodeResponse, err error) {
 	s.called++
+	s.ctx = ctx
 	s.command = command
 	return s.returnsOnCreateEpisode, s.failsWith
 }
@@ -137,3 +143,70 @@ func Test_should_call_service_on_create_episode(t *testing.T) {
 	assert.Equal(t, test.expectedWebResponse, createEpisodeDto)
 	assert.Equal(t, http.StatusCreated, recorder.Code)
 }
+
+func Test_should_pass_podcast_namespace_values_on_create_episode(t *testing.T) {
+	defer mockCreateEpisodeService.init()
+	var createEpisodeDto *episodeResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+
+	webRequestBody := `{"title":"some title", "season":1, "episode":2,
+		"transcripts":[{"url":"https://example.com/transcript.vtt","type":"text/vtt"}],
+		"chapters":{"url":"https://example.com/chapters.json","type":"application/json+chapters"},
+		"persons":[{"name":"some guest","role":"guest"}]}`
+	transcripts := []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt"}}
+	chapters := &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"}
+	persons := []model.Person{{Name: "some guest", Role: "guest"}}
+
+	mockCreateEpisodeService.returnsOnCreateEpisode = &inbound.CreateEpisodeResponse{
+		Id:            "some-id",
+		ShowId:        "some-show-id",
+		Title:         "some title",
+		Season:        1,
+		EpisodeNumber: 2,
+		Transcripts:   transcripts,
+		Chapters:      chapters,
+		Persons:       persons,
+	}
+
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode", bytes.NewBuffer([]byte(webRequestBody)))
+	context.AddParam("showId", "some-show-id")
+
+	createEpisodeHandler.Handle(context)
+
+	var err = json.Unmarshal(recorder.Body.Bytes(), &createEpisodeDto)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.CreateEpisodeCommand{
+		ShowId:        "some-show-id",
+		Title:         "some title",
+		Season:        1,
+		EpisodeNumber: 2,
+		Transcripts:   transcripts,
+		Chapters:      chapters,
+		Persons:       persons,
+	}, mockCreateEpisodeService.command)
+	assert.Equal(t, &episodeResponseDto{
+		Id:            "some-id",
+		ShowId:        "some-show-id",
+		Title:         "some title",
+		Season:        1,
+		EpisodeNumber: 2,
+		Transcripts:   []dto.TranscriptDto{{Url: "https://example.com/transcript.vtt", Type: "text/vtt"}},
+		Chapters:      &dto.ChaptersDto{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+		Persons:       []dto.PersonDto{{Name: "some guest", Role: "guest"}},
+	}, createEpisodeDto)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_abort_if_season_is_negative_on_create_episode(t *testing.T) {
+	defer mockCreateEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode", bytes.NewBuffer([]byte(`{"title":"some title", "season":-1}`)))
+
+	createEpisodeHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, 400, context.Writer.Status())
+	assert.Equal(t, 0, mockCreateEpisodeService.called)
+}
@@ -0,0 +1,40 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteEpisodeHandler struct {
+	route *handler.Route
+	port  inbound.DeleteEpisodePort
+}
+
+func NewDeleteEpisodeHandler(portMap inbound.PortMap) *DeleteEpisodeHandler {
+	return &DeleteEpisodeHandler{
+		route: &handler.Route{
+			Method: http.MethodDelete,
+			Path:   "/show/:showId/episode/:episodeId",
+		},
+		port: portMap[inbound.DeleteEpisode].(inbound.DeleteEpisodePort),
+	}
+}
+
+func (h *DeleteEpisodeHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *DeleteEpisodeHandler) Handle(context *gin.Context) {
+	command := &inbound.DeleteEpisodeCommand{
+		ShowId:    context.Param("showId"),
+		EpisodeId: context.Param("episodeId"),
+	}
+	if err := h.port.DeleteEpisode(context.Request.Context(), command); err != nil {
+		_ = context.Error(err)
+	} else {
+		context.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,94 @@
+package episode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deleteEpisodeTestService struct {
+	called    int
+	ctx       context.Context
+	command   *inbound.DeleteEpisodeCommand
+	failsWith error
+}
+
+func (s *deleteEpisodeTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.failsWith = nil
+}
+
+func (s *deleteEpisodeTestService) DeleteEpisode(ctx context.Context, command *inbound.DeleteEpisodeCommand) error {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.failsWith
+}
+
+var mockDeleteEpisodeService = new(deleteEpisodeTestService)
+var deleteEpisodeHandler = NewDeleteEpisodeHandler(inbound.PortMap{
+	inbound.DeleteEpisode: mockDeleteEpisodeService,
+})
+
+func Test_should_implement_handler_for_delete_episode(t *testing.T) {
+	assert.NotNil(t, deleteEpisodeHandler)
+	assert.Implements(t, (*handler.Handler)(nil), deleteEpisodeHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_delete_episode_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockDeleteEpisodeService,
+	}
+
+	assert.Panics(t, func() {
+		NewDeleteEpisodeHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_delete_episode(t *testing.T) {
+	var route = deleteEpisodeHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "DELETE",
+		Path:   "/show/:showId/episode/:episodeId",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_call_service_on_delete_episode(t *testing.T) {
+	defer mockDeleteEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("DELETE", "/show/some-show-id/episode/some-episode-id", nil)
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	deleteEpisodeHandler.Handle(context)
+
+	assert.Equal(t, 1, mockDeleteEpisodeService.called)
+	assert.Equal(t, &inbound.DeleteEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"}, mockDeleteEpisodeService.command)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusNoContent, context.Writer.Status())
+}
+
+func Test_should_propagate_error_on_delete_episode(t *testing.T) {
+	defer mockDeleteEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockDeleteEpisodeService.failsWith = expectedError
+	context.Request = httptest.NewRequest("DELETE", "/show/some-show-id/episode/some-episode-id", nil)
+
+	deleteEpisodeHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
@@ -5,6 +5,7 @@ import (
 	error2 "podGopher/core/domain/error"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,15 +27,31 @@ func (h GetEpisodeHandler) Handle(context *gin.Context) {
 		return
 	}
 
-	foundEpisode, err := h.port.GetEpisode(&inbound.GetEpisodeCommand{
+	foundEpisode, err := h.port.GetEpisode(context.Request.Context(), &inbound.GetEpisodeCommand{
 		EpisodeId: episodeId,
 		ShowId:    showId,
 	})
 	if err != nil {
 		_ = context.Error(err)
 	} else {
-		responseDto := episodeResponseDto{Id: foundEpisode.Id, ShowId: foundEpisode.ShowId, Title: foundEpisode.Title}
-		context.JSON(http.StatusOK, responseDto)
+		context.JSON(http.StatusOK, episodeToDto(foundEpisode, handler.BaseUrl(context.Request)))
+	}
+}
+
+func episodeToDto(episode *inbound.GetEpisodeResponse, baseUrl string) episodeResponseDto {
+	return episodeResponseDto{
+		Id:            episode.Id,
+		ShowId:        episode.ShowId,
+		Title:         episode.Title,
+		Description:   episode.Description,
+		Season:        episode.Season,
+		EpisodeNumber: episode.EpisodeNumber,
+		Status:        string(episode.Status),
+		PublishedAt:   publishedAtToDto(episode.PublishedAt),
+		Transcripts:   dto.TranscriptsFromModel(episode.Transcripts),
+		Chapters:      dto.ChaptersFromModel(episode.Chapters),
+		Persons:       dto.PersonsFromModel(episode.Persons),
+		Media:         dto.MediaFromModel(episode.Media, baseUrl),
 	}
 }
 
@@ -2,21 +2,26 @@ package episode
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 	"podGopher/integration/web/handler/handlerTestSetup"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 type getEpisodeTestService struct {
 	called              int
+	ctx                 context.Context
 	command             *inbound.GetEpisodeCommand
 	returnsOnGetEpisode *inbound.GetEpisodeResponse
 	failsWith           error
@@ -24,13 +29,15 @@ type getEpisodeTestService struct {
 
 func (s *getEpisodeTestService) init() {
 	s.called = 0
+	s.ctx = nil
 	s.command = nil
 	s.returnsOnGetEpisode = nil
 	s.failsWith = nil
 }
 
-func (s *getEpisodeTestService) GetEpisode(command *inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+func (s *getEpisodeTestService) GetEpisode(ctx context.Context, command *inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
 	s.called++
+	s.ctx = ctx
 	s.command = command
 	return s.returnsOnGetEpisode, s.failsWith
 }
@@ -154,3 +161,65 @@ func Test_should_call_service_on_get_episode(t *testing.T) {
 	assert.Equal(t, test.expectedWebResponse, getEpisodeDto)
 	assert.Equal(t, http.StatusOK, recorder.Code)
 }
+
+func Test_should_reference_media_on_get_episode(t *testing.T) {
+	defer mockGetEpisodeService.init()
+	var getEpisodeDto *episodeResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+
+	mockGetEpisodeService.returnsOnGetEpisode = &inbound.GetEpisodeResponse{
+		Id:     "some-episode-id",
+		ShowId: "some-show-id",
+		Title:  "Mocked Title",
+		Media: &model.Media{
+			Key:        "some-episode-id/episode.mp3",
+			FileName:   "episode.mp3",
+			MimeType:   "audio/mpeg",
+			Size:       1024,
+			Duration:   90500 * time.Millisecond,
+			Bitrate:    128000,
+			SampleRate: 44100,
+			Channels:   2,
+			Title:      "Media Title",
+			Artwork:    &model.Artwork{Key: "some-episode-id/artwork.jpg", MimeType: "image/jpeg"},
+			Chapters:   []model.MediaChapter{{Start: 0, Title: "Intro"}, {Start: 45 * time.Second, Title: "Topic"}},
+		},
+	}
+
+	context.Request = httptest.NewRequest("GET", "http://example.com/show/some-show-id/episode/some-episode-id", bytes.NewBuffer([]byte("")))
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	getEpisodeHandler.Handle(context)
+
+	var err = json.Unmarshal(recorder.Body.Bytes(), &getEpisodeDto)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &dto.MediaDto{
+		Url:        "http://example.com/media/some-episode-id/episode.mp3",
+		FileName:   "episode.mp3",
+		Type:       "audio/mpeg",
+		Size:       1024,
+		Duration:   90.5,
+		Bitrate:    128000,
+		SampleRate: 44100,
+		Channels:   2,
+		Title:      "Media Title",
+		ArtworkUrl: "http://example.com/media/some-episode-id/artwork.jpg",
+		Chapters:   []dto.MediaChapterDto{{Start: 0, Title: "Intro"}, {Start: 45, Title: "Topic"}},
+	}, getEpisodeDto.Media)
+}
+
+func Test_should_pass_context_of_request_to_port_on_get_episode(t *testing.T) {
+	defer mockGetEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	mockGetEpisodeService.returnsOnGetEpisode = &inbound.GetEpisodeResponse{Id: "some-episode-id", ShowId: "some-show-id"}
+
+	context.Request = httptest.NewRequestWithContext(t.Context(), "GET", "/show/some-show-id/episode/some-episode-id", nil)
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	getEpisodeHandler.Handle(context)
+
+	assert.Equal(t, t.Context(), mockGetEpisodeService.ctx)
+}
@@ -0,0 +1,68 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetEpisodeMediaHandler struct {
+	route *handler.Route
+	port  inbound.GetEpisodeMediaPort
+}
+
+func NewGetEpisodeMediaHandler(portMap inbound.PortMap) handler.StreamHandler {
+	return &GetEpisodeMediaHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/media/:episodeId/:filename",
+		},
+		port: portMap[inbound.GetEpisodeMedia].(inbound.GetEpisodeMediaPort),
+	}
+}
+
+func (h *GetEpisodeMediaHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *GetEpisodeMediaHandler) Handle(context *gin.Context) {
+	h.serveMedia(context)
+}
+
+func (h *GetEpisodeMediaHandler) HandleHead(context *gin.Context) {
+	h.serveMedia(context)
+}
+
+// serveMedia leaves range requests, conditional requests and HEAD to http.ServeContent,
+// which evaluates them against the ETag and Last-Modified of the stored media.
+func (h *GetEpisodeMediaHandler) serveMedia(context *gin.Context) {
+	request := context.Request
+	found, err := h.port.GetEpisodeMedia(context.Request.Context(), &inbound.GetEpisodeMediaCommand{
+		EpisodeId:     context.Param("episodeId"),
+		FileName:      context.Param("filename"),
+		Method:        request.Method,
+		RemoteAddress: context.ClientIP(),
+		UserAgent:     request.UserAgent(),
+		Referrer:      request.Referer(),
+		Range:         request.Header.Get("Range"),
+	})
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	media := found.Media
+	defer func() {
+		_ = media.Content.Close()
+	}()
+
+	if media.ETag != "" {
+		context.Header("ETag", media.ETag)
+	}
+	if media.MimeType != "" {
+		context.Header("Content-Type", media.MimeType)
+	}
+	http.ServeContent(context.Writer, request, found.FileName, media.ModTime, media.Content)
+}
@@ -0,0 +1,232 @@
+package episode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type closeRecordingReader struct {
+	io.ReadSeeker
+	closed bool
+}
+
+func (r *closeRecordingReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+type getEpisodeMediaTestService struct {
+	called                   int
+	ctx                      context.Context
+	command                  *inbound.GetEpisodeMediaCommand
+	returnsOnGetEpisodeMedia *inbound.GetEpisodeMediaResponse
+	failsWith                error
+}
+
+func (s *getEpisodeMediaTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnGetEpisodeMedia = nil
+	s.failsWith = nil
+}
+
+func (s *getEpisodeMediaTestService) GetEpisodeMedia(ctx context.Context, command *inbound.GetEpisodeMediaCommand) (*inbound.GetEpisodeMediaResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnGetEpisodeMedia, s.failsWith
+}
+
+var mockGetEpisodeMediaService = new(getEpisodeMediaTestService)
+
+var getEpisodeMediaHandler = NewGetEpisodeMediaHandler(inbound.PortMap{
+	inbound.GetEpisodeMedia: mockGetEpisodeMediaService,
+})
+
+var mediaModTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func givenMediaContent(content string) *closeRecordingReader {
+	reader := &closeRecordingReader{ReadSeeker: strings.NewReader(content)}
+	mockGetEpisodeMediaService.returnsOnGetEpisodeMedia = &inbound.GetEpisodeMediaResponse{
+		FileName: "episode.mp3",
+		Media: &model.MediaContent{
+			Content:  reader,
+			MimeType: "audio/mpeg",
+			Size:     int64(len(content)),
+			ModTime:  mediaModTime,
+			ETag:     `"some-etag"`,
+		},
+	}
+	return reader
+}
+
+func serveTestMedia(t *testing.T, method string, headers map[string]string) *httptest.ResponseRecorder {
+	context, recorder := handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest(method, "http://example.com/media/some-episode-id/episode.mp3", nil)
+	for name, value := range headers {
+		context.Request.Header.Set(name, value)
+	}
+	context.AddParam("episodeId", "some-episode-id")
+	context.AddParam("filename", "episode.mp3")
+
+	if method == http.MethodHead {
+		getEpisodeMediaHandler.HandleHead(context)
+	} else {
+		getEpisodeMediaHandler.Handle(context)
+	}
+	// the engine writes the status of responses without body after all handlers ran
+	context.Writer.WriteHeaderNow()
+	assert.Empty(t, context.Errors)
+	return recorder
+}
+
+func Test_should_implement_stream_handler_for_get_episode_media(t *testing.T) {
+	assert.NotNil(t, getEpisodeMediaHandler)
+	assert.Implements(t, (*handler.StreamHandler)(nil), getEpisodeMediaHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_episode_media_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockGetEpisodeMediaService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetEpisodeMediaHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_episode_media(t *testing.T) {
+	var route = getEpisodeMediaHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/media/:episodeId/:filename",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_episode_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockGetEpisodeMediaService.failsWith = expectedError
+	context.Request = httptest.NewRequest("GET", "http://example.com/media/some-episode-id/episode.mp3", nil)
+
+	getEpisodeMediaHandler.Handle(context)
+
+	assert.Len(t, context.Errors, 1)
+	assert.Equal(t, expectedError, context.Errors[0].Err)
+}
+
+func Test_should_pass_request_details_to_get_episode_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	givenMediaContent("some audio")
+
+	serveTestMedia(t, http.MethodGet, map[string]string{
+		"User-Agent": "AppleCoreMedia/1.0.0",
+		"Referer":    "https://example.com",
+		"Range":      "bytes=0-1",
+	})
+
+	assert.Equal(t, &inbound.GetEpisodeMediaCommand{
+		EpisodeId:     "some-episode-id",
+		FileName:      "episode.mp3",
+		Method:        "GET",
+		RemoteAddress: "192.0.2.1",
+		UserAgent:     "AppleCoreMedia/1.0.0",
+		Referrer:      "https://example.com",
+		Range:         "bytes=0-1",
+	}, mockGetEpisodeMediaService.command)
+}
+
+func Test_should_stream_complete_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	reader := givenMediaContent("some audio")
+
+	recorder := serveTestMedia(t, http.MethodGet, nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "some audio", recorder.Body.String())
+	assert.Equal(t, "audio/mpeg", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "10", recorder.Header().Get("Content-Length"))
+	assert.Equal(t, "bytes", recorder.Header().Get("Accept-Ranges"))
+	assert.Equal(t, `"some-etag"`, recorder.Header().Get("ETag"))
+	assert.Equal(t, "Wed, 01 May 2024 12:00:00 GMT", recorder.Header().Get("Last-Modified"))
+	assert.True(t, reader.closed)
+}
+
+func Test_should_stream_requested_range_of_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	givenMediaContent("some audio")
+
+	recorder := serveTestMedia(t, http.MethodGet, map[string]string{"Range": "bytes=5-"})
+
+	assert.Equal(t, http.StatusPartialContent, recorder.Code)
+	assert.Equal(t, "audio", recorder.Body.String())
+	assert.Equal(t, "bytes 5-9/10", recorder.Header().Get("Content-Range"))
+}
+
+func Test_should_reject_unsatisfiable_range_of_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	givenMediaContent("some audio")
+
+	recorder := serveTestMedia(t, http.MethodGet, map[string]string{"Range": "bytes=20-"})
+
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, recorder.Code)
+	assert.Equal(t, "bytes */10", recorder.Header().Get("Content-Range"))
+}
+
+func Test_should_answer_head_without_body(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	givenMediaContent("some audio")
+
+	recorder := serveTestMedia(t, http.MethodHead, nil)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+	assert.Equal(t, "10", recorder.Header().Get("Content-Length"))
+	assert.Equal(t, "HEAD", mockGetEpisodeMediaService.command.Method)
+}
+
+func Test_should_answer_conditional_requests_of_unchanged_media(t *testing.T) {
+	tests := map[string]map[string]string{
+		"etag":          {"If-None-Match": `"some-etag"`},
+		"last modified": {"If-Modified-Since": "Wed, 01 May 2024 12:00:00 GMT"},
+	}
+
+	for name, headers := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockGetEpisodeMediaService.init()
+			givenMediaContent("some audio")
+
+			recorder := serveTestMedia(t, http.MethodGet, headers)
+
+			assert.Equal(t, http.StatusNotModified, recorder.Code)
+			assert.Empty(t, recorder.Body.String())
+		})
+	}
+}
+
+func Test_should_ignore_range_of_changed_media(t *testing.T) {
+	defer mockGetEpisodeMediaService.init()
+	givenMediaContent("some audio")
+
+	recorder := serveTestMedia(t, http.MethodGet, map[string]string{"Range": "bytes=5-", "If-Range": `"other-etag"`})
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "some audio", recorder.Body.String())
+}
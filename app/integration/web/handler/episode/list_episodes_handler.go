@@ -0,0 +1,68 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListEpisodesHandler struct {
+	route *handler.Route
+	port  inbound.ListEpisodesPort
+}
+
+type ListEpisodesRequestDto struct {
+	Sort   string `form:"sort" binding:"omitempty,oneof=publishedAt number"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type episodeListResponseDto struct {
+	Episodes   []episodeResponseDto `json:"episodes"`
+	NextCursor string               `json:"nextCursor,omitempty"`
+}
+
+func NewListEpisodesHandler(portMap inbound.PortMap) *ListEpisodesHandler {
+	return &ListEpisodesHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/show/:showId/episode",
+		},
+		port: portMap[inbound.ListEpisodes].(inbound.ListEpisodesPort),
+	}
+}
+
+func (h *ListEpisodesHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *ListEpisodesHandler) Handle(context *gin.Context) {
+	var request ListEpisodesRequestDto
+	if err := context.ShouldBindQuery(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	episodes, err := h.port.ListEpisodes(context.Request.Context(), &inbound.ListEpisodesCommand{
+		ShowId: context.Param("showId"),
+		Sort:   model.EpisodeSort(request.Sort),
+		Order:  model.SortOrder(request.Order),
+		Cursor: request.Cursor,
+		Limit:  request.Limit,
+	})
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	baseUrl := handler.BaseUrl(context.Request)
+	responseDto := episodeListResponseDto{Episodes: []episodeResponseDto{}, NextCursor: episodes.NextCursor}
+	for _, episode := range episodes.Episodes {
+		responseDto.Episodes = append(responseDto.Episodes, episodeToDto(episode, baseUrl))
+	}
+	context.JSON(http.StatusOK, responseDto)
+}
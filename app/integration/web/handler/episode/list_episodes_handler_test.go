@@ -0,0 +1,169 @@
+package episode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type listEpisodesTestService struct {
+	called                int
+	ctx                   context.Context
+	command               *inbound.ListEpisodesCommand
+	returnsOnListEpisodes *inbound.ListEpisodesResponse
+	failsWith             error
+}
+
+func (s *listEpisodesTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnListEpisodes = nil
+	s.failsWith = nil
+}
+
+func (s *listEpisodesTestService) ListEpisodes(ctx context.Context, command *inbound.ListEpisodesCommand) (episodes *inbound.ListEpisodesResponse, err error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnListEpisodes, s.failsWith
+}
+
+var mockListEpisodesService = new(listEpisodesTestService)
+
+var listEpisodesHandler = NewListEpisodesHandler(inbound.PortMap{
+	inbound.ListEpisodes: mockListEpisodesService,
+})
+
+func requestEpisodes(t *testing.T, query string) (*httptest.ResponseRecorder, []*gin.Error) {
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("GET", "http://example.com/show/some-show-id/episode"+query, nil)
+	context.AddParam("showId", "some-show-id")
+
+	listEpisodesHandler.Handle(context)
+	// gin sends the status at the end of a request, unless a response was written before
+	context.Writer.WriteHeaderNow()
+
+	return recorder, context.Errors
+}
+
+func Test_should_implement_handler_for_list_episodes(t *testing.T) {
+	assert.NotNil(t, listEpisodesHandler)
+	assert.Implements(t, (*handler.Handler)(nil), listEpisodesHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_list_episodes_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockListEpisodesService,
+	}
+
+	assert.Panics(t, func() {
+		NewListEpisodesHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_list_episodes(t *testing.T) {
+	assert.Equal(t, &handler.Route{Method: "GET", Path: "/show/:showId/episode"}, listEpisodesHandler.GetRoute())
+}
+
+func Test_should_reject_invalid_query_on_list_episodes(t *testing.T) {
+	tests := map[string]string{
+		"unknown sort":    "?sort=title",
+		"unknown order":   "?order=up",
+		"limit too small": "?limit=-1",
+		"limit too large": "?limit=101",
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockListEpisodesService.init()
+
+			recorder, errs := requestEpisodes(t, query)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Len(t, errs, 1)
+			assert.Equal(t, 0, mockListEpisodesService.called)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_list_episodes(t *testing.T) {
+	defer mockListEpisodesService.init()
+	expectedError := errors.New("some error")
+	mockListEpisodesService.failsWith = expectedError
+
+	_, errs := requestEpisodes(t, "")
+
+	assert.NotEmpty(t, errs)
+	assert.Equal(t, expectedError, errs[0].Err)
+}
+
+func Test_should_call_service_on_list_episodes(t *testing.T) {
+	defer mockListEpisodesService.init()
+	mockListEpisodesService.returnsOnListEpisodes = &inbound.ListEpisodesResponse{
+		Episodes: []*inbound.GetEpisodeResponse{{
+			Id:            "some-episode-id",
+			ShowId:        "some-show-id",
+			Title:         "Some Title",
+			Season:        1,
+			EpisodeNumber: 2,
+			Status:        model.EpisodePublished,
+			PublishedAt:   time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+			Media:         &model.Media{Key: "some-episode-id/episode.mp3", FileName: "episode.mp3", MimeType: "audio/mpeg", Size: 1024},
+		}},
+		NextCursor: "some-cursor",
+	}
+
+	recorder, errs := requestEpisodes(t, "?status=draft&sort=number&order=asc&cursor=other-cursor&limit=1")
+
+	var responseDto *episodeListResponseDto
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &responseDto))
+	assert.Empty(t, errs)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, &inbound.ListEpisodesCommand{
+		ShowId: "some-show-id",
+		Sort:   model.EpisodeSortNumber,
+		Order:  model.Ascending,
+		Cursor: "other-cursor",
+		Limit:  1,
+	}, mockListEpisodesService.command)
+	assert.Equal(t, &episodeListResponseDto{
+		Episodes: []episodeResponseDto{{
+			Id:            "some-episode-id",
+			ShowId:        "some-show-id",
+			Title:         "Some Title",
+			Season:        1,
+			EpisodeNumber: 2,
+			Status:        "published",
+			PublishedAt:   "2024-05-01T12:00:00Z",
+			Media: &dto.MediaDto{
+				Url:      "http://example.com/media/some-episode-id/episode.mp3",
+				FileName: "episode.mp3",
+				Type:     "audio/mpeg",
+				Size:     1024,
+			},
+		}},
+		NextCursor: "some-cursor",
+	}, responseDto)
+}
+
+func Test_should_return_empty_list_without_episodes(t *testing.T) {
+	defer mockListEpisodesService.init()
+	mockListEpisodesService.returnsOnListEpisodes = &inbound.ListEpisodesResponse{}
+
+	recorder, _ := requestEpisodes(t, "")
+
+	assert.Equal(t, `{"episodes":[]}`, recorder.Body.String())
+}
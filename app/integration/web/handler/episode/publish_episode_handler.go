@@ -0,0 +1,40 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PublishEpisodeHandler struct {
+	route *handler.Route
+	port  inbound.PublishEpisodePort
+}
+
+func NewPublishEpisodeHandler(portMap inbound.PortMap) *PublishEpisodeHandler {
+	return &PublishEpisodeHandler{
+		route: &handler.Route{
+			Method: http.MethodPost,
+			Path:   "/show/:showId/episode/:episodeId/publish",
+		},
+		port: portMap[inbound.PublishEpisode].(inbound.PublishEpisodePort),
+	}
+}
+
+func (h *PublishEpisodeHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *PublishEpisodeHandler) Handle(context *gin.Context) {
+	episode, err := h.port.PublishEpisode(context.Request.Context(), &inbound.PublishEpisodeCommand{
+		ShowId:    context.Param("showId"),
+		EpisodeId: context.Param("episodeId"),
+	})
+	if err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, episodeToDto(episode, handler.BaseUrl(context.Request)))
+	}
+}
@@ -0,0 +1,115 @@
+package episode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type publishEpisodeTestService struct {
+	called    int
+	ctx       context.Context
+	command   *inbound.PublishEpisodeCommand
+	returns   *inbound.GetEpisodeResponse
+	failsWith error
+}
+
+func (s *publishEpisodeTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returns = nil
+	s.failsWith = nil
+}
+
+func (s *publishEpisodeTestService) PublishEpisode(ctx context.Context, command *inbound.PublishEpisodeCommand) (*inbound.GetEpisodeResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returns, s.failsWith
+}
+
+var mockPublishEpisodeService = new(publishEpisodeTestService)
+var publishEpisodeHandler = NewPublishEpisodeHandler(inbound.PortMap{
+	inbound.PublishEpisode: mockPublishEpisodeService,
+})
+
+func Test_should_implement_handler_for_publish_episode(t *testing.T) {
+	assert.NotNil(t, publishEpisodeHandler)
+	assert.Implements(t, (*handler.Handler)(nil), publishEpisodeHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_publish_episode_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockPublishEpisodeService,
+	}
+
+	assert.Panics(t, func() {
+		NewPublishEpisodeHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_publish_episode(t *testing.T) {
+	var route = publishEpisodeHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "POST",
+		Path:   "/show/:showId/episode/:episodeId/publish",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_call_service_on_publish_episode(t *testing.T) {
+	defer mockPublishEpisodeService.init()
+	var episodeDto *episodeResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockPublishEpisodeService.returns = &inbound.GetEpisodeResponse{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      model.EpisodePublished,
+		PublishedAt: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+	}
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/publish", nil)
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	publishEpisodeHandler.Handle(context)
+
+	assert.Equal(t, 1, mockPublishEpisodeService.called)
+	assert.Equal(t, &inbound.PublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"}, mockPublishEpisodeService.command)
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &episodeDto))
+	assert.Equal(t, &episodeResponseDto{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      "published",
+		PublishedAt: "2024-03-01T08:00:00Z",
+	}, episodeDto)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_propagate_error_on_publish_episode(t *testing.T) {
+	defer mockPublishEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockPublishEpisodeService.failsWith = expectedError
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/publish", nil)
+
+	publishEpisodeHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
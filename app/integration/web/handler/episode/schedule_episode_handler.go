@@ -0,0 +1,52 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScheduleEpisodeHandler struct {
+	route *handler.Route
+	port  inbound.ScheduleEpisodePort
+}
+
+type ScheduleEpisodeRequestDto struct {
+	PublishAt time.Time `json:"publishAt" binding:"required"`
+}
+
+func NewScheduleEpisodeHandler(portMap inbound.PortMap) *ScheduleEpisodeHandler {
+	return &ScheduleEpisodeHandler{
+		route: &handler.Route{
+			Method: http.MethodPost,
+			Path:   "/show/:showId/episode/:episodeId/schedule",
+		},
+		port: portMap[inbound.ScheduleEpisode].(inbound.ScheduleEpisodePort),
+	}
+}
+
+func (h *ScheduleEpisodeHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *ScheduleEpisodeHandler) Handle(context *gin.Context) {
+	var request *ScheduleEpisodeRequestDto
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	episode, err := h.port.ScheduleEpisode(context.Request.Context(), &inbound.ScheduleEpisodeCommand{
+		ShowId:    context.Param("showId"),
+		EpisodeId: context.Param("episodeId"),
+		PublishAt: request.PublishAt,
+	})
+	if err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, episodeToDto(episode, handler.BaseUrl(context.Request)))
+	}
+}
@@ -0,0 +1,134 @@
+package episode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type scheduleEpisodeTestService struct {
+	called    int
+	ctx       context.Context
+	command   *inbound.ScheduleEpisodeCommand
+	returns   *inbound.GetEpisodeResponse
+	failsWith error
+}
+
+func (s *scheduleEpisodeTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returns = nil
+	s.failsWith = nil
+}
+
+func (s *scheduleEpisodeTestService) ScheduleEpisode(ctx context.Context, command *inbound.ScheduleEpisodeCommand) (*inbound.GetEpisodeResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returns, s.failsWith
+}
+
+var mockScheduleEpisodeService = new(scheduleEpisodeTestService)
+var scheduleEpisodeHandler = NewScheduleEpisodeHandler(inbound.PortMap{
+	inbound.ScheduleEpisode: mockScheduleEpisodeService,
+})
+
+func Test_should_implement_handler_for_schedule_episode(t *testing.T) {
+	assert.NotNil(t, scheduleEpisodeHandler)
+	assert.Implements(t, (*handler.Handler)(nil), scheduleEpisodeHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_schedule_episode_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockScheduleEpisodeService,
+	}
+
+	assert.Panics(t, func() {
+		NewScheduleEpisodeHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_schedule_episode(t *testing.T) {
+	var route = scheduleEpisodeHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "POST",
+		Path:   "/show/:showId/episode/:episodeId/schedule",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_call_service_on_schedule_episode(t *testing.T) {
+	defer mockScheduleEpisodeService.init()
+	var episodeDto *episodeResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	publishAt := time.Date(2030, 3, 1, 8, 0, 0, 0, time.UTC)
+	mockScheduleEpisodeService.returns = &inbound.GetEpisodeResponse{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      model.EpisodeScheduled,
+		PublishedAt: publishAt,
+	}
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/schedule",
+		bytes.NewBufferString(`{"publishAt":"2030-03-01T09:00:00+01:00"}`))
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	scheduleEpisodeHandler.Handle(context)
+
+	assert.Equal(t, 1, mockScheduleEpisodeService.called)
+	assert.Equal(t, "some-show-id", mockScheduleEpisodeService.command.ShowId)
+	assert.Equal(t, "some-episode-id", mockScheduleEpisodeService.command.EpisodeId)
+	assert.True(t, publishAt.Equal(mockScheduleEpisodeService.command.PublishAt))
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &episodeDto))
+	assert.Equal(t, &episodeResponseDto{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      "scheduled",
+		PublishedAt: "2030-03-01T08:00:00Z",
+	}, episodeDto)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_propagate_error_on_schedule_episode(t *testing.T) {
+	defer mockScheduleEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockScheduleEpisodeService.failsWith = expectedError
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/schedule",
+		bytes.NewBufferString(`{"publishAt":"2030-03-01T08:00:00Z"}`))
+
+	scheduleEpisodeHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
+
+func Test_abort_if_publish_date_is_missing_on_schedule_episode(t *testing.T) {
+	defer mockScheduleEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/schedule",
+		bytes.NewBufferString(`{"publishAt":"tomorrow"}`))
+
+	scheduleEpisodeHandler.Handle(context)
+
+	assert.Equal(t, 0, mockScheduleEpisodeService.called)
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, http.StatusBadRequest, context.Writer.Status())
+}
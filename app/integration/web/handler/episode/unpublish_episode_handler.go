@@ -0,0 +1,40 @@
+package episode
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UnpublishEpisodeHandler struct {
+	route *handler.Route
+	port  inbound.UnpublishEpisodePort
+}
+
+func NewUnpublishEpisodeHandler(portMap inbound.PortMap) *UnpublishEpisodeHandler {
+	return &UnpublishEpisodeHandler{
+		route: &handler.Route{
+			Method: http.MethodPost,
+			Path:   "/show/:showId/episode/:episodeId/unpublish",
+		},
+		port: portMap[inbound.UnpublishEpisode].(inbound.UnpublishEpisodePort),
+	}
+}
+
+func (h *UnpublishEpisodeHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *UnpublishEpisodeHandler) Handle(context *gin.Context) {
+	episode, err := h.port.UnpublishEpisode(context.Request.Context(), &inbound.UnpublishEpisodeCommand{
+		ShowId:    context.Param("showId"),
+		EpisodeId: context.Param("episodeId"),
+	})
+	if err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, episodeToDto(episode, handler.BaseUrl(context.Request)))
+	}
+}
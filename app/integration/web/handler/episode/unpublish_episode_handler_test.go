@@ -0,0 +1,115 @@
+package episode
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type unpublishEpisodeTestService struct {
+	called    int
+	ctx       context.Context
+	command   *inbound.UnpublishEpisodeCommand
+	returns   *inbound.GetEpisodeResponse
+	failsWith error
+}
+
+func (s *unpublishEpisodeTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returns = nil
+	s.failsWith = nil
+}
+
+func (s *unpublishEpisodeTestService) UnpublishEpisode(ctx context.Context, command *inbound.UnpublishEpisodeCommand) (*inbound.GetEpisodeResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returns, s.failsWith
+}
+
+var mockUnpublishEpisodeService = new(unpublishEpisodeTestService)
+var unpublishEpisodeHandler = NewUnpublishEpisodeHandler(inbound.PortMap{
+	inbound.UnpublishEpisode: mockUnpublishEpisodeService,
+})
+
+func Test_should_implement_handler_for_unpublish_episode(t *testing.T) {
+	assert.NotNil(t, unpublishEpisodeHandler)
+	assert.Implements(t, (*handler.Handler)(nil), unpublishEpisodeHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_unpublish_episode_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockUnpublishEpisodeService,
+	}
+
+	assert.Panics(t, func() {
+		NewUnpublishEpisodeHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_unpublish_episode(t *testing.T) {
+	var route = unpublishEpisodeHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "POST",
+		Path:   "/show/:showId/episode/:episodeId/unpublish",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_call_service_on_unpublish_episode(t *testing.T) {
+	defer mockUnpublishEpisodeService.init()
+	var episodeDto *episodeResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockUnpublishEpisodeService.returns = &inbound.GetEpisodeResponse{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      model.EpisodeUnpublished,
+		PublishedAt: time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+	}
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/unpublish", nil)
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	unpublishEpisodeHandler.Handle(context)
+
+	assert.Equal(t, 1, mockUnpublishEpisodeService.called)
+	assert.Equal(t, &inbound.UnpublishEpisodeCommand{ShowId: "some-show-id", EpisodeId: "some-episode-id"}, mockUnpublishEpisodeService.command)
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &episodeDto))
+	assert.Equal(t, &episodeResponseDto{
+		Id:          "some-episode-id",
+		ShowId:      "some-show-id",
+		Title:       "Mocked Title",
+		Status:      "unpublished",
+		PublishedAt: "2024-03-01T08:00:00Z",
+	}, episodeDto)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_propagate_error_on_unpublish_episode(t *testing.T) {
+	defer mockUnpublishEpisodeService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockUnpublishEpisodeService.failsWith = expectedError
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/unpublish", nil)
+
+	unpublishEpisodeHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
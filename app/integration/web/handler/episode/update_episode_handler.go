@@ -0,0 +1,111 @@
+package episode
+
+import (
+	"encoding/json"
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type UpdateEpisodeHandler struct {
+	route *handler.Route
+	port  inbound.UpdateEpisodePort
+}
+
+// PatchEpisodeRequestDto holds the fields to change, missing fields stay as they are.
+// Chapters set to null are removed.
+type PatchEpisodeRequestDto struct {
+	Title         *string              `json:"title" binding:"omitempty,min=1"`
+	Description   *string              `json:"description" binding:"omitempty,max=4000"`
+	Season        *int                 `json:"season" binding:"omitempty,min=0"`
+	EpisodeNumber *int                 `json:"episode" binding:"omitempty,min=0"`
+	Transcripts   *[]dto.TranscriptDto `json:"transcripts" binding:"omitempty,dive"`
+	Chapters      *dto.ChaptersDto     `json:"chapters"`
+	Persons       *[]dto.PersonDto     `json:"persons" binding:"omitempty,dive"`
+}
+
+func NewUpdateEpisodeHandler(portMap inbound.PortMap) *UpdateEpisodeHandler {
+	return &UpdateEpisodeHandler{
+		route: &handler.Route{
+			Method: http.MethodPut,
+			Path:   "/show/:showId/episode/:episodeId",
+		},
+		port: portMap[inbound.UpdateEpisode].(inbound.UpdateEpisodePort),
+	}
+}
+
+func (h *UpdateEpisodeHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+// Handle replaces all fields of an episode, so missing optional fields are reset.
+func (h *UpdateEpisodeHandler) Handle(context *gin.Context) {
+	var request *CreateEpisodeRequestDto
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	chapters := dto.ChaptersToModel(request.Chapters)
+	if chapters == nil {
+		chapters = &model.Chapters{}
+	}
+	transcripts, persons := dto.TranscriptsToModel(request.Transcripts), dto.PersonsToModel(request.Persons)
+	h.handleUpdateEpisode(context, &inbound.UpdateEpisodeCommand{
+		ShowId:        context.Param("showId"),
+		EpisodeId:     context.Param("episodeId"),
+		Title:         &request.Title,
+		Description:   &request.Description,
+		Season:        &request.Season,
+		EpisodeNumber: &request.EpisodeNumber,
+		Transcripts:   &transcripts,
+		Chapters:      chapters,
+		Persons:       &persons,
+	})
+}
+
+func (h *UpdateEpisodeHandler) HandlePatch(context *gin.Context) {
+	var request *PatchEpisodeRequestDto
+	var fields map[string]json.RawMessage
+	if err := context.ShouldBindBodyWith(&request, binding.JSON); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+	// the body was valid json, the fields tell a null value from a missing one
+	_ = context.ShouldBindBodyWith(&fields, binding.JSON)
+
+	command := &inbound.UpdateEpisodeCommand{
+		ShowId:        context.Param("showId"),
+		EpisodeId:     context.Param("episodeId"),
+		Title:         request.Title,
+		Description:   request.Description,
+		Season:        request.Season,
+		EpisodeNumber: request.EpisodeNumber,
+		Chapters:      dto.ChaptersToModel(request.Chapters),
+	}
+	if _, present := fields["chapters"]; present && request.Chapters == nil {
+		command.Chapters = &model.Chapters{}
+	}
+	if request.Transcripts != nil {
+		transcripts := dto.TranscriptsToModel(*request.Transcripts)
+		command.Transcripts = &transcripts
+	}
+	if request.Persons != nil {
+		persons := dto.PersonsToModel(*request.Persons)
+		command.Persons = &persons
+	}
+	h.handleUpdateEpisode(context, command)
+}
+
+func (h *UpdateEpisodeHandler) handleUpdateEpisode(context *gin.Context, command *inbound.UpdateEpisodeCommand) {
+	if updatedEpisode, err := h.port.UpdateEpisode(context.Request.Context(), command); err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, episodeToDto(updatedEpisode, handler.BaseUrl(context.Request)))
+	}
+}
@@ -0,0 +1,193 @@
+package episode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type updateEpisodeTestService struct {
+	called                 int
+	ctx                    context.Context
+	command                *inbound.UpdateEpisodeCommand
+	returnsOnUpdateEpisode *inbound.GetEpisodeResponse
+	failsWith              error
+}
+
+func (s *updateEpisodeTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnUpdateEpisode = nil
+	s.failsWith = nil
+}
+
+func (s *updateEpisodeTestService) UpdateEpisode(ctx context.Context, command *inbound.UpdateEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnUpdateEpisode, s.failsWith
+}
+
+var mockUpdateEpisodeService = new(updateEpisodeTestService)
+var updateEpisodeHandler = NewUpdateEpisodeHandler(inbound.PortMap{
+	inbound.UpdateEpisode: mockUpdateEpisodeService,
+})
+
+func Test_should_implement_handler_for_update_episode(t *testing.T) {
+	assert.NotNil(t, updateEpisodeHandler)
+	assert.Implements(t, (*handler.PatchHandler)(nil), updateEpisodeHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_update_episode_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockUpdateEpisodeService,
+	}
+
+	assert.Panics(t, func() {
+		NewUpdateEpisodeHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_update_episode(t *testing.T) {
+	var route = updateEpisodeHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "PUT",
+		Path:   "/show/:showId/episode/:episodeId",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func updateEpisodeRequest(t *testing.T, method string, body string) (*httptest.ResponseRecorder, func() error) {
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest(method, "/show/some-show-id/episode/some-episode-id", bytes.NewBuffer([]byte(body)))
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	if method == http.MethodPatch {
+		updateEpisodeHandler.HandlePatch(context)
+	} else {
+		updateEpisodeHandler.Handle(context)
+	}
+	// gin sends the status at the end of a request, unless a response was written before
+	context.Writer.WriteHeaderNow()
+	return recorder, func() error {
+		if len(context.Errors) == 0 {
+			return nil
+		}
+		return context.Errors[0].Err
+	}
+}
+
+func Test_should_replace_all_fields_on_put_episode(t *testing.T) {
+	defer mockUpdateEpisodeService.init()
+	var updatedEpisodeDto *episodeResponseDto
+	mockUpdateEpisodeService.returnsOnUpdateEpisode = &inbound.GetEpisodeResponse{Id: "some-episode-id", ShowId: "some-show-id", Title: "some title"}
+
+	recorder, firstError := updateEpisodeRequest(t, http.MethodPut, `{"title":"some title", "season":2}`)
+
+	title, description, season, episodeNumber := "some title", "", 2, 0
+	var transcripts []model.Transcript
+	var persons []model.Person
+	err := json.Unmarshal(recorder.Body.Bytes(), &updatedEpisodeDto)
+	assert.Nil(t, err)
+	assert.Nil(t, firstError())
+	assert.Equal(t, &inbound.UpdateEpisodeCommand{
+		ShowId:        "some-show-id",
+		EpisodeId:     "some-episode-id",
+		Title:         &title,
+		Description:   &description,
+		Season:        &season,
+		EpisodeNumber: &episodeNumber,
+		Transcripts:   &transcripts,
+		Chapters:      &model.Chapters{},
+		Persons:       &persons,
+	}, mockUpdateEpisodeService.command)
+	assert.Equal(t, &episodeResponseDto{Id: "some-episode-id", ShowId: "some-show-id", Title: "some title"}, updatedEpisodeDto)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_change_given_fields_on_patch_episode(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected *inbound.UpdateEpisodeCommand
+	}{
+		"title": {
+			`{"title":"other title"}`,
+			&inbound.UpdateEpisodeCommand{Title: func() *string { title := "other title"; return &title }()},
+		},
+		"chapters": {
+			`{"chapters":{"url":"https://example.com/chapters.json","type":"application/json+chapters"}}`,
+			&inbound.UpdateEpisodeCommand{Chapters: &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"}},
+		},
+		"chapters removed with null": {
+			`{"chapters":null}`,
+			&inbound.UpdateEpisodeCommand{Chapters: &model.Chapters{}},
+		},
+		"persons": {
+			`{"persons":[{"name":"some guest"}]}`,
+			&inbound.UpdateEpisodeCommand{Persons: &[]model.Person{{Name: "some guest"}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockUpdateEpisodeService.init()
+			mockUpdateEpisodeService.returnsOnUpdateEpisode = &inbound.GetEpisodeResponse{Id: "some-episode-id"}
+			test.expected.ShowId, test.expected.EpisodeId = "some-show-id", "some-episode-id"
+
+			recorder, firstError := updateEpisodeRequest(t, http.MethodPatch, test.body)
+
+			assert.Nil(t, firstError())
+			assert.Equal(t, test.expected, mockUpdateEpisodeService.command)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+func Test_abort_if_dto_is_invalid_on_update_episode(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		body   string
+	}{
+		"put without title":       {http.MethodPut, `{"season":1}`},
+		"patch with empty title":  {http.MethodPatch, `{"title":""}`},
+		"patch with bad season":   {http.MethodPatch, `{"season":-1}`},
+		"patch with bad chapters": {http.MethodPatch, `{"chapters":{}}`},
+		"patch with invalid json": {http.MethodPatch, `{"title"`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockUpdateEpisodeService.init()
+
+			recorder, firstError := updateEpisodeRequest(t, test.method, test.body)
+
+			assert.NotNil(t, firstError())
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, 0, mockUpdateEpisodeService.called)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_update_episode(t *testing.T) {
+	defer mockUpdateEpisodeService.init()
+	expectedError := errors.New("some error")
+	mockUpdateEpisodeService.failsWith = expectedError
+
+	_, firstError := updateEpisodeRequest(t, http.MethodPatch, `{"season":1}`)
+
+	assert.Equal(t, expectedError, firstError())
+}
@@ -0,0 +1,96 @@
+package episode
+
+import (
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const mediaFormField = "file"
+
+// mediaTypesByExtension complements mime.TypeByExtension which does not know audio types on every system
+var mediaTypesByExtension = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/x-m4a",
+	".aac":  "audio/aac",
+	".ogg":  "audio/ogg",
+	".opus": "audio/opus",
+	".mp4":  "video/mp4",
+}
+
+type UploadEpisodeMediaHandler struct {
+	route *handler.Route
+	port  inbound.UploadEpisodeMediaPort
+}
+
+func NewUploadEpisodeMediaHandler(portMap inbound.PortMap) *UploadEpisodeMediaHandler {
+	return &UploadEpisodeMediaHandler{
+		route: &handler.Route{
+			Method: http.MethodPost,
+			Path:   "/show/:showId/episode/:episodeId/media",
+		},
+		port: portMap[inbound.UploadEpisodeMedia].(inbound.UploadEpisodeMediaPort),
+	}
+}
+
+func (h *UploadEpisodeMediaHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *UploadEpisodeMediaHandler) Handle(context *gin.Context) {
+	fileHeader, err := context.FormFile(mediaFormField)
+	if err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+	defer func(file multipart.File) {
+		_ = file.Close()
+	}(file)
+
+	h.handleUploadEpisodeMedia(context, fileHeader, file)
+}
+
+func (h *UploadEpisodeMediaHandler) handleUploadEpisodeMedia(context *gin.Context, fileHeader *multipart.FileHeader, file multipart.File) {
+	command := &inbound.UploadEpisodeMediaCommand{
+		ShowId:    context.Param("showId"),
+		EpisodeId: context.Param("episodeId"),
+		FileName:  fileHeader.Filename,
+		MimeType:  mimeTypeOf(fileHeader),
+		Content:   file,
+	}
+	if uploaded, err := h.port.UploadEpisodeMedia(context.Request.Context(), command); err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusCreated, dto.MediaFromModel(uploaded.Media, handler.BaseUrl(context.Request)))
+	}
+}
+
+// mimeTypeOf uses the content type of the multipart section and falls back to the file extension
+// if the client did not send a specific one.
+func mimeTypeOf(fileHeader *multipart.FileHeader) string {
+	contentType := fileHeader.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType != "application/octet-stream" {
+		return mediaType
+	}
+	extension := strings.ToLower(filepath.Ext(fileHeader.Filename))
+	if mediaType, found := mediaTypesByExtension[extension]; found {
+		return mediaType
+	}
+	if mediaType, _, err := mime.ParseMediaType(mime.TypeByExtension(extension)); err == nil {
+		return mediaType
+	}
+	return contentType
+}
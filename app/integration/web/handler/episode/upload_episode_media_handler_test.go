@@ -0,0 +1,189 @@
+package episode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type uploadEpisodeMediaTestService struct {
+	called                      int
+	ctx                         context.Context
+	command                     *inbound.UploadEpisodeMediaCommand
+	receivedContent             string
+	returnsOnUploadEpisodeMedia *inbound.UploadEpisodeMediaResponse
+	failsWith                   error
+}
+
+func (s *uploadEpisodeMediaTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.receivedContent = ""
+	s.returnsOnUploadEpisodeMedia = nil
+	s.failsWith = nil
+}
+
+func (s *uploadEpisodeMediaTestService) UploadEpisodeMedia(ctx context.Context, command *inbound.UploadEpisodeMediaCommand) (*inbound.UploadEpisodeMediaResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	content, _ := io.ReadAll(command.Content)
+	s.receivedContent = string(content)
+	return s.returnsOnUploadEpisodeMedia, s.failsWith
+}
+
+var mockUploadEpisodeMediaService = new(uploadEpisodeMediaTestService)
+
+var uploadEpisodeMediaHandler = NewUploadEpisodeMediaHandler(inbound.PortMap{
+	inbound.UploadEpisodeMedia: mockUploadEpisodeMediaService,
+})
+
+func newMultipartRequest(t *testing.T, url string, fileName string, contentType string, content string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+fileName+`"`)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = part.Write([]byte(content))
+	_ = writer.Close()
+
+	request := httptest.NewRequest("POST", url, body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	return request
+}
+
+func Test_should_implement_handler_for_upload_episode_media(t *testing.T) {
+	assert.NotNil(t, uploadEpisodeMediaHandler)
+	assert.Implements(t, (*handler.Handler)(nil), uploadEpisodeMediaHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_upload_episode_media_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockUploadEpisodeMediaService,
+	}
+
+	assert.Panics(t, func() {
+		NewUploadEpisodeMediaHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_upload_episode_media(t *testing.T) {
+	var route = uploadEpisodeMediaHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "POST",
+		Path:   "/show/:showId/episode/:episodeId/media",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_abort_if_file_is_missing_on_upload_episode_media(t *testing.T) {
+	defer mockUploadEpisodeMediaService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+
+	context.Request = httptest.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/media", bytes.NewBuffer([]byte("")))
+
+	uploadEpisodeMediaHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, http.StatusBadRequest, context.Writer.Status())
+	assert.Equal(t, 0, mockUploadEpisodeMediaService.called)
+}
+
+func Test_should_propagate_error_on_upload_episode_media(t *testing.T) {
+	defer mockUploadEpisodeMediaService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+
+	mockUploadEpisodeMediaService.failsWith = expectedError
+
+	context.Request = newMultipartRequest(t, "/show/some-show-id/episode/some-episode-id/media", "episode.mp3", "audio/mpeg", "some audio")
+
+	uploadEpisodeMediaHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
+
+func Test_should_call_service_on_upload_episode_media(t *testing.T) {
+	defer mockUploadEpisodeMediaService.init()
+	var mediaDto *dto.MediaDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+
+	mockUploadEpisodeMediaService.returnsOnUploadEpisodeMedia = &inbound.UploadEpisodeMediaResponse{
+		EpisodeId: "some-episode-id",
+		ShowId:    "some-show-id",
+		Media:     &model.Media{Key: "some-episode-id/episode.mp3", FileName: "episode.mp3", MimeType: "audio/mpeg", Size: 10},
+	}
+
+	context.Request = newMultipartRequest(t, "http://example.com/show/some-show-id/episode/some-episode-id/media", "episode.mp3", "audio/mpeg", "some audio")
+	context.AddParam("showId", "some-show-id")
+	context.AddParam("episodeId", "some-episode-id")
+
+	uploadEpisodeMediaHandler.Handle(context)
+
+	var err = json.Unmarshal(recorder.Body.Bytes(), &mediaDto)
+
+	assert.Nil(t, err)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, 1, mockUploadEpisodeMediaService.called)
+	assert.Equal(t, "some-show-id", mockUploadEpisodeMediaService.command.ShowId)
+	assert.Equal(t, "some-episode-id", mockUploadEpisodeMediaService.command.EpisodeId)
+	assert.Equal(t, "episode.mp3", mockUploadEpisodeMediaService.command.FileName)
+	assert.Equal(t, "audio/mpeg", mockUploadEpisodeMediaService.command.MimeType)
+	assert.Equal(t, "some audio", mockUploadEpisodeMediaService.receivedContent)
+	assert.Equal(t, &dto.MediaDto{
+		Url:      "http://example.com/media/some-episode-id/episode.mp3",
+		FileName: "episode.mp3",
+		Type:     "audio/mpeg",
+		Size:     10,
+	}, mediaDto)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_detect_mime_type_of_media(t *testing.T) {
+	tests := map[string]struct {
+		fileName         string
+		contentType      string
+		expectedMimeType string
+	}{
+		"from content type":          {"episode.bin", "audio/mp4; codecs=mp4a.40.2", "audio/mp4"},
+		"from extension":             {"Episode.MP3", "", "audio/mpeg"},
+		"from extension for generic": {"episode.m4a", "application/octet-stream", "audio/x-m4a"},
+		"unknown":                    {"episode", "application/octet-stream", "application/octet-stream"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := &multipart.FileHeader{Filename: test.fileName, Header: textproto.MIMEHeader{}}
+			if test.contentType != "" {
+				header.Header.Set("Content-Type", test.contentType)
+			}
+
+			assert.Equal(t, test.expectedMimeType, mimeTypeOf(header))
+		})
+	}
+}
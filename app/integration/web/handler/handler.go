@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -13,3 +15,24 @@ type Handler interface {
 	GetRoute() *Route
 	Handle(context *gin.Context)
 }
+
+// StreamHandler is a Handler which streams its response body from storage.
+// It also answers HEAD requests on its route, which clients use to learn size and ranges of a stream.
+type StreamHandler interface {
+	Handler
+	HandleHead(context *gin.Context)
+}
+
+// PatchHandler is a Handler which also changes parts of its resource on PATCH requests to its route.
+type PatchHandler interface {
+	Handler
+	HandlePatch(context *gin.Context)
+}
+
+// AbortWithBindError stops a request which could not be bound, like a malformed body or an invalid field. The router
+// answers it as a bad request, with the fields which failed validation.
+func AbortWithBindError(context *gin.Context, err error) {
+	context.Status(http.StatusBadRequest)
+	_ = context.Error(err).SetType(gin.ErrorTypeBind)
+	context.Abort()
+}
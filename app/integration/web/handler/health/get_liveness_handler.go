@@ -0,0 +1,36 @@
+package health
+
+import (
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetLivenessHandler answers as long as the process serves requests. It checks no component, so an orchestrator
+// does not restart the app for an outage of the database.
+type GetLivenessHandler struct {
+	route *handler.Route
+}
+
+type livenessResponseDto struct {
+	Status model.HealthStatus `json:"status"`
+}
+
+func NewGetLivenessHandler() *GetLivenessHandler {
+	return &GetLivenessHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/healthz",
+		},
+	}
+}
+
+func (h *GetLivenessHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *GetLivenessHandler) Handle(context *gin.Context) {
+	context.JSON(http.StatusOK, &livenessResponseDto{Status: model.HealthUp})
+}
@@ -0,0 +1,39 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var getLivenessHandler = NewGetLivenessHandler()
+
+func Test_should_implement_handler_for_get_liveness(t *testing.T) {
+	assert.NotNil(t, getLivenessHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getLivenessHandler)
+}
+
+func Test_should_return_route_on_get_liveness(t *testing.T) {
+	var route = getLivenessHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/healthz",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_answer_up_on_get_liveness(t *testing.T) {
+	context, recorder := handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("GET", "/healthz", nil)
+
+	getLivenessHandler.Handle(context)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"up"}`, recorder.Body.String())
+}
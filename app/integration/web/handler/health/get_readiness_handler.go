@@ -0,0 +1,67 @@
+package health
+
+import (
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetReadinessHandler answers 503 while a component is down, so an orchestrator routes no requests to the app.
+type GetReadinessHandler struct {
+	route *handler.Route
+	port  inbound.CheckReadinessPort
+}
+
+type readinessResponseDto struct {
+	Status     model.HealthStatus   `json:"status"`
+	Components []componentHealthDto `json:"components"`
+}
+
+type componentHealthDto struct {
+	Name    string             `json:"name"`
+	Status  model.HealthStatus `json:"status"`
+	Error   string             `json:"error,omitempty"`
+	Details map[string]any     `json:"details,omitempty"`
+}
+
+func NewGetReadinessHandler(portMap inbound.PortMap) *GetReadinessHandler {
+	return &GetReadinessHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/readyz",
+		},
+		port: portMap[inbound.CheckReadiness].(inbound.CheckReadinessPort),
+	}
+}
+
+func (h *GetReadinessHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *GetReadinessHandler) Handle(context *gin.Context) {
+	readiness, err := h.port.CheckReadiness(context.Request.Context(), &inbound.CheckReadinessCommand{})
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	context.JSON(status, readinessToDto(readiness))
+}
+
+func readinessToDto(readiness *inbound.CheckReadinessResponse) *readinessResponseDto {
+	responseDto := &readinessResponseDto{Status: model.HealthUp, Components: []componentHealthDto{}}
+	if !readiness.Ready {
+		responseDto.Status = model.HealthDown
+	}
+	for _, component := range readiness.Components {
+		responseDto.Components = append(responseDto.Components, componentHealthDto(component))
+	}
+	return responseDto
+}
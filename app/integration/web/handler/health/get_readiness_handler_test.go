@@ -0,0 +1,125 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type checkReadinessTestService struct {
+	called                  int
+	ctx                     context.Context
+	returnsOnCheckReadiness *inbound.CheckReadinessResponse
+	failsWith               error
+}
+
+func (s *checkReadinessTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.returnsOnCheckReadiness = nil
+	s.failsWith = nil
+}
+
+func (s *checkReadinessTestService) CheckReadiness(ctx context.Context, _ *inbound.CheckReadinessCommand) (*inbound.CheckReadinessResponse, error) {
+	s.called++
+	s.ctx = ctx
+	return s.returnsOnCheckReadiness, s.failsWith
+}
+
+var mockCheckReadinessService = new(checkReadinessTestService)
+
+var getReadinessHandler = NewGetReadinessHandler(inbound.PortMap{
+	inbound.CheckReadiness: mockCheckReadinessService,
+})
+
+func requestReadiness(t *testing.T) (*httptest.ResponseRecorder, []error) {
+	context, recorder := handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("GET", "/readyz", nil)
+
+	getReadinessHandler.Handle(context)
+
+	var errs []error
+	for _, err := range context.Errors {
+		errs = append(errs, err.Err)
+	}
+	return recorder, errs
+}
+
+func Test_should_implement_handler_for_get_readiness(t *testing.T) {
+	assert.NotNil(t, getReadinessHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getReadinessHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_readiness_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockCheckReadinessService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetReadinessHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_readiness(t *testing.T) {
+	var route = getReadinessHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/readyz",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_readiness(t *testing.T) {
+	defer mockCheckReadinessService.init()
+	expectedError := errors.New("some error")
+	mockCheckReadinessService.failsWith = expectedError
+
+	_, errs := requestReadiness(t)
+
+	assert.Equal(t, []error{expectedError}, errs)
+}
+
+func Test_should_answer_ok_if_ready(t *testing.T) {
+	defer mockCheckReadinessService.init()
+	mockCheckReadinessService.returnsOnCheckReadiness = &inbound.CheckReadinessResponse{
+		Ready: true,
+		Components: []model.ComponentHealth{
+			{Name: "migration", Status: model.HealthUp, Details: map[string]any{"version": 6, "dirty": false}},
+			{Name: "storage", Status: model.HealthUp},
+		},
+	}
+
+	recorder, errs := requestReadiness(t)
+
+	assert.Empty(t, errs)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"up","components":[
+		{"name":"migration","status":"up","details":{"version":6,"dirty":false}},
+		{"name":"storage","status":"up"}
+	]}`, recorder.Body.String())
+}
+
+func Test_should_answer_service_unavailable_if_not_ready(t *testing.T) {
+	defer mockCheckReadinessService.init()
+	mockCheckReadinessService.returnsOnCheckReadiness = &inbound.CheckReadinessResponse{
+		Components: []model.ComponentHealth{{Name: "database", Status: model.HealthDown, Error: "database does not answer"}},
+	}
+
+	recorder, errs := requestReadiness(t)
+
+	assert.Empty(t, errs)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.JSONEq(t, `{"status":"down","components":[
+		{"name":"database","status":"down","error":"database does not answer"}
+	]}`, recorder.Body.String())
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 
 	"github.com/gin-gonic/gin"
 )
@@ -14,15 +15,39 @@ type CreateShowHandler struct {
 }
 
 type CreateShowRequestDto struct {
-	Title string `json:"title" binding:"required"`
-	Slug  string `json:"slug" binding:"required"`
+	Title                       string           `json:"title" binding:"required"`
+	Slug                        string           `json:"slug"`
+	Guid                        string           `json:"guid"`
+	Locked                      bool             `json:"locked"`
+	AllowDuplicateEpisodeTitles bool             `json:"allowDuplicateEpisodeTitles"`
+	Funding                     []dto.FundingDto `json:"funding" binding:"dive"`
+	Persons                     []dto.PersonDto  `json:"persons" binding:"dive"`
+	Description                 string           `json:"description" binding:"max=4000"`
+	Language                    string           `json:"language" binding:"omitempty,bcp47_language_tag"`
+	Author                      string           `json:"author"`
+	ImageUrl                    string           `json:"imageUrl" binding:"omitempty,url"`
+	Category                    string           `json:"category"`
+	Subcategory                 string           `json:"subcategory"`
+	Explicit                    bool             `json:"explicit"`
 }
 
 type showResponseDto struct {
-	Id       string   `json:"id" binding:"required"`
-	Title    string   `json:"title" binding:"required"`
-	Slug     string   `json:"slug" binding:"required"`
-	Episodes []string `json:"episodes" binding:"required"`
+	Id                          string           `json:"id" binding:"required"`
+	Title                       string           `json:"title" binding:"required"`
+	Slug                        string           `json:"slug" binding:"required"`
+	Guid                        string           `json:"guid,omitempty"`
+	Locked                      bool             `json:"locked"`
+	AllowDuplicateEpisodeTitles bool             `json:"allowDuplicateEpisodeTitles"`
+	Funding                     []dto.FundingDto `json:"funding,omitempty"`
+	Persons                     []dto.PersonDto  `json:"persons,omitempty"`
+	Description                 string           `json:"description,omitempty"`
+	Language                    string           `json:"language,omitempty"`
+	Author                      string           `json:"author,omitempty"`
+	ImageUrl                    string           `json:"imageUrl,omitempty"`
+	Category                    string           `json:"category,omitempty"`
+	Subcategory                 string           `json:"subcategory,omitempty"`
+	Explicit                    bool             `json:"explicit"`
+	Episodes                    []string         `json:"episodes" binding:"required"`
 }
 
 func (h *CreateShowHandler) GetRoute() *handler.Route {
@@ -41,8 +66,8 @@ func NewCreateShowHandler(portMap inbound.PortMap) *CreateShowHandler {
 
 func (h *CreateShowHandler) Handle(context *gin.Context) {
 	var request *CreateShowRequestDto
-	if err := context.BindJSON(&request); err != nil {
-		context.Abort()
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
 		return
 	}
 
@@ -50,10 +75,42 @@ func (h *CreateShowHandler) Handle(context *gin.Context) {
 }
 
 func (h *CreateShowHandler) handleCreateShow(context *gin.Context, request *CreateShowRequestDto) {
-	if createdShow, err := h.port.CreateShow(&inbound.CreateShowCommand{Title: request.Title, Slug: request.Slug}); err != nil {
+	command := &inbound.CreateShowCommand{
+		Title:                       request.Title,
+		Slug:                        request.Slug,
+		Guid:                        request.Guid,
+		Locked:                      request.Locked,
+		AllowDuplicateEpisodeTitles: request.AllowDuplicateEpisodeTitles,
+		Funding:                     dto.FundingToModel(request.Funding),
+		Persons:                     dto.PersonsToModel(request.Persons),
+		Description:                 request.Description,
+		Language:                    request.Language,
+		Author:                      request.Author,
+		ImageUrl:                    request.ImageUrl,
+		Category:                    request.Category,
+		Subcategory:                 request.Subcategory,
+		Explicit:                    request.Explicit,
+	}
+	if createdShow, err := h.port.CreateShow(context.Request.Context(), command); err != nil {
 		_ = context.Error(err)
 	} else {
-		responseDto := showResponseDto{Id: createdShow.Id, Title: createdShow.Title, Slug: createdShow.Slug}
+		responseDto := showResponseDto{
+			Id:                          createdShow.Id,
+			Title:                       createdShow.Title,
+			Slug:                        createdShow.Slug,
+			Guid:                        createdShow.Guid,
+			Locked:                      createdShow.Locked,
+			AllowDuplicateEpisodeTitles: createdShow.AllowDuplicateEpisodeTitles,
+			Funding:                     dto.FundingFromModel(createdShow.Funding),
+			Persons:                     dto.PersonsFromModel(createdShow.Persons),
+			Description:                 createdShow.Description,
+			Language:                    createdShow.Language,
+			Author:                      createdShow.Author,
+			ImageUrl:                    createdShow.ImageUrl,
+			Category:                    createdShow.Category,
+			Subcategory:                 createdShow.Subcategory,
+			Explicit:                    createdShow.Explicit,
+		}
 		context.JSON(http.StatusCreated, responseDto)
 	}
 }
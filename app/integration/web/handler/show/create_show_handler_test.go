@@ -2,12 +2,15 @@ package show
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"podGopher/core/domain/model"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 	"podGopher/integration/web/handler/handlerTestSetup"
 	"testing"
 
@@ -16,6 +19,7 @@ import (
 
 type createShowTestService struct {
 	called              int
+	ctx                 context.Context
 	command             *inbound.CreateShowCommand
 	returnsOnCreateShow *inbound.CreateShowResponse
 	failsWith           error
@@ -23,13 +27,15 @@ type createShowTestService struct {
 
 func (s *createShowTestService) init() {
 	s.called = 0
+	s.ctx = nil
 	s.command = nil
 	s.returnsOnCreateShow = nil
 	s.failsWith = nil
 }
 
-func (s *createShowTestService) CreateShow(command *inbound.CreateShowCommand) (show *inbound.CreateShowResponse, err error) {
+func (s *createShowTestService) CreateShow(ctx context.Context, command *inbound.CreateShowCommand) (show *inbound.CreateShowResponse, err error) {
 	s.called++
+	s.ctx = ctx
 	s.command = command
 	return s.returnsOnCreateShow, s.failsWith
 }
@@ -109,6 +115,116 @@ func Test_should_call_service_on_create_show(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, recorder.Code)
 }
 
+func Test_should_pass_duplicate_episode_titles_setting_on_create_show(t *testing.T) {
+	defer mockCreateShowService.init()
+	var createdShowDto *showResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockCreateShowService.returnsOnCreateShow = &inbound.CreateShowResponse{
+		Id: "some-id", Title: "some title", Slug: "some slug", AllowDuplicateEpisodeTitles: true,
+	}
+
+	context.Request = httptest.NewRequest("POST", "/show",
+		bytes.NewBufferString(`{"title":"some title", "slug":"some slug", "allowDuplicateEpisodeTitles":true}`))
+	createShowHandler.Handle(context)
+
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &createdShowDto))
+	assert.True(t, mockCreateShowService.command.AllowDuplicateEpisodeTitles)
+	assert.True(t, createdShowDto.AllowDuplicateEpisodeTitles)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_pass_podcast_namespace_values_on_create_show(t *testing.T) {
+	defer mockCreateShowService.init()
+	var createdShowDto *showResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+
+	webCommand := `{"title":"some title", "slug":"some slug", "guid":"some-guid", "locked":true,
+		"funding":[{"url":"https://example.com/donate","text":"Support us"}],
+		"persons":[{"name":"some host","role":"host","href":"https://example.com"}]}`
+	funding := []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}}
+	persons := []model.Person{{Name: "some host", Role: "host", Href: "https://example.com"}}
+
+	mockCreateShowService.returnsOnCreateShow = &inbound.CreateShowResponse{
+		Id:      "some-id",
+		Title:   "some title",
+		Slug:    "some slug",
+		Guid:    "some-guid",
+		Locked:  true,
+		Funding: funding,
+		Persons: persons,
+	}
+
+	context.Request = httptest.NewRequest("POST", "/show", bytes.NewBuffer([]byte(webCommand)))
+
+	createShowHandler.Handle(context)
+
+	var err = json.Unmarshal(recorder.Body.Bytes(), &createdShowDto)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &inbound.CreateShowCommand{
+		Title:   "some title",
+		Slug:    "some slug",
+		Guid:    "some-guid",
+		Locked:  true,
+		Funding: funding,
+		Persons: persons,
+	}, mockCreateShowService.command)
+	assert.Equal(t, &showResponseDto{
+		Id:      "some-id",
+		Title:   "some title",
+		Slug:    "some slug",
+		Guid:    "some-guid",
+		Locked:  true,
+		Funding: []dto.FundingDto{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons: []dto.PersonDto{{Name: "some host", Role: "host", Href: "https://example.com"}},
+	}, createdShowDto)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_pass_channel_details_on_create_show(t *testing.T) {
+	defer mockCreateShowService.init()
+	var createdShowDto *showResponseDto
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockCreateShowService.returnsOnCreateShow = &inbound.CreateShowResponse{
+		Id: "some-id", Title: "some title", Slug: "some slug", Language: "en", Explicit: true,
+	}
+
+	context.Request = httptest.NewRequest("POST", "/show", bytes.NewBufferString(`{"title":"some title", "slug":"some slug",
+		"description":"some description", "language":"en", "author":"some author", "imageUrl":"https://example.com/cover.jpg",
+		"category":"Technology", "subcategory":"Tech News", "explicit":true}`))
+	createShowHandler.Handle(context)
+
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &createdShowDto))
+	assert.Equal(t, &inbound.CreateShowCommand{
+		Title:       "some title",
+		Slug:        "some slug",
+		Description: "some description",
+		Language:    "en",
+		Author:      "some author",
+		ImageUrl:    "https://example.com/cover.jpg",
+		Category:    "Technology",
+		Subcategory: "Tech News",
+		Explicit:    true,
+	}, mockCreateShowService.command)
+	assert.Equal(t, "en", createdShowDto.Language)
+	assert.True(t, createdShowDto.Explicit)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_leave_slug_to_service_if_missing_on_create_show(t *testing.T) {
+	defer mockCreateShowService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockCreateShowService.returnsOnCreateShow = &inbound.CreateShowResponse{Id: "some-id", Title: "some title", Slug: "some-title"}
+
+	context.Request = httptest.NewRequest("POST", "/show", bytes.NewBufferString(`{"title":"some title"}`))
+
+	createShowHandler.Handle(context)
+
+	assert.Equal(t, &inbound.CreateShowCommand{Title: "some title"}, mockCreateShowService.command)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
 func Test_should_propagate_error_on_create_show(t *testing.T) {
 	defer mockCreateShowService.init()
 	var context, _ = handlerTestSetup.GetTestGinContext(t)
@@ -134,7 +250,7 @@ func Test_should_propagate_error_on_create_show(t *testing.T) {
 
 func Test_abort_if_dto_is_invalid_on_create_show(t *testing.T) {
 	defer mockCreateShowService.init()
-	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
 
 	test := struct {
 		webCommand string
@@ -147,5 +263,5 @@ func Test_abort_if_dto_is_invalid_on_create_show(t *testing.T) {
 	createShowHandler.Handle(context)
 
 	assert.NotEmpty(t, context.Errors)
-	assert.Equal(t, 400, recorder.Code)
+	assert.Equal(t, 400, context.Writer.Status())
 }
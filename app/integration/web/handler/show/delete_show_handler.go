@@ -0,0 +1,36 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeleteShowHandler struct {
+	route *handler.Route
+	port  inbound.DeleteShowPort
+}
+
+func NewDeleteShowHandler(portMap inbound.PortMap) *DeleteShowHandler {
+	return &DeleteShowHandler{
+		route: &handler.Route{
+			Method: http.MethodDelete,
+			Path:   "/show/:showId",
+		},
+		port: portMap[inbound.DeleteShow].(inbound.DeleteShowPort),
+	}
+}
+
+func (h *DeleteShowHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *DeleteShowHandler) Handle(context *gin.Context) {
+	if err := h.port.DeleteShow(context.Request.Context(), &inbound.DeleteShowCommand{Id: context.Param("showId")}); err != nil {
+		_ = context.Error(err)
+	} else {
+		context.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,93 @@
+package show
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type deleteShowTestService struct {
+	called    int
+	ctx       context.Context
+	command   *inbound.DeleteShowCommand
+	failsWith error
+}
+
+func (s *deleteShowTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.failsWith = nil
+}
+
+func (s *deleteShowTestService) DeleteShow(ctx context.Context, command *inbound.DeleteShowCommand) error {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.failsWith
+}
+
+var mockDeleteShowService = new(deleteShowTestService)
+var deleteShowHandler = NewDeleteShowHandler(inbound.PortMap{
+	inbound.DeleteShow: mockDeleteShowService,
+})
+
+func Test_should_implement_handler_for_delete_show(t *testing.T) {
+	assert.NotNil(t, deleteShowHandler)
+	assert.Implements(t, (*handler.Handler)(nil), deleteShowHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_delete_show_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockDeleteShowService,
+	}
+
+	assert.Panics(t, func() {
+		NewDeleteShowHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_delete_show(t *testing.T) {
+	var route = deleteShowHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "DELETE",
+		Path:   "/show/:showId",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_call_service_on_delete_show(t *testing.T) {
+	defer mockDeleteShowService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("DELETE", "/show/some-id", nil)
+	context.AddParam("showId", "some-id")
+
+	deleteShowHandler.Handle(context)
+
+	assert.Equal(t, 1, mockDeleteShowService.called)
+	assert.Equal(t, &inbound.DeleteShowCommand{Id: "some-id"}, mockDeleteShowService.command)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusNoContent, context.Writer.Status())
+}
+
+func Test_should_propagate_error_on_delete_show(t *testing.T) {
+	defer mockDeleteShowService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockDeleteShowService.failsWith = expectedError
+	context.Request = httptest.NewRequest("DELETE", "/show/some-id", nil)
+
+	deleteShowHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
@@ -0,0 +1,44 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetShowBySlugHandler struct {
+	route *handler.Route
+	port  inbound.GetShowBySlugPort
+}
+
+func NewGetShowBySlugHandler(portMap inbound.PortMap) *GetShowBySlugHandler {
+	return &GetShowBySlugHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/show/by-slug/:slug",
+		},
+		port: portMap[inbound.GetShowBySlug].(inbound.GetShowBySlugPort),
+	}
+}
+
+func (h *GetShowBySlugHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+// Handle answers a former slug of a show with a permanent redirect to its current slug.
+func (h *GetShowBySlugHandler) Handle(context *gin.Context) {
+	slug := context.Param("slug")
+	foundShow, err := h.port.GetShowBySlug(context.Request.Context(), &inbound.GetShowBySlugCommand{Slug: slug})
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	if foundShow.Slug != slug {
+		context.Redirect(http.StatusMovedPermanently, handler.ShowBySlugUrl(handler.BaseUrl(context.Request), foundShow.Slug))
+		return
+	}
+	context.JSON(http.StatusOK, showToDto(foundShow))
+}
@@ -0,0 +1,122 @@
+package show
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type getShowBySlugTestService struct {
+	called                 int
+	ctx                    context.Context
+	command                *inbound.GetShowBySlugCommand
+	returnsOnGetShowBySlug *inbound.GetShowResponse
+	failsWith              error
+}
+
+func (s *getShowBySlugTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnGetShowBySlug = nil
+	s.failsWith = nil
+}
+
+func (s *getShowBySlugTestService) GetShowBySlug(ctx context.Context, command *inbound.GetShowBySlugCommand) (*inbound.GetShowResponse, error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnGetShowBySlug, s.failsWith
+}
+
+var mockGetShowBySlugService = new(getShowBySlugTestService)
+
+var getShowBySlugHandler = NewGetShowBySlugHandler(inbound.PortMap{
+	inbound.GetShowBySlug: mockGetShowBySlugService,
+})
+
+func Test_should_implement_handler_for_get_show_by_slug(t *testing.T) {
+	assert.NotNil(t, getShowBySlugHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getShowBySlugHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_show_by_slug_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockGetShowBySlugService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetShowBySlugHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_show_by_slug(t *testing.T) {
+	var route = getShowBySlugHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/show/by-slug/:slug",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_show_by_slug(t *testing.T) {
+	defer mockGetShowBySlugService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockGetShowBySlugService.failsWith = expectedError
+
+	context.Request = httptest.NewRequest("GET", "/show/by-slug/some-slug", nil)
+	context.AddParam("slug", "some-slug")
+
+	getShowBySlugHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
+
+func Test_should_call_service_on_get_show_by_slug(t *testing.T) {
+	defer mockGetShowBySlugService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	var foundShowDto *showResponseDto
+	mockGetShowBySlugService.returnsOnGetShowBySlug = &inbound.GetShowResponse{Id: "some-id", Title: "Mocked Title", Slug: "some-slug"}
+
+	context.Request = httptest.NewRequestWithContext(t.Context(), "GET", "/show/by-slug/some-slug", nil)
+	context.AddParam("slug", "some-slug")
+
+	getShowBySlugHandler.Handle(context)
+
+	var err = json.Unmarshal(recorder.Body.Bytes(), &foundShowDto)
+
+	assert.Equal(t, 1, mockGetShowBySlugService.called)
+	assert.Equal(t, &inbound.GetShowBySlugCommand{Slug: "some-slug"}, mockGetShowBySlugService.command)
+	assert.Equal(t, t.Context(), mockGetShowBySlugService.ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, &showResponseDto{Id: "some-id", Title: "Mocked Title", Slug: "some-slug", Episodes: []string{}}, foundShowDto)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_redirect_former_slug_on_get_show_by_slug(t *testing.T) {
+	defer mockGetShowBySlugService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockGetShowBySlugService.returnsOnGetShowBySlug = &inbound.GetShowResponse{Id: "some-id", Slug: "current-slug"}
+
+	context.Request = httptest.NewRequest("GET", "http://example.com/show/by-slug/former-slug", nil)
+	context.AddParam("slug", "former-slug")
+
+	getShowBySlugHandler.Handle(context)
+
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusMovedPermanently, recorder.Code)
+	assert.Equal(t, "http://example.com/show/by-slug/current-slug", recorder.Header().Get("Location"))
+}
@@ -0,0 +1,48 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetShowFeedBySlugHandler struct {
+	route *handler.Route
+	port  inbound.GetShowFeedPort
+}
+
+func NewGetShowFeedBySlugHandler(portMap inbound.PortMap) *GetShowFeedBySlugHandler {
+	return &GetShowFeedBySlugHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/shows/:slug/feed.xml",
+		},
+		port: portMap[inbound.GetShowFeed].(inbound.GetShowFeedPort),
+	}
+}
+
+func (h *GetShowFeedBySlugHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+// Handle answers a former slug of a show with a permanent redirect, so podcast apps follow the show to its
+// current feed url.
+func (h *GetShowFeedBySlugHandler) Handle(context *gin.Context) {
+	baseUrl := handler.BaseUrl(context.Request)
+	slug := context.Param("slug")
+	command := &inbound.GetShowFeedCommand{Slug: slug}
+
+	feed, err := h.port.GetShowFeed(context.Request.Context(), command)
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	if feed.Slug != slug {
+		context.Redirect(http.StatusMovedPermanently, handler.SlugFeedUrl(baseUrl, feed.Slug))
+		return
+	}
+	renderFeed(context, feed, baseUrl)
+}
@@ -0,0 +1,92 @@
+package show
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var getShowFeedBySlugHandler = NewGetShowFeedBySlugHandler(inbound.PortMap{
+	inbound.GetShowFeed: mockGetShowFeedService,
+})
+
+func Test_should_implement_handler_for_get_show_feed_by_slug(t *testing.T) {
+	assert.NotNil(t, getShowFeedBySlugHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getShowFeedBySlugHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_show_feed_by_slug_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockGetShowFeedService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetShowFeedBySlugHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_show_feed_by_slug(t *testing.T) {
+	var route = getShowFeedBySlugHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/shows/:slug/feed.xml",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_show_feed_by_slug(t *testing.T) {
+	defer mockGetShowFeedService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+	mockGetShowFeedService.failsWith = expectedError
+
+	context.Request = httptest.NewRequest("GET", "/shows/some-slug/feed.xml", nil)
+	context.AddParam("slug", "some-slug")
+
+	getShowFeedBySlugHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
+
+func Test_should_render_rss_on_get_show_feed_by_slug(t *testing.T) {
+	defer mockGetShowFeedService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockGetShowFeedService.returnsOnGetShowFeed = &inbound.GetShowFeedResponse{Id: "some-show-id", Title: "Mocked Title", Slug: "some-slug"}
+
+	context.Request = httptest.NewRequest("GET", "http://example.com/shows/some-slug/feed.xml", nil)
+	context.AddParam("slug", "some-slug")
+
+	getShowFeedBySlugHandler.Handle(context)
+
+	assert.Equal(t, 1, mockGetShowFeedService.called)
+	assert.Empty(t, mockGetShowFeedService.command.ShowId)
+	assert.Equal(t, "some-slug", mockGetShowFeedService.command.Slug)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `<atom:link href="http://example.com/shows/some-slug/feed.xml"`)
+}
+
+func Test_should_redirect_former_slug_on_get_show_feed_by_slug(t *testing.T) {
+	defer mockGetShowFeedService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	mockGetShowFeedService.returnsOnGetShowFeed = &inbound.GetShowFeedResponse{Id: "some-show-id", Slug: "current-slug"}
+
+	context.Request = httptest.NewRequest("GET", "http://example.com/shows/former-slug/feed.xml", nil)
+	context.AddParam("slug", "former-slug")
+
+	getShowFeedBySlugHandler.Handle(context)
+
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusMovedPermanently, recorder.Code)
+	assert.Equal(t, "http://example.com/shows/current-slug/feed.xml", recorder.Header().Get("Location"))
+	assert.NotContains(t, recorder.Body.String(), "<rss")
+}
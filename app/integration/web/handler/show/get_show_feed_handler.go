@@ -0,0 +1,50 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/rss"
+
+	"github.com/gin-gonic/gin"
+)
+
+type GetShowFeedHandler struct {
+	route *handler.Route
+	port  inbound.GetShowFeedPort
+}
+
+func NewGetShowFeedHandler(portMap inbound.PortMap) *GetShowFeedHandler {
+	return &GetShowFeedHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/show/:showId/feed.xml",
+		},
+		port: portMap[inbound.GetShowFeed].(inbound.GetShowFeedPort),
+	}
+}
+
+func (h *GetShowFeedHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *GetShowFeedHandler) Handle(context *gin.Context) {
+	baseUrl := handler.BaseUrl(context.Request)
+	command := &inbound.GetShowFeedCommand{ShowId: context.Param("showId")}
+
+	feed, err := h.port.GetShowFeed(context.Request.Context(), command)
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+	renderFeed(context, feed, baseUrl)
+}
+
+func renderFeed(context *gin.Context, feed *inbound.GetShowFeedResponse, baseUrl string) {
+	body, err := rss.Render(feed, baseUrl)
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+	context.Data(http.StatusOK, rss.ContentType, body)
+}
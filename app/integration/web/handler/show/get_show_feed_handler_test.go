@@ -0,0 +1,112 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type getShowFeedTestService struct {
+	called               int
+	ctx                  context.Context
+	command              *inbound.GetShowFeedCommand
+	returnsOnGetShowFeed *inbound.GetShowFeedResponse
+	failsWith            error
+}
+
+func (s *getShowFeedTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnGetShowFeed = nil
+	s.failsWith = nil
+}
+
+func (s *getShowFeedTestService) GetShowFeed(ctx context.Context, command *inbound.GetShowFeedCommand) (feed *inbound.GetShowFeedResponse, err error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnGetShowFeed, s.failsWith
+}
+
+var mockGetShowFeedService = new(getShowFeedTestService)
+
+var getShowFeedHandler = NewGetShowFeedHandler(inbound.PortMap{
+	inbound.GetShowFeed: mockGetShowFeedService,
+})
+
+func Test_should_implement_handler_for_get_show_feed(t *testing.T) {
+	assert.NotNil(t, getShowFeedHandler)
+	assert.Implements(t, (*handler.Handler)(nil), getShowFeedHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_get_show_feed_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockGetShowFeedService,
+	}
+
+	assert.Panics(t, func() {
+		NewGetShowFeedHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_get_show_feed(t *testing.T) {
+	var route = getShowFeedHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "GET",
+		Path:   "/show/:showId/feed.xml",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func Test_should_propagate_error_on_get_show_feed(t *testing.T) {
+	defer mockGetShowFeedService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	expectedError := errors.New("some error")
+
+	mockGetShowFeedService.failsWith = expectedError
+
+	context.Request = httptest.NewRequest("GET", "/show/some-error-id/feed.xml", bytes.NewBuffer([]byte("")))
+
+	getShowFeedHandler.Handle(context)
+
+	assert.NotEmpty(t, context.Errors)
+	assert.Equal(t, expectedError, (*context.Errors[0]).Err)
+}
+
+func Test_should_render_rss_on_get_show_feed(t *testing.T) {
+	defer mockGetShowFeedService.init()
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+
+	mockGetShowFeedService.returnsOnGetShowFeed = &inbound.GetShowFeedResponse{
+		Id:       "some-show-id",
+		Title:    "Mocked Title",
+		Slug:     "mocked-slug",
+		Episodes: []*inbound.FeedEpisode{{Id: "some-episode-id", Title: "Mocked Episode"}},
+	}
+
+	context.Request = httptest.NewRequest("GET", "http://example.com/show/some-show-id/feed.xml", bytes.NewBuffer([]byte("")))
+	context.AddParam("showId", "some-show-id")
+
+	getShowFeedHandler.Handle(context)
+
+	assert.Equal(t, 1, mockGetShowFeedService.called)
+	assert.Equal(t, "some-show-id", mockGetShowFeedService.command.ShowId)
+	assert.Empty(t, context.Errors)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/rss+xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "<title>Mocked Title</title>")
+	assert.Contains(t, recorder.Body.String(), "<link>http://example.com/show/some-show-id</link>")
+	assert.Contains(t, recorder.Body.String(), `<atom:link href="http://example.com/shows/mocked-slug/feed.xml"`)
+	assert.Contains(t, recorder.Body.String(), "<title>Mocked Episode</title>")
+}
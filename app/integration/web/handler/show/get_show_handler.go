@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
 
 	"github.com/gin-gonic/gin"
 )
@@ -28,12 +29,32 @@ func (h *GetShowHandler) GetRoute() *handler.Route {
 }
 
 func (h *GetShowHandler) Handle(context *gin.Context) {
-	foundShow, err := h.port.GetShow(&inbound.GetShowCommand{Id: context.Param("showId")})
+	foundShow, err := h.port.GetShow(context.Request.Context(), &inbound.GetShowCommand{Id: context.Param("showId")})
 	if err != nil {
 		_ = context.Error(err)
 	} else {
-		responseDto := showResponseDto{Id: foundShow.Id, Title: foundShow.Title, Slug: foundShow.Slug, Episodes: episodesToDto(foundShow)}
-		context.JSON(http.StatusOK, responseDto)
+		context.JSON(http.StatusOK, showToDto(foundShow))
+	}
+}
+
+func showToDto(show *inbound.GetShowResponse) showResponseDto {
+	return showResponseDto{
+		Id:                          show.Id,
+		Title:                       show.Title,
+		Slug:                        show.Slug,
+		Guid:                        show.Guid,
+		Locked:                      show.Locked,
+		AllowDuplicateEpisodeTitles: show.AllowDuplicateEpisodeTitles,
+		Funding:                     dto.FundingFromModel(show.Funding),
+		Persons:                     dto.PersonsFromModel(show.Persons),
+		Description:                 show.Description,
+		Language:                    show.Language,
+		Author:                      show.Author,
+		ImageUrl:                    show.ImageUrl,
+		Category:                    show.Category,
+		Subcategory:                 show.Subcategory,
+		Explicit:                    show.Explicit,
+		Episodes:                    episodesToDto(show),
 	}
 }
 
@@ -2,6 +2,7 @@ package show
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 
 type getShowTestService struct {
 	called           int
+	ctx              context.Context
 	command          *inbound.GetShowCommand
 	returnsOnGetShow *inbound.GetShowResponse
 	failsWith        error
@@ -23,13 +25,15 @@ type getShowTestService struct {
 
 func (s *getShowTestService) init() {
 	s.called = 0
+	s.ctx = nil
 	s.command = nil
 	s.returnsOnGetShow = nil
 	s.failsWith = nil
 }
 
-func (s *getShowTestService) GetShow(command *inbound.GetShowCommand) (show *inbound.GetShowResponse, err error) {
+func (s *getShowTestService) GetShow(ctx context.Context, command *inbound.GetShowCommand) (show *inbound.GetShowResponse, err error) {
 	s.called++
+	s.ctx = ctx
 	s.command = command
 	return s.returnsOnGetShow, s.failsWith
 }
@@ -180,3 +184,16 @@ func Test_should_call_service_on_get_show(t *testing.T) {
 		})
 	}
 }
+
+func Test_should_pass_context_of_request_to_port_on_get_show(t *testing.T) {
+	defer mockGetShowService.init()
+	var context, _ = handlerTestSetup.GetTestGinContext(t)
+	mockGetShowService.returnsOnGetShow = &inbound.GetShowResponse{Id: "some-id"}
+
+	context.Request = httptest.NewRequestWithContext(t.Context(), "GET", "/show/some-id", nil)
+	context.AddParam("showId", "some-id")
+
+	getShowHandler.Handle(context)
+
+	assert.Equal(t, t.Context(), mockGetShowService.ctx)
+}
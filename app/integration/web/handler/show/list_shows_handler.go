@@ -0,0 +1,81 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ListShowsHandler struct {
+	route *handler.Route
+	port  inbound.ListShowsPort
+}
+
+type ListShowsRequestDto struct {
+	Query  string `form:"q"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=title createdAt"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Cursor string `form:"cursor"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+type showListResponseDto struct {
+	Shows      []showSummaryDto `json:"shows"`
+	NextCursor string           `json:"nextCursor,omitempty"`
+}
+
+type showSummaryDto struct {
+	Id        string `json:"id"`
+	Title     string `json:"title"`
+	Slug      string `json:"slug"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func NewListShowsHandler(portMap inbound.PortMap) *ListShowsHandler {
+	return &ListShowsHandler{
+		route: &handler.Route{
+			Method: http.MethodGet,
+			Path:   "/show",
+		},
+		port: portMap[inbound.ListShows].(inbound.ListShowsPort),
+	}
+}
+
+func (h *ListShowsHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+func (h *ListShowsHandler) Handle(context *gin.Context) {
+	var request ListShowsRequestDto
+	if err := context.ShouldBindQuery(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	shows, err := h.port.ListShows(context.Request.Context(), &inbound.ListShowsCommand{
+		Query:  request.Query,
+		Sort:   model.ShowSort(request.Sort),
+		Order:  model.SortOrder(request.Order),
+		Cursor: request.Cursor,
+		Limit:  request.Limit,
+	})
+	if err != nil {
+		_ = context.Error(err)
+		return
+	}
+
+	responseDto := showListResponseDto{Shows: []showSummaryDto{}, NextCursor: shows.NextCursor}
+	for _, show := range shows.Shows {
+		responseDto.Shows = append(responseDto.Shows, showSummaryDto{
+			Id:        show.Id,
+			Title:     show.Title,
+			Slug:      show.Slug,
+			CreatedAt: show.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	context.JSON(http.StatusOK, responseDto)
+}
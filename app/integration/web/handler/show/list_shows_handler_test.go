@@ -0,0 +1,147 @@
+package show
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type listShowsTestService struct {
+	called             int
+	ctx                context.Context
+	command            *inbound.ListShowsCommand
+	returnsOnListShows *inbound.ListShowsResponse
+	failsWith          error
+}
+
+func (s *listShowsTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnListShows = nil
+	s.failsWith = nil
+}
+
+func (s *listShowsTestService) ListShows(ctx context.Context, command *inbound.ListShowsCommand) (shows *inbound.ListShowsResponse, err error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnListShows, s.failsWith
+}
+
+var mockListShowsService = new(listShowsTestService)
+
+var listShowsHandler = NewListShowsHandler(inbound.PortMap{
+	inbound.ListShows: mockListShowsService,
+})
+
+func requestShows(t *testing.T, query string) (*httptest.ResponseRecorder, []*gin.Error) {
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest("GET", "/show"+query, nil)
+
+	listShowsHandler.Handle(context)
+	// gin sends the status at the end of a request, unless a response was written before
+	context.Writer.WriteHeaderNow()
+
+	return recorder, context.Errors
+}
+
+func Test_should_implement_handler_for_list_shows(t *testing.T) {
+	assert.NotNil(t, listShowsHandler)
+	assert.Implements(t, (*handler.Handler)(nil), listShowsHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_list_shows_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockListShowsService,
+	}
+
+	assert.Panics(t, func() {
+		NewListShowsHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_list_shows(t *testing.T) {
+	assert.Equal(t, &handler.Route{Method: "GET", Path: "/show"}, listShowsHandler.GetRoute())
+}
+
+func Test_should_reject_invalid_query_on_list_shows(t *testing.T) {
+	tests := map[string]string{
+		"unknown sort":    "?sort=slug",
+		"unknown order":   "?order=up",
+		"limit too small": "?limit=-1",
+		"limit too large": "?limit=101",
+		"limit no number": "?limit=some",
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockListShowsService.init()
+
+			recorder, errs := requestShows(t, query)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Len(t, errs, 1)
+			assert.Equal(t, 0, mockListShowsService.called)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_list_shows(t *testing.T) {
+	defer mockListShowsService.init()
+	expectedError := errors.New("some error")
+	mockListShowsService.failsWith = expectedError
+
+	_, errs := requestShows(t, "")
+
+	assert.NotEmpty(t, errs)
+	assert.Equal(t, expectedError, errs[0].Err)
+}
+
+func Test_should_call_service_on_list_shows(t *testing.T) {
+	defer mockListShowsService.init()
+	mockListShowsService.returnsOnListShows = &inbound.ListShowsResponse{
+		Shows: []inbound.ShowSummary{
+			{Id: "some-id", Title: "Some Title", Slug: "some-slug", CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		NextCursor: "some-cursor",
+	}
+
+	recorder, errs := requestShows(t, "?q=some&sort=createdAt&order=desc&cursor=other-cursor&limit=1")
+
+	var responseDto *showListResponseDto
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &responseDto))
+	assert.Empty(t, errs)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, &inbound.ListShowsCommand{
+		Query:  "some",
+		Sort:   model.ShowSortCreatedAt,
+		Order:  model.Descending,
+		Cursor: "other-cursor",
+		Limit:  1,
+	}, mockListShowsService.command)
+	assert.Equal(t, &showListResponseDto{
+		Shows:      []showSummaryDto{{Id: "some-id", Title: "Some Title", Slug: "some-slug", CreatedAt: "2024-05-01T12:00:00Z"}},
+		NextCursor: "some-cursor",
+	}, responseDto)
+}
+
+func Test_should_return_empty_list_without_shows(t *testing.T) {
+	defer mockListShowsService.init()
+	mockListShowsService.returnsOnListShows = &inbound.ListShowsResponse{}
+
+	recorder, _ := requestShows(t, "")
+
+	assert.Equal(t, `{"shows":[]}`, recorder.Body.String())
+}
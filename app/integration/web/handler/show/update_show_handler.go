@@ -0,0 +1,122 @@
+package show
+
+import (
+	"net/http"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateShowHandler struct {
+	route *handler.Route
+	port  inbound.UpdateShowPort
+}
+
+// PatchShowRequestDto holds the fields to change, missing fields stay as they are.
+type PatchShowRequestDto struct {
+	Title                       *string           `json:"title" binding:"omitempty,min=1"`
+	Slug                        *string           `json:"slug" binding:"omitempty,min=1"`
+	Guid                        *string           `json:"guid"`
+	Locked                      *bool             `json:"locked"`
+	AllowDuplicateEpisodeTitles *bool             `json:"allowDuplicateEpisodeTitles"`
+	Funding                     *[]dto.FundingDto `json:"funding" binding:"omitempty,dive"`
+	Persons                     *[]dto.PersonDto  `json:"persons" binding:"omitempty,dive"`
+	Description                 *string           `json:"description" binding:"omitempty,max=4000"`
+	Language                    *string           `json:"language" binding:"omitempty,bcp47_language_tag"`
+	Author                      *string           `json:"author"`
+	ImageUrl                    *string           `json:"imageUrl" binding:"omitempty,url"`
+	Category                    *string           `json:"category"`
+	Subcategory                 *string           `json:"subcategory"`
+	Explicit                    *bool             `json:"explicit"`
+}
+
+// PutShowRequestDto replaces a show. Unlike on creation, the slug is not generated and therefore required.
+type PutShowRequestDto struct {
+	CreateShowRequestDto
+	Slug string `json:"slug" binding:"required"`
+}
+
+func NewUpdateShowHandler(portMap inbound.PortMap) *UpdateShowHandler {
+	return &UpdateShowHandler{
+		route: &handler.Route{
+			Method: http.MethodPut,
+			Path:   "/show/:showId",
+		},
+		port: portMap[inbound.UpdateShow].(inbound.UpdateShowPort),
+	}
+}
+
+func (h *UpdateShowHandler) GetRoute() *handler.Route {
+	return h.route
+}
+
+// Handle replaces all fields of a show, so missing optional fields are reset.
+func (h *UpdateShowHandler) Handle(context *gin.Context) {
+	var request *PutShowRequestDto
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	funding, persons := dto.FundingToModel(request.Funding), dto.PersonsToModel(request.Persons)
+	h.handleUpdateShow(context, &inbound.UpdateShowCommand{
+		Id:                          context.Param("showId"),
+		Title:                       &request.Title,
+		Slug:                        &request.Slug,
+		Guid:                        &request.Guid,
+		Locked:                      &request.Locked,
+		AllowDuplicateEpisodeTitles: &request.AllowDuplicateEpisodeTitles,
+		Funding:                     &funding,
+		Persons:                     &persons,
+		Description:                 &request.Description,
+		Language:                    &request.Language,
+		Author:                      &request.Author,
+		ImageUrl:                    &request.ImageUrl,
+		Category:                    &request.Category,
+		Subcategory:                 &request.Subcategory,
+		Explicit:                    &request.Explicit,
+	})
+}
+
+func (h *UpdateShowHandler) HandlePatch(context *gin.Context) {
+	var request *PatchShowRequestDto
+	if err := context.ShouldBindJSON(&request); err != nil {
+		handler.AbortWithBindError(context, err)
+		return
+	}
+
+	command := &inbound.UpdateShowCommand{
+		Id:                          context.Param("showId"),
+		Title:                       request.Title,
+		Slug:                        request.Slug,
+		Guid:                        request.Guid,
+		Locked:                      request.Locked,
+		AllowDuplicateEpisodeTitles: request.AllowDuplicateEpisodeTitles,
+		Description:                 request.Description,
+		Language:                    request.Language,
+		Author:                      request.Author,
+		ImageUrl:                    request.ImageUrl,
+		Category:                    request.Category,
+		Subcategory:                 request.Subcategory,
+		Explicit:                    request.Explicit,
+	}
+	if request.Funding != nil {
+		funding := dto.FundingToModel(*request.Funding)
+		command.Funding = &funding
+	}
+	if request.Persons != nil {
+		persons := dto.PersonsToModel(*request.Persons)
+		command.Persons = &persons
+	}
+	h.handleUpdateShow(context, command)
+}
+
+func (h *UpdateShowHandler) handleUpdateShow(context *gin.Context, command *inbound.UpdateShowCommand) {
+	if updatedShow, err := h.port.UpdateShow(context.Request.Context(), command); err != nil {
+		_ = context.Error(err)
+	} else {
+		context.JSON(http.StatusOK, showToDto(updatedShow))
+	}
+}
@@ -0,0 +1,180 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/handlerTestSetup"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type updateShowTestService struct {
+	called              int
+	ctx                 context.Context
+	command             *inbound.UpdateShowCommand
+	returnsOnUpdateShow *inbound.GetShowResponse
+	failsWith           error
+}
+
+func (s *updateShowTestService) init() {
+	s.called = 0
+	s.ctx = nil
+	s.command = nil
+	s.returnsOnUpdateShow = nil
+	s.failsWith = nil
+}
+
+func (s *updateShowTestService) UpdateShow(ctx context.Context, command *inbound.UpdateShowCommand) (show *inbound.GetShowResponse, err error) {
+	s.called++
+	s.ctx = ctx
+	s.command = command
+	return s.returnsOnUpdateShow, s.failsWith
+}
+
+var mockUpdateShowService = new(updateShowTestService)
+var updateShowHandler = NewUpdateShowHandler(inbound.PortMap{
+	inbound.UpdateShow: mockUpdateShowService,
+})
+
+func Test_should_implement_handler_for_update_show(t *testing.T) {
+	assert.NotNil(t, updateShowHandler)
+	assert.Implements(t, (*handler.PatchHandler)(nil), updateShowHandler)
+}
+
+func Test_should_panic_if_no_port_was_found_on_update_show_handler(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: mockUpdateShowService,
+	}
+
+	assert.Panics(t, func() {
+		NewUpdateShowHandler(invalidPortMap)
+	})
+}
+
+func Test_should_return_route_on_update_show(t *testing.T) {
+	var route = updateShowHandler.GetRoute()
+
+	var expectedRoute = &handler.Route{
+		Method: "PUT",
+		Path:   "/show/:showId",
+	}
+
+	assert.Equal(t, expectedRoute, route)
+}
+
+func updateShowRequest(t *testing.T, method string, body string) (*httptest.ResponseRecorder, func() error) {
+	var context, recorder = handlerTestSetup.GetTestGinContext(t)
+	context.Request = httptest.NewRequest(method, "/show/some-id", bytes.NewBuffer([]byte(body)))
+	context.AddParam("showId", "some-id")
+
+	if method == http.MethodPatch {
+		updateShowHandler.HandlePatch(context)
+	} else {
+		updateShowHandler.Handle(context)
+	}
+	// gin sends the status at the end of a request, unless a response was written before
+	context.Writer.WriteHeaderNow()
+	return recorder, func() error {
+		if len(context.Errors) == 0 {
+			return nil
+		}
+		return context.Errors[0].Err
+	}
+}
+
+func Test_should_replace_all_fields_on_put_show(t *testing.T) {
+	defer mockUpdateShowService.init()
+	var updatedShowDto *showResponseDto
+	mockUpdateShowService.returnsOnUpdateShow = &inbound.GetShowResponse{Id: "some-id", Title: "some title", Slug: "some slug"}
+
+	recorder, firstError := updateShowRequest(t, http.MethodPut, `{"title":"some title", "slug":"some slug"}`)
+
+	title, slug, guid, locked, allowDuplicates := "some title", "some slug", "", false, false
+	empty, explicit := "", false
+	var funding []model.Funding
+	var persons []model.Person
+	err := json.Unmarshal(recorder.Body.Bytes(), &updatedShowDto)
+	assert.Nil(t, err)
+	assert.Nil(t, firstError())
+	assert.Equal(t, &inbound.UpdateShowCommand{
+		Id:                          "some-id",
+		Title:                       &title,
+		Slug:                        &slug,
+		Guid:                        &guid,
+		Locked:                      &locked,
+		AllowDuplicateEpisodeTitles: &allowDuplicates,
+		Funding:                     &funding,
+		Persons:                     &persons,
+		Description:                 &empty,
+		Language:                    &empty,
+		Author:                      &empty,
+		ImageUrl:                    &empty,
+		Category:                    &empty,
+		Subcategory:                 &empty,
+		Explicit:                    &explicit,
+	}, mockUpdateShowService.command)
+	assert.Equal(t, &showResponseDto{Id: "some-id", Title: "some title", Slug: "some slug", Episodes: []string{}}, updatedShowDto)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_change_given_fields_on_patch_show(t *testing.T) {
+	defer mockUpdateShowService.init()
+	mockUpdateShowService.returnsOnUpdateShow = &inbound.GetShowResponse{Id: "some-id", Title: "some title", Slug: "some slug"}
+
+	recorder, firstError := updateShowRequest(t, http.MethodPatch,
+		`{"title":"other title", "allowDuplicateEpisodeTitles":true, "persons":[{"name":"some host"}]}`)
+
+	title, allowDuplicates := "other title", true
+	persons := []model.Person{{Name: "some host"}}
+	assert.Nil(t, firstError())
+	assert.Equal(t, &inbound.UpdateShowCommand{
+		Id:                          "some-id",
+		Title:                       &title,
+		AllowDuplicateEpisodeTitles: &allowDuplicates,
+		Persons:                     &persons,
+	}, mockUpdateShowService.command)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_abort_if_dto_is_invalid_on_update_show(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		body   string
+	}{
+		"put without slug":        {http.MethodPut, `{"title":"some title"}`},
+		"patch with empty title":  {http.MethodPatch, `{"title":""}`},
+		"patch with bad person":   {http.MethodPatch, `{"persons":[{"role":"host"}]}`},
+		"patch with bad language": {http.MethodPatch, `{"language":"not a language"}`},
+		"patch with bad image":    {http.MethodPatch, `{"imageUrl":"cover.jpg"}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer mockUpdateShowService.init()
+
+			recorder, firstError := updateShowRequest(t, test.method, test.body)
+
+			assert.NotNil(t, firstError())
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, 0, mockUpdateShowService.called)
+		})
+	}
+}
+
+func Test_should_propagate_error_on_update_show(t *testing.T) {
+	defer mockUpdateShowService.init()
+	expectedError := errors.New("some error")
+	mockUpdateShowService.failsWith = expectedError
+
+	_, firstError := updateShowRequest(t, http.MethodPatch, `{"locked":true}`)
+
+	assert.Equal(t, expectedError, firstError())
+}
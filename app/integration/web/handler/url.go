@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// BaseUrl returns scheme and host a request was sent to.
+func BaseUrl(request *http.Request) string {
+	scheme := "http"
+	if request.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + request.Host
+}
+
+func ShowUrl(baseUrl string, showId string) string {
+	return baseUrl + "/show/" + showId
+}
+
+func FeedUrl(baseUrl string, showId string) string {
+	return ShowUrl(baseUrl, showId) + "/feed.xml"
+}
+
+// FeedUrlOf gives the feed url of shows by id, which does not change with the slug of a show.
+func FeedUrlOf(baseUrl string) func(showId string) string {
+	return func(showId string) string {
+		return FeedUrl(baseUrl, showId)
+	}
+}
+
+func ShowBySlugUrl(baseUrl string, slug string) string {
+	return baseUrl + "/show/by-slug/" + url.PathEscape(slug)
+}
+
+// SlugFeedUrl is the public url of a feed, which follows the show when its slug changes.
+func SlugFeedUrl(baseUrl string, slug string) string {
+	return baseUrl + "/shows/" + url.PathEscape(slug) + "/feed.xml"
+}
+
+func MediaUrl(baseUrl string, key string) string {
+	return baseUrl + "/media/" + key
+}
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"crypto/tls"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_should_build_base_url_from_request(t *testing.T) {
+	request := httptest.NewRequest("GET", "http://example.com:3000/show", nil)
+
+	assert.Equal(t, "http://example.com:3000", BaseUrl(request))
+
+	request.TLS = &tls.ConnectionState{}
+	assert.Equal(t, "https://example.com:3000", BaseUrl(request))
+}
+
+func Test_should_build_urls(t *testing.T) {
+	assert.Equal(t, "http://example.com/show/some-show-id", ShowUrl("http://example.com", "some-show-id"))
+	assert.Equal(t, "http://example.com/show/some-show-id/feed.xml", FeedUrl("http://example.com", "some-show-id"))
+	assert.Equal(t, "http://example.com/show/some-show-id/feed.xml", FeedUrlOf("http://example.com")("some-show-id"))
+	assert.Equal(t, "http://example.com/show/by-slug/some-slug", ShowBySlugUrl("http://example.com", "some-slug"))
+	assert.Equal(t, "http://example.com/shows/some-slug/feed.xml", SlugFeedUrl("http://example.com", "some-slug"))
+	assert.Equal(t, "http://example.com/shows/some%20slug/feed.xml", SlugFeedUrl("http://example.com", "some slug"))
+	assert.Equal(t, "http://example.com/media/some-episode-id/episode.mp3", MediaUrl("http://example.com", "some-episode-id/episode.mp3"))
+}
@@ -0,0 +1,62 @@
+package web
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"podGopher/integration/logging"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	requestIdHeader = "X-Request-Id"
+	// maxRequestIdLength keeps clients from filling the log with their request ids
+	maxRequestIdLength = 128
+)
+
+// logRequest logs every request once it is answered, with the errors of its handlers in full, also those which
+// are answered without details. The id of the request is taken from the client or generated, and answered in the
+// X-Request-Id header. Requests are logged without a user, since the app does not authenticate them yet.
+func logRequest(context *gin.Context) {
+	start := time.Now()
+	requestId := context.GetHeader(requestIdHeader)
+	if requestId == "" || len(requestId) > maxRequestIdLength {
+		requestId = uuid.NewString()
+	}
+	context.Header(requestIdHeader, requestId)
+	context.Request = context.Request.WithContext(logging.WithRequestId(context.Request.Context(), requestId))
+
+	context.Next()
+
+	status := context.Writer.Status()
+	attrs := []slog.Attr{
+		slog.String("method", context.Request.Method),
+		slog.String("route", context.FullPath()),
+		slog.String("path", context.Request.URL.Path),
+		slog.Int("status", status),
+		slog.Duration("latency", time.Since(start)),
+		slog.String("client", context.ClientIP()),
+	}
+	if len(context.Errors) > 0 {
+		attrs = append(attrs, slog.Any("errors", context.Errors.Errors()))
+	}
+	level := slog.LevelInfo
+	if status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	slog.LogAttrs(context.Request.Context(), level, "request answered", attrs...)
+}
+
+// recoverRequest answers a panicking handler with a 500 problem, and logs the panic with the stack of the handler.
+var recoverRequest = gin.CustomRecoveryWithWriter(io.Discard, func(context *gin.Context, recovered any) {
+	slog.ErrorContext(context.Request.Context(), "request panicked", "panic", recovered, "stack", string(debug.Stack()))
+	if context.Writer.Written() {
+		context.Abort()
+		return
+	}
+	writeProblem(context, newStatusProblemDto(http.StatusInternalServerError, context.Request.URL.Path))
+})
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"podGopher/integration/web/handler"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsPath = "/metrics"
+
+// httpMetrics label requests by the path of their route instead of the requested path, so ids do not create a
+// series per show or episode.
+type httpMetrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func newHttpMetrics(registerer prometheus.Registerer) *httpMetrics {
+	metrics := &httpMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "podgopher_http_requests_total",
+			Help: "Requests answered per route and status.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "podgopher_http_request_duration_seconds",
+			Help:    "Time to answer requests per route, including the transfer of the response.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+	}
+	registerer.MustRegister(metrics.requests, metrics.duration)
+	return metrics
+}
+
+// observe measures the handlers of a route which follow it, including the handling of their errors.
+func (metrics *httpMetrics) observe(route *handler.Route) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		start := time.Now()
+		context.Next()
+
+		method := context.Request.Method
+		metrics.requests.WithLabelValues(method, route.Path, strconv.Itoa(context.Writer.Status())).Inc()
+		metrics.duration.WithLabelValues(method, route.Path).Observe(time.Since(start).Seconds())
+	}
+}
+
+func metricsHandler(registry *prometheus.Registry) gin.HandlerFunc {
+	return gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry}))
+}
@@ -0,0 +1,162 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	error2 "podGopher/core/domain/error"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	// problemTypePrefix makes the types of problems URIs, they identify problems without documents behind them
+	problemTypePrefix = "urn:podgopher:problem:"
+)
+
+// problemDto answers a failed request as described by RFC 9457.
+type problemDto struct {
+	Type     string          `json:"type"`
+	Title    string          `json:"title"`
+	Status   int             `json:"status"`
+	Detail   string          `json:"detail,omitempty"`
+	Instance string          `json:"instance"`
+	Errors   []fieldErrorDto `json:"errors,omitempty"`
+}
+
+type fieldErrorDto struct {
+	Field  string `json:"field"`
+	Detail string `json:"detail"`
+}
+
+// problemKindStatus answers the problems of the domain, so a new domain error only needs its problem registered.
+var problemKindStatus = map[error2.ProblemKind]int{
+	error2.ProblemInvalid:       http.StatusBadRequest,
+	error2.ProblemNotFound:      http.StatusNotFound,
+	error2.ProblemConflict:      http.StatusConflict,
+	error2.ProblemUnsupported:   http.StatusUnsupportedMediaType,
+	error2.ProblemUnprocessable: http.StatusUnprocessableEntity,
+	error2.ProblemUnavailable:   http.StatusServiceUnavailable,
+}
+
+var invalidRequestProblem = error2.Problem{Kind: error2.ProblemInvalid, Type: "invalid-request", Title: "Request is invalid"}
+
+// handleError answers the last error of the handlers which follow it as problem, unless they answered already.
+// Errors without a registered problem are answered without details, the log keeps them in full.
+func handleError(context *gin.Context) {
+	context.Next()
+
+	if len(context.Errors) == 0 || context.Writer.Written() {
+		return
+	}
+	writeProblem(context, newProblemDto(context.Errors.Last(), context.Request.URL.Path))
+}
+
+// answerUnknownRoute answers requests which match no route, or none with their method, as problem.
+func answerUnknownRoute(context *gin.Context) {
+	writeProblem(context, newStatusProblemDto(context.Writer.Status(), context.Request.URL.Path))
+}
+
+func writeProblem(context *gin.Context, problem *problemDto) {
+	context.Header("Content-Type", problemContentType)
+	context.AbortWithStatusJSON(problem.Status, problem)
+}
+
+func newProblemDto(err *gin.Error, instance string) *problemDto {
+	if err.IsType(gin.ErrorTypeBind) {
+		return newBindProblemDto(err.Err, instance)
+	}
+
+	problem, found := error2.ProblemOf(err.Err)
+	if status := problemKindStatus[problem.Kind]; found && status != 0 {
+		dto := &problemDto{
+			Type:     problemTypePrefix + problem.Type,
+			Title:    problem.Title,
+			Status:   status,
+			Instance: instance,
+		}
+		if !problem.Internal {
+			dto.Detail = err.Error()
+		}
+		return dto
+	}
+
+	return newStatusProblemDto(http.StatusInternalServerError, instance)
+}
+
+// newStatusProblemDto answers a problem which the status tells in full, without details.
+func newStatusProblemDto(status int, instance string) *problemDto {
+	return &problemDto{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Instance: instance,
+	}
+}
+
+// newBindProblemDto answers a request which could not be bound, with the fields which failed validation.
+func newBindProblemDto(err error, instance string) *problemDto {
+	dto := &problemDto{
+		Type:     problemTypePrefix + invalidRequestProblem.Type,
+		Title:    invalidRequestProblem.Title,
+		Status:   problemKindStatus[invalidRequestProblem.Kind],
+		Detail:   err.Error(),
+		Instance: instance,
+	}
+
+	var validationErrors validator.ValidationErrors
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &validationErrors):
+		dto.Detail = "request has invalid fields"
+		for _, fieldError := range validationErrors {
+			dto.Errors = append(dto.Errors, fieldErrorDto{fieldPath(fieldError), fieldErrorDetail(fieldError)})
+		}
+	case errors.As(err, &typeError):
+		dto.Detail = "request has invalid fields"
+		dto.Errors = []fieldErrorDto{{typeError.Field, fmt.Sprintf("must be of type %s", typeError.Type)}}
+	case errors.Is(err, io.EOF):
+		dto.Detail = "request body is missing"
+	}
+	return dto
+}
+
+// fieldPath names a field by its path in the request, without the name of the type bound to.
+func fieldPath(fieldError validator.FieldError) string {
+	_, path, found := strings.Cut(fieldError.Namespace(), ".")
+	if !found {
+		return fieldError.Field()
+	}
+	return path
+}
+
+func fieldErrorDetail(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must be at least %s", fieldError.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fieldError.Param())
+	default:
+		return fmt.Sprintf("does not satisfy '%s'", fieldError.Tag())
+	}
+}
+
+// nameFieldsByJson makes validation errors name fields as clients know them, by their json names.
+func nameFieldsByJson() {
+	if validate, isValidator := binding.Validator.Engine().(*validator.Validate); isValidator {
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			// fields without a json name keep their name
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			return name
+		})
+	}
+}
@@ -1,20 +1,29 @@
 package web
 
 import (
-	"errors"
 	"net/http"
-	error2 "podGopher/core/domain/error"
 	"podGopher/core/port/inbound"
 	"podGopher/integration/web/handler"
+	"podGopher/integration/web/handler/analytics"
 	"podGopher/integration/web/handler/episode"
+	"podGopher/integration/web/handler/health"
 	"podGopher/integration/web/handler/show"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
-func NewRouter(portMap inbound.PortMap) *gin.Engine {
-	router := gin.Default()
-	setHandlers(portMap, router)
+// NewRouter measures requests into registry, and exposes all metrics of registry on /metrics. Requests are traced
+// by the global tracer provider, logged by the default logger, and failed requests are answered as problems.
+func NewRouter(portMap inbound.PortMap, registry *prometheus.Registry) *gin.Engine {
+	nameFieldsByJson()
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	router.Use(logRequest, recoverRequest)
+	router.NoRoute(answerUnknownRoute)
+	router.NoMethod(answerUnknownRoute)
+	setHandlers(portMap, router, newHttpMetrics(registry))
+	router.GET(metricsPath, metricsHandler(registry))
 
 	_ = router.SetTrustedProxies(nil)
 
@@ -25,44 +34,49 @@ func CreateHandlers(portMap inbound.PortMap) []handler.Handler {
 	return []handler.Handler{
 		show.NewCreateShowHandler(portMap),
 		show.NewGetShowHandler(portMap),
+		show.NewListShowsHandler(portMap),
+		show.NewUpdateShowHandler(portMap),
+		show.NewDeleteShowHandler(portMap),
+		show.NewGetShowFeedHandler(portMap),
+		show.NewGetShowBySlugHandler(portMap),
+		show.NewGetShowFeedBySlugHandler(portMap),
 		episode.NewCreateEpisodeHandler(portMap),
 		episode.NewGetEpisodeHandler(portMap),
+		episode.NewListEpisodesHandler(portMap),
+		episode.NewUpdateEpisodeHandler(portMap),
+		episode.NewDeleteEpisodeHandler(portMap),
+		episode.NewPublishEpisodeHandler(portMap),
+		episode.NewScheduleEpisodeHandler(portMap),
+		episode.NewUnpublishEpisodeHandler(portMap),
+		episode.NewUploadEpisodeMediaHandler(portMap),
+		episode.NewGetEpisodeMediaHandler(portMap),
+		analytics.NewGetAnalyticsHandler(portMap),
+		health.NewGetLivenessHandler(),
+		health.NewGetReadinessHandler(portMap),
 	}
 }
 
-func setHandlers(portMap inbound.PortMap, router *gin.Engine) {
+func setHandlers(portMap inbound.PortMap, router *gin.Engine, metrics *httpMetrics) {
 	var handlers = CreateHandlers(portMap)
 
 	for _, handlerImpl := range handlers {
 		route := handlerImpl.GetRoute()
+		trace, observe := traceRequest(route), metrics.observe(route)
 		switch route.Method {
 		case http.MethodPost:
-			router.POST(route.Path, handlerImpl.Handle, handleError)
+			router.POST(route.Path, trace, observe, handleError, handlerImpl.Handle)
 		case http.MethodGet:
-			router.GET(route.Path, handlerImpl.Handle, handleError)
+			router.GET(route.Path, trace, observe, handleError, handlerImpl.Handle)
+		case http.MethodPut:
+			router.PUT(route.Path, trace, observe, handleError, handlerImpl.Handle)
+		case http.MethodDelete:
+			router.DELETE(route.Path, trace, observe, handleError, handlerImpl.Handle)
 		}
-	}
-}
-
-func handleError(context *gin.Context) {
-	var showAlreadyExists *error2.ShowAlreadyExistsError
-	var episodeAlreadyExists *error2.EpisodeAlreadyExistsError
-	var episodeNotFound *error2.EpisodeNotFoundError
-	var showNotFound *error2.ShowNotFoundError
-
-	for _, err := range context.Errors {
-		switch {
-		case errors.As(err.Err, &showAlreadyExists):
-			context.AbortWithStatusJSON(http.StatusBadRequest, err.JSON())
-		case errors.As(err.Err, &showNotFound):
-			context.AbortWithStatusJSON(http.StatusNotFound, err.JSON())
-		case errors.As(err.Err, &episodeAlreadyExists):
-			context.AbortWithStatusJSON(http.StatusBadRequest, err.JSON())
-		case errors.As(err.Err, &episodeNotFound):
-			context.AbortWithStatusJSON(http.StatusNotFound, err.JSON())
-		default:
-			context.AbortWithStatusJSON(http.StatusInternalServerError, map[string]string{"error": "Internal Server Error"})
+		if streamHandler, streams := handlerImpl.(handler.StreamHandler); streams {
+			router.HEAD(route.Path, trace, observe, handleError, streamHandler.HandleHead)
+		}
+		if patchHandler, patches := handlerImpl.(handler.PatchHandler); patches {
+			router.PATCH(route.Path, trace, observe, handleError, patchHandler.HandlePatch)
 		}
 	}
-	context.Next()
 }
@@ -2,16 +2,37 @@ package web
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	error2 "podGopher/core/domain/error"
+	"podGopher/core/domain/model"
+	"podGopher/core/domain/service/analytics"
 	"podGopher/core/domain/service/episode"
+	"podGopher/core/domain/service/health"
 	"podGopher/core/domain/service/show"
 	"podGopher/core/port/inbound"
+	"podGopher/integration/logging"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type responseMock struct {
@@ -20,7 +41,7 @@ type responseMock struct {
 }
 
 var exampleRequests = map[string]string{
-	"postShow":    `{"Title":"some title", "Slug":"some slug"}`,
+	"postShow":    `{"Title":"some title", "Slug":"some-slug"}`,
 	"postEpisode": `{"Title":"some title"}`,
 }
 
@@ -28,33 +49,147 @@ var response responseMock
 
 type mockInboundPort struct{}
 
-func (port *mockInboundPort) CreateShow(*inbound.CreateShowCommand) (show *inbound.CreateShowResponse, err error) {
+func (port *mockInboundPort) CreateShow(context.Context, *inbound.CreateShowCommand) (show *inbound.CreateShowResponse, err error) {
 	response.Text += "CreateShow"
 	return &inbound.CreateShowResponse{Title: "CreateShow"}, response.failsWith
 }
 
-func (port *mockInboundPort) GetShow(*inbound.GetShowCommand) (show *inbound.GetShowResponse, err error) {
+func (port *mockInboundPort) GetShow(context.Context, *inbound.GetShowCommand) (show *inbound.GetShowResponse, err error) {
 	response.Text += "GetShow"
 	return &inbound.GetShowResponse{}, response.failsWith
 }
 
-func (port *mockInboundPort) CreateEpisode(*inbound.CreateEpisodeCommand) (episode *inbound.CreateEpisodeResponse, err error) {
+func (port *mockInboundPort) CreateEpisode(context.Context, *inbound.CreateEpisodeCommand) (episode *inbound.CreateEpisodeResponse, err error) {
 	response.Text += "PostEpisode"
 	return &inbound.CreateEpisodeResponse{}, response.failsWith
 }
 
-func (port *mockInboundPort) GetEpisode(*inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+func (port *mockInboundPort) GetEpisode(context.Context, *inbound.GetEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
 	response.Text += "GetEpisode"
 	return &inbound.GetEpisodeResponse{}, response.failsWith
 }
 
+func (port *mockInboundPort) GetShowBySlug(_ context.Context, command *inbound.GetShowBySlugCommand) (show *inbound.GetShowResponse, err error) {
+	response.Text += "GetShowBySlug"
+	return &inbound.GetShowResponse{Slug: command.Slug}, response.failsWith
+}
+
+func (port *mockInboundPort) GetShowFeed(_ context.Context, command *inbound.GetShowFeedCommand) (feed *inbound.GetShowFeedResponse, err error) {
+	response.Text += "GetShowFeed"
+	return &inbound.GetShowFeedResponse{Slug: command.Slug}, response.failsWith
+}
+
+func (port *mockInboundPort) UploadEpisodeMedia(context.Context, *inbound.UploadEpisodeMediaCommand) (media *inbound.UploadEpisodeMediaResponse, err error) {
+	response.Text += "UploadEpisodeMedia"
+	return &inbound.UploadEpisodeMediaResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) GetEpisodeMedia(context.Context, *inbound.GetEpisodeMediaCommand) (media *inbound.GetEpisodeMediaResponse, err error) {
+	response.Text += "GetEpisodeMedia"
+	if response.failsWith != nil {
+		return nil, response.failsWith
+	}
+	return &inbound.GetEpisodeMediaResponse{
+		FileName: "episode.mp3",
+		Media:    &model.MediaContent{Content: nopReadSeekCloser{strings.NewReader("some audio")}, MimeType: "audio/mpeg", Size: 10},
+	}, nil
+}
+
+func (port *mockInboundPort) ListShows(context.Context, *inbound.ListShowsCommand) (shows *inbound.ListShowsResponse, err error) {
+	response.Text += "ListShows"
+	return &inbound.ListShowsResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) ListEpisodes(context.Context, *inbound.ListEpisodesCommand) (episodes *inbound.ListEpisodesResponse, err error) {
+	response.Text += "ListEpisodes"
+	return &inbound.ListEpisodesResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) GetAnalytics(context.Context, *inbound.GetAnalyticsCommand) (analytics *inbound.GetAnalyticsResponse, err error) {
+	response.Text += "GetAnalytics"
+	return &inbound.GetAnalyticsResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) UpdateShow(context.Context, *inbound.UpdateShowCommand) (show *inbound.GetShowResponse, err error) {
+	response.Text += "UpdateShow"
+	return &inbound.GetShowResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) DeleteShow(context.Context, *inbound.DeleteShowCommand) error {
+	response.Text += "DeleteShow"
+	return response.failsWith
+}
+
+func (port *mockInboundPort) UpdateEpisode(context.Context, *inbound.UpdateEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	response.Text += "UpdateEpisode"
+	return &inbound.GetEpisodeResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) DeleteEpisode(context.Context, *inbound.DeleteEpisodeCommand) error {
+	response.Text += "DeleteEpisode"
+	return response.failsWith
+}
+
+func (port *mockInboundPort) PublishEpisode(context.Context, *inbound.PublishEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	response.Text += "PublishEpisode"
+	return &inbound.GetEpisodeResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) ScheduleEpisode(context.Context, *inbound.ScheduleEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	response.Text += "ScheduleEpisode"
+	return &inbound.GetEpisodeResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) UnpublishEpisode(context.Context, *inbound.UnpublishEpisodeCommand) (episode *inbound.GetEpisodeResponse, err error) {
+	response.Text += "UnpublishEpisode"
+	return &inbound.GetEpisodeResponse{}, response.failsWith
+}
+
+func (port *mockInboundPort) CheckReadiness(context.Context, *inbound.CheckReadinessCommand) (readiness *inbound.CheckReadinessResponse, err error) {
+	response.Text += "CheckReadiness"
+	return &inbound.CheckReadinessResponse{Ready: true}, response.failsWith
+}
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
+
 var mockPort = new(mockInboundPort)
+var registry = prometheus.NewRegistry()
+var spans = recordSpans()
 var router = NewRouter(inbound.PortMap{
-	inbound.CreateShow:    mockPort,
-	inbound.GetShow:       mockPort,
-	inbound.CreateEpisode: mockPort,
-	inbound.GetEpisode:    mockPort,
-})
+	inbound.CreateShow:         mockPort,
+	inbound.GetShow:            mockPort,
+	inbound.CreateEpisode:      mockPort,
+	inbound.GetEpisode:         mockPort,
+	inbound.GetShowFeed:        mockPort,
+	inbound.UploadEpisodeMedia: mockPort,
+	inbound.GetEpisodeMedia:    mockPort,
+	inbound.GetAnalytics:       mockPort,
+	inbound.ListShows:          mockPort,
+	inbound.ListEpisodes:       mockPort,
+	inbound.UpdateShow:         mockPort,
+	inbound.DeleteShow:         mockPort,
+	inbound.UpdateEpisode:      mockPort,
+	inbound.DeleteEpisode:      mockPort,
+	inbound.GetShowBySlug:      mockPort,
+	inbound.PublishEpisode:     mockPort,
+	inbound.ScheduleEpisode:    mockPort,
+	inbound.UnpublishEpisode:   mockPort,
+	inbound.CheckReadiness:     mockPort,
+}, registry)
+
+// recordSpans installs the global tracer provider once, since tracers obtained before keep the first one.
+func recordSpans() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
 
 func setup() {
 	response = responseMock{Text: "", failsWith: nil}
@@ -63,8 +198,18 @@ func setup() {
 func Test_should_return_NotFound_on_wrong_path(t *testing.T) {
 	recorder := doRequest("GET", "/", "")
 
-	assert.Equal(t, "404 page not found", recorder.Body.String())
 	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"instance":"/"}`, recorder.Body.String())
+}
+
+func Test_should_return_MethodNotAllowed_on_wrong_method(t *testing.T) {
+	recorder := doRequest("PATCH", "/show", "")
+
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "POST, GET", recorder.Header().Get("Allow"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Method Not Allowed","status":405,"instance":"/show"}`, recorder.Body.String())
 }
 
 func Test_should_post_a_show(t *testing.T) {
@@ -81,6 +226,71 @@ func Test_should_get_a_show(t *testing.T) {
 	assert.Equal(t, "GetShow", response.Text)
 }
 
+func Test_should_list_shows(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/show?sort=title&limit=10", "")
+
+	assert.Equal(t, "ListShows", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_update_a_show(t *testing.T) {
+	tests := map[string]string{
+		http.MethodPut:   `{"title":"some title", "slug":"some slug"}`,
+		http.MethodPatch: `{"locked":true}`,
+	}
+
+	for method, body := range tests {
+		t.Run(method, func(t *testing.T) {
+			setup()
+			recorder := doRequest(method, "/show/some-show-id", body)
+
+			assert.Equal(t, "UpdateShow", response.Text)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+func Test_should_delete_a_show(t *testing.T) {
+	setup()
+	recorder := doRequest("DELETE", "/show/some-show-id", "")
+
+	assert.Equal(t, "DeleteShow", response.Text)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func Test_should_get_a_show_feed(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/show/some-show-id/feed.xml", "")
+
+	assert.Equal(t, "GetShowFeed", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_get_a_show_by_slug(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/show/by-slug/some-slug", "")
+
+	assert.Equal(t, "GetShowBySlug", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_get_a_show_feed_by_slug(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/shows/some-slug/feed.xml", "")
+
+	assert.Equal(t, "GetShowFeed", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_post_a_show_without_slug(t *testing.T) {
+	setup()
+	recorder := doRequest("POST", "/show", `{"Title":"some title"}`)
+
+	assert.Equal(t, "CreateShow", response.Text)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
 func Test_should_post_an_episode(t *testing.T) {
 	setup()
 	doRequest("POST", "/show/show-id/episode", exampleRequests["postEpisode"])
@@ -95,38 +305,332 @@ func Test_should_get_an_episode(t *testing.T) {
 	assert.Equal(t, "GetEpisode", response.Text)
 }
 
+func Test_should_list_episodes(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/show/some-show-id/episode?sort=number", "")
+
+	assert.Equal(t, "ListEpisodes", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_update_an_episode(t *testing.T) {
+	tests := map[string]string{
+		http.MethodPut:   `{"title":"some title"}`,
+		http.MethodPatch: `{"chapters":null}`,
+	}
+
+	for method, body := range tests {
+		t.Run(method, func(t *testing.T) {
+			setup()
+			recorder := doRequest(method, "/show/some-show-id/episode/some-episode-id", body)
+
+			assert.Equal(t, "UpdateEpisode", response.Text)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+func Test_should_delete_an_episode(t *testing.T) {
+	setup()
+	recorder := doRequest("DELETE", "/show/some-show-id/episode/some-episode-id", "")
+
+	assert.Equal(t, "DeleteEpisode", response.Text)
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func Test_should_change_the_status_of_an_episode(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		body string
+	}{
+		"PublishEpisode":   {"/show/some-show-id/episode/some-episode-id/publish", ""},
+		"ScheduleEpisode":  {"/show/some-show-id/episode/some-episode-id/schedule", `{"publishAt":"2030-03-01T08:00:00Z"}`},
+		"UnpublishEpisode": {"/show/some-show-id/episode/some-episode-id/unpublish", ""},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			recorder := doRequest("POST", test.path, test.body)
+
+			assert.Equal(t, name, response.Text)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+func Test_should_upload_episode_media(t *testing.T) {
+	setup()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "episode.mp3")
+	_, _ = part.Write([]byte("some audio"))
+	_ = writer.Close()
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/show/some-show-id/episode/some-episode-id/media", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, "UploadEpisodeMedia", response.Text)
+}
+
+func Test_should_stream_episode_media(t *testing.T) {
+	setup()
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/media/some-episode-id/episode.mp3", nil)
+	req.Header.Set("Range", "bytes=5-")
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, "GetEpisodeMedia", response.Text)
+	assert.Equal(t, http.StatusPartialContent, recorder.Code)
+	assert.Equal(t, "audio", recorder.Body.String())
+}
+
+func Test_should_answer_head_on_episode_media(t *testing.T) {
+	setup()
+	recorder := doRequest("HEAD", "/media/some-episode-id/episode.mp3", "")
+
+	assert.Equal(t, "GetEpisodeMedia", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "10", recorder.Header().Get("Content-Length"))
+	assert.Empty(t, recorder.Body.String())
+}
+
+func Test_should_get_analytics_of_a_show(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/show/some-show-id/analytics?from=2024-05-01&to=2024-05-31", "")
+
+	assert.Equal(t, "GetAnalytics", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_answer_liveness(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/healthz", "")
+
+	assert.Empty(t, response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_check_readiness(t *testing.T) {
+	setup()
+	recorder := doRequest("GET", "/readyz", "")
+
+	assert.Equal(t, "CheckReadiness", response.Text)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func Test_should_expose_metrics_of_requests_per_route(t *testing.T) {
+	setup()
+	doRequest("GET", "/show/some-show-id", "")
+	response.failsWith = &error2.ShowNotFoundError{Id: "other-show-id"}
+	doRequest("GET", "/show/other-show-id", "")
+
+	recorder := doRequest("GET", "/metrics", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `podgopher_http_requests_total{method="GET",route="/show/:showId",status="200"}`)
+	assert.Contains(t, recorder.Body.String(), `podgopher_http_requests_total{method="GET",route="/show/:showId",status="404"}`)
+	assert.Contains(t, recorder.Body.String(), `podgopher_http_request_duration_seconds_count{method="GET",route="/show/:showId"}`)
+	assert.NotContains(t, recorder.Body.String(), "some-show-id")
+}
+
+func Test_should_continue_trace_of_request(t *testing.T) {
+	setup()
+	traceId := "4bf92f3577b34da6a3ce929d0e0e4736"
+	request := httptest.NewRequest("GET", "/show/some-show-id", nil)
+	request.Header.Set("traceparent", "00-"+traceId+"-00f067aa0ba902b7-01")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	assert.Contains(t, recorder.Header().Get("traceparent"), traceId)
+	var span sdktrace.ReadOnlySpan
+	for _, ended := range spans.Ended() {
+		if ended.SpanContext().TraceID().String() == traceId {
+			span = ended
+		}
+	}
+	assert.NotNil(t, span)
+	assert.Equal(t, "GET /show/:showId", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+}
+
+func Test_should_mark_span_of_failed_request(t *testing.T) {
+	setup()
+	response.failsWith = errors.New("some error")
+
+	recorder := doRequest("GET", "/show/failing-show-id", "")
+
+	ended := spans.Ended()
+	span := ended[len(ended)-1]
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "GET /show/:showId", span.Name())
+	assert.Equal(t, codes.Error, span.Status().Code)
+}
+
+// captureLog logs into the returned buffer as JSON until the test ends.
+func captureLog(t *testing.T) *bytes.Buffer {
+	var out bytes.Buffer
+	defaultLogger := slog.Default()
+	logger, _ := logging.NewLogger(&out, "", logging.JsonFormat)
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	return &out
+}
+
+func Test_should_log_answered_request(t *testing.T) {
+	setup()
+	out := captureLog(t)
+	response.failsWith = fmt.Errorf("loading show: %w", errors.New("some error"))
+	request := httptest.NewRequest("GET", "/show/some-show-id", nil)
+	request.Header.Set("X-Request-Id", "some-request-id")
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, request)
+
+	var record map[string]any
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &record))
+	assert.Equal(t, "some-request-id", recorder.Header().Get("X-Request-Id"))
+	assert.NotContains(t, recorder.Body.String(), "some error")
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "some-request-id", record["requestId"])
+	assert.Equal(t, "/show/:showId", record["route"])
+	assert.Equal(t, "/show/some-show-id", record["path"])
+	assert.Equal(t, float64(http.StatusInternalServerError), record["status"])
+	assert.Equal(t, []any{"loading show: some error"}, record["errors"])
+	assert.NotEmpty(t, record["traceId"])
+	assert.Contains(t, record, "latency")
+}
+
+func Test_should_generate_missing_or_overlong_request_ids(t *testing.T) {
+	for name, requestId := range map[string]string{"missing": "", "overlong": strings.Repeat("x", 129)} {
+		t.Run(name, func(t *testing.T) {
+			setup()
+			captureLog(t)
+			request := httptest.NewRequest("GET", "/show/some-show-id", nil)
+			request.Header.Set("X-Request-Id", requestId)
+			recorder := httptest.NewRecorder()
+
+			router.ServeHTTP(recorder, request)
+
+			assert.Len(t, recorder.Header().Get("X-Request-Id"), len("4bf92f35-77b3-4da6-a3ce-929d0e0e4736"))
+		})
+	}
+}
+
+func Test_should_log_panicking_request(t *testing.T) {
+	out := captureLog(t)
+	router.GET("/panic", func(*gin.Context) { panic("some panic") })
+
+	recorder := doRequest("GET", "/panic", "")
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"instance":"/panic"}`, recorder.Body.String())
+	assert.Contains(t, out.String(), `"msg":"request panicked","panic":"some panic"`)
+	assert.Contains(t, out.String(), `"msg":"request answered"`)
+}
+
 func Test_should_handle_errors(t *testing.T) {
 	setup()
 
 	tests := map[string]struct {
 		err          error
 		expectedCode int
-		expectedMsg  string
+		expectedType string
 	}{
 		"show_already_exists": {
 			error2.NewShowAlreadyExistsError("FAKE"),
-			400,
-			"FAKE",
+			409,
+			"urn:podgopher:problem:show-already-exists",
 		},
 		"Show_not_found_error": {
 			error2.NewShowNotFoundError("FAKE"),
 			404,
-			"FAKE",
+			"urn:podgopher:problem:show-not-found",
+		},
+		"Show_slug_not_found": {
+			error2.NewShowSlugNotFoundError("FAKE"),
+			404,
+			"urn:podgopher:problem:show-not-found",
+		},
+		"Invalid_slug": {
+			error2.NewInvalidSlugError("FAKE"),
+			400,
+			"urn:podgopher:problem:invalid-slug",
 		},
 		"Episode_already_exists": {
 			error2.NewEpisodeAlreadyExistsError("FAKE"),
-			400,
-			"FAKE",
+			409,
+			"urn:podgopher:problem:episode-already-exists",
 		},
 		"Episode_not_found": {
 			error2.NewEpisodeNotFoundError("FAKE"),
 			404,
-			"FAKE",
+			"urn:podgopher:problem:episode-not-found",
+		},
+		"Episode_status_change": {
+			error2.NewEpisodeStatusChangeError("FAKE", "draft", "unpublished"),
+			409,
+			"urn:podgopher:problem:episode-status-change",
+		},
+		"Invalid_publish_date": {
+			error2.NewInvalidPublishDateError(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+			400,
+			"urn:podgopher:problem:invalid-publish-date",
+		},
+		"Unsupported_media_type": {
+			error2.NewUnsupportedMediaTypeError("FAKE"),
+			415,
+			"urn:podgopher:problem:unsupported-media-type",
+		},
+		"Media_not_found": {
+			error2.NewMediaNotFoundError("FAKE"),
+			404,
+			"urn:podgopher:problem:media-not-found",
+		},
+		"Invalid_media": {
+			error2.NewInvalidMediaError("FAKE", "audio/mpeg"),
+			422,
+			"urn:podgopher:problem:invalid-media",
+		},
+		"Invalid_cursor": {
+			error2.NewInvalidCursorError("FAKE"),
+			400,
+			"urn:podgopher:problem:invalid-cursor",
+		},
+		"Repository_connection_failure": {
+			error2.NewRepositoryError(error2.ConnectionFailure, errors.New("FAKE")),
+			503,
+			"urn:podgopher:problem:repository-unavailable",
+		},
+		"Repository_constraint_violation": {
+			error2.NewRepositoryError(error2.ConstraintViolation, errors.New("FAKE")),
+			409,
+			"urn:podgopher:problem:repository-conflict",
+		},
+		"Repository_not_found": {
+			error2.NewRepositoryError(error2.NotFound, errors.New("FAKE")),
+			404,
+			"urn:podgopher:problem:repository-not-found",
+		},
+		"Repository_serialization_failure": {
+			error2.NewRepositoryError(error2.SerializationFailure, errors.New("FAKE")),
+			409,
+			"urn:podgopher:problem:concurrent-change",
+		},
+		"Wrapped_repository_error": {
+			fmt.Errorf("saving: %w", error2.NewRepositoryError(error2.ConnectionFailure, errors.New("FAKE"))),
+			503,
+			"urn:podgopher:problem:repository-unavailable",
 		},
 		"unknown": {
 			errors.New("FAKE"),
 			500,
-			"Internal Server Error",
+			"about:blank",
 		},
 	}
 
@@ -135,25 +639,138 @@ func Test_should_handle_errors(t *testing.T) {
 			response.failsWith = test.err
 
 			recorder := doRequest("POST", "/show", exampleRequests["postShow"])
+			var problem map[string]any
+			_ = json.Unmarshal(recorder.Body.Bytes(), &problem)
 
 			assert.Equal(t, test.expectedCode, recorder.Code)
-			assert.Contains(t, recorder.Body.String(), test.expectedMsg)
+			assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+			assert.Equal(t, test.expectedType, problem["type"])
+			assert.Equal(t, float64(test.expectedCode), problem["status"])
+			assert.Equal(t, "/show", problem["instance"])
+		})
+	}
+}
+
+func Test_should_not_expose_details_of_repository_errors(t *testing.T) {
+	setup()
+	response.failsWith = error2.NewRepositoryError(error2.ConnectionFailure, errors.New("dial tcp 10.0.0.1:5432: connection refused"))
+
+	recorder := doRequest("POST", "/show", exampleRequests["postShow"])
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), "5432")
+}
+
+func Test_should_detail_problem_of_domain_error(t *testing.T) {
+	setup()
+	response.failsWith = fmt.Errorf("saving show: %w", error2.NewShowAlreadyExistsError("Show Title"))
+
+	recorder := doRequest("POST", "/show", exampleRequests["postShow"])
+
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.JSONEq(t, `{
+		"type": "urn:podgopher:problem:show-already-exists",
+		"title": "Show already exists",
+		"status": 409,
+		"detail": "saving show: show with title 'Show Title' or given slug already exists",
+		"instance": "/show"
+	}`, recorder.Body.String())
+}
+
+func Test_should_answer_invalid_fields_as_problem(t *testing.T) {
+	setup()
+
+	recorder := doRequest("POST", "/show", `{"persons": [{"role": "host"}]}`)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "urn:podgopher:problem:invalid-request",
+		"title": "Request is invalid",
+		"status": 400,
+		"detail": "request has invalid fields",
+		"instance": "/show",
+		"errors": [
+			{"field": "title", "detail": "is required"},
+			{"field": "persons[0].name", "detail": "is required"}
+		]
+	}`, recorder.Body.String())
+}
+
+func Test_should_answer_malformed_requests_as_problem(t *testing.T) {
+	setup()
+
+	tests := map[string]struct {
+		method         string
+		url            string
+		body           string
+		expectedDetail string
+		expectedErrors any
+	}{
+		"missing_body": {
+			"POST", "/show", "",
+			"request body is missing",
+			nil,
+		},
+		"malformed_body": {
+			"POST", "/show", "{",
+			"unexpected EOF",
+			nil,
+		},
+		"field_of_wrong_type": {
+			"POST", "/show/show-id/episode", `{"title": "Episode", "season": "one"}`,
+			"request has invalid fields",
+			[]any{map[string]any{"field": "season", "detail": "must be of type int"}},
+		},
+		"invalid_query": {
+			"GET", "/show?limit=many", "",
+			`strconv.ParseInt: parsing "many": invalid syntax`,
+			nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := doRequest(test.method, test.url, test.body)
+			var problem map[string]any
+			_ = json.Unmarshal(recorder.Body.Bytes(), &problem)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, "urn:podgopher:problem:invalid-request", problem["type"])
+			assert.Equal(t, test.expectedDetail, problem["detail"])
+			assert.Equal(t, test.expectedErrors, problem["errors"])
 		})
 	}
 }
 
 func Test_should_create_handlers(t *testing.T) {
 	portMap := inbound.PortMap{
-		inbound.CreateShow:    show.NewCreateShowService(nil),
-		inbound.GetShow:       show.NewGetShowService(nil),
-		inbound.CreateEpisode: episode.NewCreateEpisodeService(nil, nil),
-		inbound.GetEpisode:    episode.NewGetEpisodeService(nil, nil),
+		inbound.CreateShow:         show.NewCreateShowService(nil, nil),
+		inbound.GetShow:            show.NewGetShowService(nil),
+		inbound.CreateEpisode:      episode.NewCreateEpisodeService(nil, nil),
+		inbound.GetEpisode:         episode.NewGetEpisodeService(nil, nil),
+		inbound.GetShowFeed:        show.NewGetShowFeedService(nil, nil, nil),
+		inbound.UploadEpisodeMedia: episode.NewUploadEpisodeMediaService(nil, nil, nil, nil),
+		inbound.GetEpisodeMedia:    episode.NewGetEpisodeMediaService(nil, nil, nil, nil),
+		inbound.GetAnalytics:       analytics.NewGetAnalyticsService(nil, nil, nil),
+		inbound.ListShows:          show.NewListShowsService(nil),
+		inbound.ListEpisodes:       episode.NewListEpisodesService(nil, nil),
+		inbound.UpdateShow:         show.NewUpdateShowService(nil, nil, nil),
+		inbound.DeleteShow:         show.NewDeleteShowService(nil, nil, nil, nil),
+		inbound.UpdateEpisode:      episode.NewUpdateEpisodeService(nil, nil, nil),
+		inbound.DeleteEpisode:      episode.NewDeleteEpisodeService(nil, nil, nil, nil),
+		inbound.GetShowBySlug:      show.NewGetShowBySlugService(nil),
+		inbound.PublishEpisode:     episode.NewPublishEpisodeService(nil, nil, nil),
+		inbound.ScheduleEpisode:    episode.NewScheduleEpisodeService(nil, nil, nil),
+		inbound.UnpublishEpisode:   episode.NewUnpublishEpisodeService(nil, nil, nil),
+		inbound.CheckReadiness:     health.NewCheckReadinessService(0),
 	}
 
 	var handlers = CreateHandlers(portMap)
 
 	assert.NotEmpty(t, handlers)
-	assert.Len(t, handlers, len(portMap))
+	// feeds are served by id and by slug, liveness is answered without a port
+	assert.Len(t, handlers, len(portMap)+2)
 }
 
 func doRequest(method string, url string, requestBody string) *httptest.ResponseRecorder {
@@ -0,0 +1,276 @@
+package rss
+
+import (
+	"encoding/xml"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"podGopher/integration/web/handler"
+	"time"
+)
+
+const (
+	ContentType      = "application/rss+xml; charset=utf-8"
+	itunesNamespace  = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+	podcastNamespace = "https://podcastindex.org/namespace/1.0"
+	atomNamespace    = "http://www.w3.org/2005/Atom"
+)
+
+type rssDto struct {
+	XMLName      xml.Name   `xml:"rss"`
+	Version      string     `xml:"version,attr"`
+	ItunesXmlns  string     `xml:"xmlns:itunes,attr"`
+	PodcastXmlns string     `xml:"xmlns:podcast,attr"`
+	AtomXmlns    string     `xml:"xmlns:atom,attr"`
+	Channel      channelDto `xml:"channel"`
+}
+
+type channelDto struct {
+	Title          string       `xml:"title"`
+	Link           string       `xml:"link"`
+	Description    string       `xml:"description"`
+	Language       string       `xml:"language,omitempty"`
+	AtomLink       atomLinkDto  `xml:"atom:link"`
+	ItunesTitle    string       `xml:"itunes:title"`
+	ItunesAuthor   string       `xml:"itunes:author,omitempty"`
+	ItunesImage    *imageDto    `xml:"itunes:image"`
+	ItunesCategory *categoryDto `xml:"itunes:category"`
+	ItunesType     string       `xml:"itunes:type"`
+	ItunesExplicit string       `xml:"itunes:explicit"`
+	PodcastGuid    string       `xml:"podcast:guid"`
+	PodcastLocked  string       `xml:"podcast:locked"`
+	PodcastFunding []fundingDto `xml:"podcast:funding"`
+	PodcastPersons []personDto  `xml:"podcast:person"`
+	Items          []itemDto    `xml:"item"`
+}
+
+type atomLinkDto struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type itemDto struct {
+	Title              string          `xml:"title"`
+	Description        string          `xml:"description,omitempty"`
+	Enclosure          *enclosureDto   `xml:"enclosure"`
+	Guid               guidDto         `xml:"guid"`
+	PubDate            string          `xml:"pubDate,omitempty"`
+	ItunesTitle        string          `xml:"itunes:title"`
+	ItunesEpisodeType  string          `xml:"itunes:episodeType"`
+	ItunesDuration     int64           `xml:"itunes:duration,omitempty"`
+	ItunesImage        *imageDto       `xml:"itunes:image"`
+	ItunesSeason       int             `xml:"itunes:season,omitempty"`
+	ItunesEpisode      int             `xml:"itunes:episode,omitempty"`
+	PodcastSeason      int             `xml:"podcast:season,omitempty"`
+	PodcastEpisode     int             `xml:"podcast:episode,omitempty"`
+	PodcastTranscripts []transcriptDto `xml:"podcast:transcript"`
+	PodcastChapters    *chaptersDto    `xml:"podcast:chapters"`
+	PodcastPersons     []personDto     `xml:"podcast:person"`
+}
+
+type enclosureDto struct {
+	Url    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type imageDto struct {
+	Href string `xml:"href,attr"`
+}
+
+type categoryDto struct {
+	Text        string       `xml:"text,attr"`
+	Subcategory *categoryDto `xml:"itunes:category"`
+}
+
+type guidDto struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+type fundingDto struct {
+	Url  string `xml:"url,attr"`
+	Text string `xml:",chardata"`
+}
+
+type personDto struct {
+	Name  string `xml:",chardata"`
+	Role  string `xml:"role,attr,omitempty"`
+	Group string `xml:"group,attr,omitempty"`
+	Img   string `xml:"img,attr,omitempty"`
+	Href  string `xml:"href,attr,omitempty"`
+}
+
+type transcriptDto struct {
+	Url      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr,omitempty"`
+	Rel      string `xml:"rel,attr,omitempty"`
+}
+
+type chaptersDto struct {
+	Url  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// Render creates an RSS 2.0 document with itunes and podcast namespace tags of the given feed.
+// All links of the document are resolved against the baseUrl.
+func Render(feed *inbound.GetShowFeedResponse, baseUrl string) ([]byte, error) {
+	document := rssDto{
+		Version:      "2.0",
+		ItunesXmlns:  itunesNamespace,
+		PodcastXmlns: podcastNamespace,
+		AtomXmlns:    atomNamespace,
+		Channel: channelDto{
+			Title:          feed.Title,
+			Link:           handler.ShowUrl(baseUrl, feed.Id),
+			Description:    descriptionToDto(feed),
+			Language:       feed.Language,
+			AtomLink:       atomLinkDto{Href: handler.SlugFeedUrl(baseUrl, feed.Slug), Rel: "self", Type: "application/rss+xml"},
+			ItunesTitle:    feed.Title,
+			ItunesAuthor:   feed.Author,
+			ItunesImage:    imageToDto(feed.ImageUrl),
+			ItunesCategory: categoryToDto(feed.Category, feed.Subcategory),
+			ItunesType:     "episodic",
+			ItunesExplicit: explicitToDto(feed.Explicit),
+			PodcastGuid:    feed.Guid,
+			PodcastLocked:  lockedToDto(feed.Locked),
+			PodcastFunding: fundingToDto(feed.Funding),
+			PodcastPersons: personsToDto(feed.Persons),
+			Items:          itemsToDto(feed.Episodes, baseUrl),
+		},
+	}
+
+	body, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// descriptionToDto falls back to the title, as RSS requires a description of the channel.
+func descriptionToDto(feed *inbound.GetShowFeedResponse) string {
+	if feed.Description == "" {
+		return feed.Title
+	}
+	return feed.Description
+}
+
+func imageToDto(imageUrl string) *imageDto {
+	if imageUrl == "" {
+		return nil
+	}
+	return &imageDto{Href: imageUrl}
+}
+
+// categoryToDto nests the subcategory in the category, a subcategory without category is left out.
+func categoryToDto(category string, subcategory string) *categoryDto {
+	if category == "" {
+		return nil
+	}
+	result := &categoryDto{Text: category}
+	if subcategory != "" {
+		result.Subcategory = &categoryDto{Text: subcategory}
+	}
+	return result
+}
+
+func explicitToDto(explicit bool) string {
+	if explicit {
+		return "true"
+	}
+	return "false"
+}
+
+func itemsToDto(episodes []*inbound.FeedEpisode, baseUrl string) []itemDto {
+	var items []itemDto
+	for _, episode := range episodes {
+		items = append(items, itemDto{
+			Title:              episode.Title,
+			Description:        episode.Description,
+			Enclosure:          enclosureToDto(episode.Media, baseUrl),
+			Guid:               guidDto{Value: episode.Id, IsPermaLink: false},
+			PubDate:            pubDateToDto(episode.PublishedAt),
+			ItunesTitle:        episode.Title,
+			ItunesEpisodeType:  "full",
+			ItunesDuration:     durationToDto(episode.Media),
+			ItunesImage:        artworkToDto(episode.Media, baseUrl),
+			ItunesSeason:       episode.Season,
+			ItunesEpisode:      episode.EpisodeNumber,
+			PodcastSeason:      episode.Season,
+			PodcastEpisode:     episode.EpisodeNumber,
+			PodcastTranscripts: transcriptsToDto(episode.Transcripts),
+			PodcastChapters:    chaptersToDto(episode.Chapters),
+			PodcastPersons:     personsToDto(episode.Persons),
+		})
+	}
+	return items
+}
+
+// pubDateToDto formats the publish date as RFC 822 date, as RSS requires, with a four digit year.
+func pubDateToDto(publishedAt time.Time) string {
+	if publishedAt.IsZero() {
+		return ""
+	}
+	return publishedAt.UTC().Format(time.RFC1123Z)
+}
+
+func enclosureToDto(media *model.Media, baseUrl string) *enclosureDto {
+	if media == nil {
+		return nil
+	}
+	return &enclosureDto{Url: handler.MediaUrl(baseUrl, media.Key), Length: media.Size, Type: media.MimeType}
+}
+
+// durationToDto returns the duration in whole seconds, as recommended for itunes:duration
+func durationToDto(media *model.Media) int64 {
+	if media == nil {
+		return 0
+	}
+	return int64(media.Duration.Round(time.Second).Seconds())
+}
+
+func artworkToDto(media *model.Media, baseUrl string) *imageDto {
+	if media == nil || media.Artwork == nil {
+		return nil
+	}
+	return &imageDto{Href: handler.MediaUrl(baseUrl, media.Artwork.Key)}
+}
+
+func lockedToDto(locked bool) string {
+	if locked {
+		return "yes"
+	}
+	return "no"
+}
+
+func fundingToDto(funding []model.Funding) []fundingDto {
+	var result []fundingDto
+	for _, entry := range funding {
+		result = append(result, fundingDto(entry))
+	}
+	return result
+}
+
+func personsToDto(persons []model.Person) []personDto {
+	var result []personDto
+	for _, person := range persons {
+		result = append(result, personDto(person))
+	}
+	return result
+}
+
+func transcriptsToDto(transcripts []model.Transcript) []transcriptDto {
+	var result []transcriptDto
+	for _, transcript := range transcripts {
+		result = append(result, transcriptDto(transcript))
+	}
+	return result
+}
+
+func chaptersToDto(chapters *model.Chapters) *chaptersDto {
+	if chapters == nil {
+		return nil
+	}
+	return &chaptersDto{Url: chapters.Url, Type: chapters.Type}
+}
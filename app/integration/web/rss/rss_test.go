@@ -0,0 +1,229 @@
+package rss
+
+import (
+	"encoding/xml"
+	"podGopher/core/domain/model"
+	"podGopher/core/port/inbound"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var exampleFeed = &inbound.GetShowFeedResponse{
+	Id:    "some-show-id",
+	Title: "Some <Show>",
+	Slug:  "some-show",
+	Episodes: []*inbound.FeedEpisode{
+		{Id: "first-episode-id", Title: "first episode"},
+		{Id: "second-episode-id", Title: "second episode"},
+	},
+}
+
+func Test_should_render_valid_xml(t *testing.T) {
+	body, err := Render(exampleFeed, "http://localhost")
+
+	assert.Nil(t, err)
+	assert.Nil(t, xml.Unmarshal(body, new(interface{})))
+	assert.Contains(t, string(body), `<?xml version="1.0" encoding="UTF-8"?>`)
+}
+
+func Test_should_render_channel(t *testing.T) {
+	body, _ := Render(exampleFeed, "http://localhost")
+	document := string(body)
+
+	expectedParts := []string{
+		`<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:podcast="https://podcastindex.org/namespace/1.0" xmlns:atom="http://www.w3.org/2005/Atom">`,
+		`<title>Some &lt;Show&gt;</title>`,
+		`<link>http://localhost/show/some-show-id</link>`,
+		`<description>Some &lt;Show&gt;</description>`,
+		`<atom:link href="http://localhost/shows/some-show/feed.xml" rel="self" type="application/rss+xml"></atom:link>`,
+		`<itunes:type>episodic</itunes:type>`,
+		`<itunes:explicit>false</itunes:explicit>`,
+	}
+
+	for _, part := range expectedParts {
+		assert.Contains(t, document, part)
+	}
+}
+
+func Test_should_render_channel_details(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{
+		Id:          "some-show-id",
+		Title:       "Some Show",
+		Description: "all about <things>",
+		Language:    "en-US",
+		Author:      "Some Author",
+		ImageUrl:    "https://example.com/cover.jpg",
+		Category:    "Technology",
+		Subcategory: "Tech News",
+		Explicit:    true,
+	}
+
+	body, _ := Render(feed, "")
+	document := string(body)
+
+	assert.Contains(t, document, `<description>all about &lt;things&gt;</description>`)
+	assert.Contains(t, document, `<language>en-US</language>`)
+	assert.Contains(t, document, `<itunes:author>Some Author</itunes:author>`)
+	assert.Contains(t, document, `<itunes:image href="https://example.com/cover.jpg"></itunes:image>`)
+	assert.Contains(t, document, `<itunes:category text="Technology">`)
+	assert.Contains(t, document, `  <itunes:category text="Tech News"></itunes:category>`)
+	assert.Contains(t, document, `<itunes:explicit>true</itunes:explicit>`)
+}
+
+func Test_should_leave_out_missing_channel_details(t *testing.T) {
+	body, _ := Render(&inbound.GetShowFeedResponse{Id: "some-show-id", Subcategory: "Tech News"}, "")
+
+	assert.NotContains(t, string(body), "<language>")
+	assert.NotContains(t, string(body), "<itunes:author>")
+	assert.NotContains(t, string(body), "<itunes:image")
+	assert.NotContains(t, string(body), "<itunes:category")
+}
+
+func Test_should_render_items(t *testing.T) {
+	body, _ := Render(exampleFeed, "")
+	document := string(body)
+
+	assert.Contains(t, document, `<title>first episode</title>`)
+	assert.Contains(t, document, `<guid isPermaLink="false">first-episode-id</guid>`)
+	assert.Contains(t, document, `<itunes:title>second episode</itunes:title>`)
+	assert.Contains(t, document, `<guid isPermaLink="false">second-episode-id</guid>`)
+	assert.Contains(t, document, `<itunes:episodeType>full</itunes:episodeType>`)
+}
+
+func Test_should_render_description_and_publish_date_of_items(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{Id: "some-show-id", Episodes: []*inbound.FeedEpisode{{
+		Id:          "some-episode-id",
+		Title:       "some episode",
+		Description: "some <notes>",
+		PublishedAt: time.Date(2024, 5, 1, 12, 30, 15, 0, time.FixedZone("CEST", 2*60*60)),
+	}}}
+
+	body, _ := Render(feed, "")
+	document := string(body)
+
+	assert.Contains(t, document, `<description>some &lt;notes&gt;</description>`)
+	assert.Contains(t, document, `<pubDate>Wed, 01 May 2024 10:30:15 +0000</pubDate>`)
+}
+
+func Test_should_render_items_without_description_and_publish_date(t *testing.T) {
+	body, _ := Render(exampleFeed, "")
+	items := string(body)[strings.Index(string(body), "<item>"):]
+
+	assert.NotContains(t, items, "<description>")
+	assert.NotContains(t, items, "<pubDate>")
+}
+
+func Test_should_render_feed_without_items(t *testing.T) {
+	body, err := Render(&inbound.GetShowFeedResponse{Id: "some-show-id", Title: "Some Show"}, "")
+
+	assert.Nil(t, err)
+	assert.NotContains(t, string(body), "<item>")
+}
+
+func Test_should_render_podcast_namespace_of_channel(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{
+		Id:      "some-show-id",
+		Title:   "Some Show",
+		Guid:    "9b024349-ccf0-5f69-a609-6b82873eab3c",
+		Locked:  true,
+		Funding: []model.Funding{{Url: "https://example.com/donate", Text: "Support us"}},
+		Persons: []model.Person{{Name: "Some Host", Role: "host", Href: "https://example.com"}},
+	}
+
+	body, _ := Render(feed, "")
+	document := string(body)
+
+	assert.Contains(t, document, `<podcast:guid>9b024349-ccf0-5f69-a609-6b82873eab3c</podcast:guid>`)
+	assert.Contains(t, document, `<podcast:locked>yes</podcast:locked>`)
+	assert.Contains(t, document, `<podcast:funding url="https://example.com/donate">Support us</podcast:funding>`)
+	assert.Contains(t, document, `<podcast:person role="host" href="https://example.com">Some Host</podcast:person>`)
+}
+
+func Test_should_render_unlocked_channel(t *testing.T) {
+	body, _ := Render(&inbound.GetShowFeedResponse{Id: "some-show-id"}, "")
+
+	assert.Contains(t, string(body), `<podcast:locked>no</podcast:locked>`)
+	assert.NotContains(t, string(body), `<podcast:funding`)
+	assert.NotContains(t, string(body), `<podcast:person`)
+}
+
+func Test_should_render_podcast_namespace_of_items(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{
+		Id: "some-show-id",
+		Episodes: []*inbound.FeedEpisode{
+			{
+				Id:            "some-episode-id",
+				Title:         "some episode",
+				Season:        2,
+				EpisodeNumber: 3,
+				Transcripts:   []model.Transcript{{Url: "https://example.com/transcript.vtt", Type: "text/vtt", Language: "en"}},
+				Chapters:      &model.Chapters{Url: "https://example.com/chapters.json", Type: "application/json+chapters"},
+				Persons:       []model.Person{{Name: "Some Guest", Role: "guest"}},
+			},
+			{Id: "other-episode-id", Title: "other episode"},
+		},
+	}
+
+	body, _ := Render(feed, "")
+	document := string(body)
+
+	assert.Contains(t, document, `<itunes:season>2</itunes:season>`)
+	assert.Contains(t, document, `<itunes:episode>3</itunes:episode>`)
+	assert.Contains(t, document, `<podcast:season>2</podcast:season>`)
+	assert.Contains(t, document, `<podcast:episode>3</podcast:episode>`)
+	assert.Contains(t, document, `<podcast:transcript url="https://example.com/transcript.vtt" type="text/vtt" language="en"></podcast:transcript>`)
+	assert.Contains(t, document, `<podcast:chapters url="https://example.com/chapters.json" type="application/json+chapters"></podcast:chapters>`)
+	assert.Contains(t, document, `<podcast:person role="guest">Some Guest</podcast:person>`)
+	assert.Equal(t, 1, strings.Count(document, `<podcast:season>`))
+	assert.Equal(t, 1, strings.Count(document, `<podcast:chapters`))
+}
+
+func Test_should_render_enclosure_of_items_with_media(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{
+		Id: "some-show-id",
+		Episodes: []*inbound.FeedEpisode{
+			{
+				Id:    "some-episode-id",
+				Title: "some episode",
+				Media: &model.Media{Key: "some-episode-id/episode.mp3", FileName: "episode.mp3", MimeType: "audio/mpeg", Size: 1024},
+			},
+			{Id: "other-episode-id", Title: "other episode"},
+		},
+	}
+
+	body, _ := Render(feed, "http://localhost")
+	document := string(body)
+
+	assert.Contains(t, document, `<enclosure url="http://localhost/media/some-episode-id/episode.mp3" length="1024" type="audio/mpeg"></enclosure>`)
+	assert.Equal(t, 1, strings.Count(document, `<enclosure`))
+}
+
+func Test_should_render_duration_and_artwork_of_analyzed_media(t *testing.T) {
+	feed := &inbound.GetShowFeedResponse{
+		Id: "some-show-id",
+		Episodes: []*inbound.FeedEpisode{
+			{
+				Id:    "some-episode-id",
+				Title: "some episode",
+				Media: &model.Media{
+					Key:      "some-episode-id/episode.mp3",
+					MimeType: "audio/mpeg",
+					Duration: 3725*time.Second + 600*time.Millisecond,
+					Artwork:  &model.Artwork{Key: "some-episode-id/artwork.png", MimeType: "image/png"},
+				},
+			},
+			{Id: "other-episode-id", Title: "other episode"},
+		},
+	}
+
+	body, _ := Render(feed, "http://localhost")
+	document := string(body)
+
+	assert.Contains(t, document, `<itunes:duration>3726</itunes:duration>`)
+	assert.Contains(t, document, `<itunes:image href="http://localhost/media/some-episode-id/artwork.png"></itunes:image>`)
+	assert.Equal(t, 1, strings.Count(document, `<itunes:duration>`))
+	assert.Equal(t, 1, strings.Count(document, `<itunes:image`))
+}
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+	"podGopher/integration/web/handler"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("podGopher/integration/web")
+
+// traceRequest spans the handlers of a route which follow it. The span continues the trace of the client, and
+// the traceparent header of the response names the trace, so a failed request can be looked up.
+func traceRequest(route *handler.Route) gin.HandlerFunc {
+	return func(context *gin.Context) {
+		propagator := otel.GetTextMapPropagator()
+		ctx := propagator.Extract(context.Request.Context(), propagation.HeaderCarrier(context.Request.Header))
+		ctx, span := tracer.Start(ctx, context.Request.Method+" "+route.Path,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(context.Request.Method),
+				semconv.HTTPRoute(route.Path),
+				semconv.URLPath(context.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		propagator.Inject(ctx, propagation.HeaderCarrier(context.Writer.Header()))
+		context.Request = context.Request.WithContext(ctx)
+		context.Next()
+
+		status := context.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
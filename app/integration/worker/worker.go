@@ -0,0 +1,69 @@
+// Package worker processes the queued jobs in the background, independent of any request.
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"podGopher/core/port/inbound"
+	"sync"
+	"time"
+)
+
+type Worker struct {
+	port         inbound.ProcessJobPort
+	concurrency  int
+	pollInterval time.Duration
+	running      sync.WaitGroup
+}
+
+// NewWorker processes up to concurrency jobs at a time. Workers without a due job look again after pollInterval.
+func NewWorker(portMap inbound.PortMap, concurrency int, pollInterval time.Duration) *Worker {
+	return &Worker{
+		port:         portMap[inbound.ProcessJob].(inbound.ProcessJobPort),
+		concurrency:  concurrency,
+		pollInterval: pollInterval,
+	}
+}
+
+// Start processes jobs until the context is done. Jobs which run at that moment are finished, Drain waits for them.
+func (w *Worker) Start(ctx context.Context) {
+	for range w.concurrency {
+		w.running.Go(func() {
+			w.run(ctx)
+		})
+	}
+}
+
+// Drain waits until the workers finished their jobs after the context of Start is done.
+func (w *Worker) Drain() {
+	w.running.Wait()
+}
+
+func (w *Worker) run(ctx context.Context) {
+	// a job is not cancelled halfway, since its lease would keep it from running again for a while
+	jobCtx := context.WithoutCancel(ctx)
+	for ctx.Err() == nil {
+		if w.processNextJob(jobCtx) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+// processNextJob tells whether a job was processed, so the next one is processed without waiting.
+func (w *Worker) processNextJob(ctx context.Context) bool {
+	response, err := w.port.ProcessNextJob(ctx, &inbound.ProcessJobCommand{Now: time.Now().UTC()})
+	switch {
+	case err != nil:
+		slog.WarnContext(ctx, "processing jobs failed", "error", err)
+		return false
+	case response.Dead:
+		slog.ErrorContext(ctx, "job failed for good", "jobId", response.JobId, "type", response.Type, "error", response.Failure)
+	case response.Failure != "":
+		slog.WarnContext(ctx, "job failed and is retried", "jobId", response.JobId, "type", response.Type, "error", response.Failure)
+	}
+	return response.JobId != ""
+}
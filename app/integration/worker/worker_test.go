@@ -0,0 +1,165 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"podGopher/core/port/inbound"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type processJobTestService struct {
+	mutex     sync.Mutex
+	called    int
+	pending   int
+	running   chan struct{}
+	release   chan struct{}
+	failsWith error
+	cancelled bool
+}
+
+func (s *processJobTestService) RegisterJobHandler(string, inbound.JobHandler) {}
+
+func (s *processJobTestService) ProcessNextJob(ctx context.Context, _ *inbound.ProcessJobCommand) (*inbound.ProcessJobResponse, error) {
+	s.mutex.Lock()
+	s.called++
+	if s.failsWith != nil || s.pending == 0 {
+		s.mutex.Unlock()
+		return &inbound.ProcessJobResponse{}, s.failsWith
+	}
+	s.pending--
+	s.mutex.Unlock()
+
+	if s.running != nil {
+		s.running <- struct{}{}
+		<-s.release
+		s.mutex.Lock()
+		s.cancelled = s.cancelled || ctx.Err() != nil
+		s.mutex.Unlock()
+	}
+	return &inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type"}, nil
+}
+
+func (s *processJobTestService) state() (called int, pending int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.called, s.pending
+}
+
+func Test_should_panic_if_no_port_was_found_on_worker(t *testing.T) {
+	invalidPortMap := inbound.PortMap{
+		inbound.PortInvalid: new(processJobTestService),
+	}
+
+	assert.Panics(t, func() {
+		NewWorker(invalidPortMap, 1, time.Millisecond)
+	})
+}
+
+func Test_should_process_due_jobs_without_waiting(t *testing.T) {
+	service := &processJobTestService{pending: 3}
+	worker := NewWorker(inbound.PortMap{inbound.ProcessJob: service}, 1, time.Hour)
+	ctx, cancel := context.WithCancel(t.Context())
+
+	worker.Start(ctx)
+
+	assert.Eventually(t, func() bool {
+		_, pending := service.state()
+		return pending == 0
+	}, time.Second, time.Millisecond)
+	cancel()
+	worker.Drain()
+	called, _ := service.state()
+	assert.Equal(t, 4, called)
+}
+
+func Test_should_keep_polling_if_processing_fails(t *testing.T) {
+	service := &processJobTestService{failsWith: errors.New("some error")}
+	worker := NewWorker(inbound.PortMap{inbound.ProcessJob: service}, 1, time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer worker.Drain()
+	defer cancel()
+
+	worker.Start(ctx)
+
+	assert.Eventually(t, func() bool {
+		called, _ := service.state()
+		return called >= 2
+	}, time.Second, time.Millisecond)
+}
+
+func Test_should_finish_running_jobs_on_drain(t *testing.T) {
+	service := &processJobTestService{pending: 2, running: make(chan struct{}), release: make(chan struct{})}
+	worker := NewWorker(inbound.PortMap{inbound.ProcessJob: service}, 2, time.Hour)
+	ctx, cancel := context.WithCancel(t.Context())
+	worker.Start(ctx)
+	<-service.running
+	<-service.running
+
+	cancel()
+	drained := make(chan struct{})
+	go func() {
+		worker.Drain()
+		close(drained)
+	}()
+
+	assert.Never(t, func() bool {
+		select {
+		case <-drained:
+			return true
+		default:
+			return false
+		}
+	}, 20*time.Millisecond, time.Millisecond)
+	close(service.release)
+	<-drained
+	called, pending := service.state()
+	assert.Equal(t, 2, called)
+	assert.Equal(t, 0, pending)
+	assert.False(t, service.cancelled)
+}
+
+func Test_should_drain_without_start(t *testing.T) {
+	worker := NewWorker(inbound.PortMap{inbound.ProcessJob: new(processJobTestService)}, 1, time.Hour)
+
+	worker.Drain()
+}
+
+type failedJobTestService struct {
+	processJobTestService
+	response *inbound.ProcessJobResponse
+}
+
+func (s *failedJobTestService) ProcessNextJob(context.Context, *inbound.ProcessJobCommand) (*inbound.ProcessJobResponse, error) {
+	return s.response, nil
+}
+
+func Test_should_log_failed_jobs(t *testing.T) {
+	tests := map[string]struct {
+		response *inbound.ProcessJobResponse
+		expected string
+	}{
+		"retried": {&inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type", Failure: "some error"},
+			`level=WARN msg="job failed and is retried" jobId=some-job-id type=some-type error="some error"`},
+		"dead": {&inbound.ProcessJobResponse{JobId: "some-job-id", Type: "some-type", Failure: "some error", Dead: true},
+			`level=ERROR msg="job failed for good" jobId=some-job-id type=some-type error="some error"`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			defaultLogger := slog.Default()
+			slog.SetDefault(slog.New(slog.NewTextHandler(&out, nil)))
+			defer slog.SetDefault(defaultLogger)
+			worker := NewWorker(inbound.PortMap{inbound.ProcessJob: &failedJobTestService{response: test.response}}, 1, time.Hour)
+
+			assert.True(t, worker.processNextJob(t.Context()))
+			assert.Contains(t, out.String(), test.expected)
+		})
+	}
+}
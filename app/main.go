@@ -3,99 +3,489 @@ package main
 import (
 	"context"
 	"database/sql"
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"podGopher/adapter/outbound/repository"
+	"podGopher/adapter/outbound/repository/memory"
+	"podGopher/adapter/outbound/repository/metrics"
+	"podGopher/adapter/outbound/repository/postgres"
+	repositoryDownload "podGopher/adapter/outbound/repository/postgres/download"
 	repositoryEpisode "podGopher/adapter/outbound/repository/postgres/episode"
+	repositoryJob "podGopher/adapter/outbound/repository/postgres/job"
 	"podGopher/adapter/outbound/repository/postgres/migration"
 	repositoryShow "podGopher/adapter/outbound/repository/postgres/show"
+	"podGopher/adapter/outbound/repository/sqlite"
+	sqliteDownload "podGopher/adapter/outbound/repository/sqlite/download"
+	sqliteEpisode "podGopher/adapter/outbound/repository/sqlite/episode"
+	sqliteJob "podGopher/adapter/outbound/repository/sqlite/job"
+	sqliteMigration "podGopher/adapter/outbound/repository/sqlite/migration"
+	sqliteShow "podGopher/adapter/outbound/repository/sqlite/show"
+	"podGopher/adapter/outbound/storage/file"
+	"podGopher/core/domain/service/analytics"
 	"podGopher/core/domain/service/episode"
+	"podGopher/core/domain/service/health"
+	"podGopher/core/domain/service/job"
 	"podGopher/core/domain/service/show"
 	"podGopher/core/port/inbound"
 	"podGopher/env"
+	"podGopher/integration/logging"
+	"podGopher/integration/scheduler"
+	"podGopher/integration/tracing"
 	"podGopher/integration/web"
+	"podGopher/integration/web/handler"
+	"podGopher/integration/worker"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	postgresClient "gocloud.dev/postgres"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func main() {
 	var app = NewApp("env/.env")
 
-	defer app.Stop()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	app.Start()
+	if err := app.Run(ctx); err != nil {
+		fatal(err)
+	}
+}
+
+// fatal logs why the app can not go on, and exits.
+func fatal(err error) {
+	slog.Error("podGopher failed", "error", err)
+	os.Exit(1)
 }
 
+const (
+	postgresRepository = "postgres"
+	sqliteRepository   = "sqlite"
+	memoryRepository   = "memory"
+
+	defaultListenAddress     = ":3000"
+	defaultReadHeaderTimeout = 10 * time.Second
+	defaultIdleTimeout       = 2 * time.Minute
+	defaultShutdownTimeout   = 30 * time.Second
+	defaultSchedulerInterval = time.Minute
+
+	// healthCheckTimeout stays below the timeouts of usual readiness probes, so a hanging component is reported
+	healthCheckTimeout = 3 * time.Second
+
+	jobWorkers      = 4
+	jobPollInterval = time.Second
+	// jobLease has to exceed the longest run of a job handler, since another worker takes the job over afterwards
+	jobLease = 5 * time.Minute
+)
+
+// App runs the web server and the background work. Its context ends the background work, requests are ended
+// by the shutdown of the server instead, so they finish first.
 type App struct {
-	ctx    context.Context
-	db     *sql.DB
-	router *gin.Engine
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              *sql.DB
+	migration       *migration.Migration
+	healthChecks    *health.CheckReadinessService
+	metrics         *prometheus.Registry
+	tracerProvider  *sdktrace.TracerProvider
+	repositories    *repository.Repositories
+	mediaStorage    *file.FileMediaOutAdapter
+	router          *gin.Engine
+	server          *http.Server
+	serveErr        chan error
+	address         string
+	shutdownTimeout time.Duration
+	scheduler       *scheduler.Scheduler
+	scheduling      sync.WaitGroup
+	worker          *worker.Worker
 }
 
 func loadEnvironment(filename string) {
 	if err := env.Load(filename); err != nil {
-		log.Fatal(err)
+		fatal(err)
 	}
 }
 
 func NewApp(environmentFilePath string) *App {
 	loadEnvironment(environmentFilePath)
+	installLogging()
+	ctx, cancel := context.WithCancel(context.Background())
 	var app = &App{
-		context.Background(),
-		nil,
-		nil,
+		ctx:      ctx,
+		cancel:   cancel,
+		serveErr: make(chan error, 1),
+
+		healthChecks: health.NewCheckReadinessService(healthCheckTimeout),
+		metrics:      createMetrics(),
 	}
-	app.createSqlDb()
+	app.installTracing()
+	app.createRepositories()
+	app.createMediaStorage()
 
-	app.startMigration()
-	app.createWebRouter()
+	var portMap = app.createPortMap()
+	app.backfillShowGuids(portMap)
+	app.createWebRouter(portMap)
+	app.createServer()
+	app.createScheduler(portMap)
+	app.createWorker(portMap)
 
 	return app
 }
 
-func (app *App) createWebRouter() {
-	var portMap = app.createPortMap()
-	app.router = web.NewRouter(portMap)
+func (app *App) createWebRouter(portMap inbound.PortMap) {
+	app.router = web.NewRouter(portMap, app.metrics)
+}
+
+// installLogging logs records from LogLevel on in LogFormat, the log of the standard library included.
+func installLogging() {
+	logger, err := logging.NewLogger(os.Stderr, env.LogLevel.GetValue(), env.LogFormat.GetValue())
+	if err != nil {
+		fatal(err)
+	}
+	slog.SetDefault(logger)
+}
+
+// installTracing installs the tracer provider before anything is created, as SQL statements are traced by the
+// provider which is installed when the database is opened.
+func (app *App) installTracing() {
+	provider, err := tracing.Install(app.ctx, env.TraceExporter.GetValue(), os.Stdout)
+	if err != nil {
+		fatal(err)
+	}
+	app.tracerProvider = provider
+}
+
+// createMetrics collects metrics of the Go runtime and the process next to the metrics of the app.
+func createMetrics() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	return registry
+}
+
+// createServer limits the time to read request headers and to keep idle connections. Reading and writing whole
+// requests is unlimited unless configured, since uploads and downloads of media take long on slow connections.
+func (app *App) createServer() {
+	address := env.ListenAddress.GetValue()
+	if address == "" {
+		address = defaultListenAddress
+	}
+	app.server = &http.Server{
+		Addr:              address,
+		Handler:           app.router,
+		ReadHeaderTimeout: durationSetting(env.ReadHeaderTimeout, defaultReadHeaderTimeout),
+		ReadTimeout:       durationSetting(env.ReadTimeout, 0),
+		WriteTimeout:      durationSetting(env.WriteTimeout, 0),
+		IdleTimeout:       durationSetting(env.IdleTimeout, defaultIdleTimeout),
+	}
+	app.shutdownTimeout = durationSetting(env.ShutdownTimeout, defaultShutdownTimeout)
+}
+
+// createScheduler publishes scheduled episodes every SchedulerInterval, a minute unless the environment says otherwise.
+func (app *App) createScheduler(portMap inbound.PortMap) {
+	interval := durationSetting(env.SchedulerInterval, defaultSchedulerInterval)
+	if interval == 0 {
+		fatal(fmt.Errorf("%s must not be zero", env.SchedulerInterval))
+	}
+	app.scheduler = scheduler.NewScheduler(portMap, interval)
+}
+
+// durationSetting parses a duration like '30s' of the environment, zero turns a timeout off. Without a value
+// it is the fallback.
+func durationSetting(name env.Name, fallback time.Duration) time.Duration {
+	value := name.GetValue()
+	if value == "" {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		fatal(fmt.Errorf("%s '%s' is not a duration like '30s'", name, value))
+	}
+	return duration
+}
+
+// createWorker registers a handler for every job type, before the workers process any job.
+func (app *App) createWorker(portMap inbound.PortMap) {
+	processJobPort := portMap[inbound.ProcessJob].(inbound.ProcessJobPort)
+	processJobPort.RegisterJobHandler(scheduler.PublishDueEpisodesJob, scheduler.NewPublishDueEpisodesJobHandler(portMap))
+	app.worker = worker.NewWorker(portMap, jobWorkers, jobPollInterval)
+}
+
+// Run serves until the context is done, like on a signal, or the server fails. Then it stops the app.
+func (app *App) Run(ctx context.Context) error {
+	if err := app.Start(); err != nil {
+		return errors.Join(err, app.Stop())
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-app.serveErr:
+	}
+	return errors.Join(err, app.Stop())
+}
+
+// Start listens on the configured address and starts the background work. It returns once the server accepts
+// connections, a later failure of the server is reported by Run.
+func (app *App) Start() error {
+	listener, err := net.Listen("tcp", app.server.Addr)
+	if err != nil {
+		return err
+	}
+	app.address = listener.Addr().String()
+	slog.Info("listening", "address", app.address)
+
+	app.worker.Start(app.ctx)
+	app.scheduling.Go(func() {
+		app.scheduler.Run(app.ctx)
+	})
+	go func() {
+		if err := app.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			app.serveErr <- err
+		}
+	}()
+	return nil
 }
 
-func (app *App) Start() {
-	log.Fatal(app.router.Run(":3000"))
+// Stop shuts down in order: the server stops accepting connections and finishes its requests, which may still
+// queue jobs. Then the scheduler stops and the workers finish their jobs. The database closes last, the traces
+// are flushed after it.
+//
+// Each phase gets ShutdownTimeout of its own, so slow requests do not cut the jobs or the traces short. Requests and
+// jobs which do not finish in time are abandoned. Abandoned jobs run again once their lease ended.
+func (app *App) Stop() error {
+	err := app.shutdownServer()
+
+	app.cancel()
+	err = errors.Join(err, app.drainBackground())
+
+	if app.migration != nil {
+		err = errors.Join(err, app.migration.Close())
+	}
+	if app.db != nil {
+		err = errors.Join(err, app.db.Close())
+	}
+	err = errors.Join(err, app.mediaStorage.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
+	defer cancel()
+	return errors.Join(err, app.tracerProvider.Shutdown(ctx))
 }
 
-func (app *App) Stop() {
-	app.db.Close()
-	app.ctx.Done()
+func (app *App) shutdownServer() error {
+	ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
+	defer cancel()
+
+	if err := app.server.Shutdown(ctx); err != nil {
+		return errors.Join(fmt.Errorf("abandoning requests: %w", err), app.server.Close())
+	}
+	return nil
+}
+
+// drainBackground waits for the scheduler and the workers, as both may still use the database.
+func (app *App) drainBackground() error {
+	ctx, cancel := context.WithTimeout(context.Background(), app.shutdownTimeout)
+	defer cancel()
+
+	drained := make(chan struct{})
+	go func() {
+		app.scheduling.Wait()
+		app.worker.Drain()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("abandoning jobs: %w", ctx.Err())
+	}
 }
 
 func (app *App) createPortMap() inbound.PortMap {
-	var showRepository = repositoryShow.NewPostgresShowRepository(app.db)
-	var episodeRepository = repositoryEpisode.NewPostgresEpisodeRepository(app.db)
-	var createShowPort = show.NewCreateShowService(showRepository)
+	var showRepository = app.repositories.Shows
+	var episodeRepository = app.repositories.Episodes
+	var downloadRepository = app.repositories.Downloads
+	var jobRepository = app.repositories.Jobs
+	var feedUrlOf = canonicalFeedUrlOf()
+	var createShowPort = show.NewCreateShowService(showRepository, feedUrlOf)
 	var getShowPort = show.NewGetShowService(showRepository)
+	var getShowBySlugPort = show.NewGetShowBySlugService(showRepository)
+	var listShowsPort = show.NewListShowsService(showRepository)
 	var createEpisodePort = episode.NewCreateEpisodeService(showRepository, episodeRepository)
 	var getEpisodePort = episode.NewGetEpisodeService(showRepository, episodeRepository)
+	var listEpisodesPort = episode.NewListEpisodesService(showRepository, episodeRepository)
+	var updateShowPort = show.NewUpdateShowService(showRepository, showRepository, feedUrlOf)
+	var deleteShowPort = show.NewDeleteShowService(showRepository, episodeRepository, showRepository, app.mediaStorage)
+	var updateEpisodePort = episode.NewUpdateEpisodeService(showRepository, episodeRepository, episodeRepository)
+	var deleteEpisodePort = episode.NewDeleteEpisodeService(showRepository, episodeRepository, episodeRepository, app.mediaStorage)
+	var getShowFeedPort = show.NewGetShowFeedService(showRepository, showRepository, episodeRepository)
+	var uploadEpisodeMediaPort = episode.NewUploadEpisodeMediaService(showRepository, episodeRepository, episodeRepository, app.mediaStorage)
+	var getEpisodeMediaPort = episode.NewGetEpisodeMediaService(episodeRepository, app.mediaStorage, downloadRepository, createAnonymizer())
+	var getAnalyticsPort = analytics.NewGetAnalyticsService(showRepository, episodeRepository, downloadRepository)
+	var publishEpisodePort = episode.NewPublishEpisodeService(showRepository, episodeRepository, episodeRepository)
+	var scheduleEpisodePort = episode.NewScheduleEpisodeService(showRepository, episodeRepository, episodeRepository)
+	var unpublishEpisodePort = episode.NewUnpublishEpisodeService(showRepository, episodeRepository, episodeRepository)
+	var publishDueEpisodesPort = episode.NewPublishDueEpisodesService(episodeRepository)
+	var enqueueJobPort = job.NewEnqueueJobService(jobRepository)
+	var processJobPort = job.NewProcessJobService(jobRepository, jobRepository, jobLease)
+	var backfillShowGuidsPort = show.NewBackfillShowGuidsService(showRepository, feedUrlOf)
 	return inbound.PortMap{
-		inbound.CreateShow:    createShowPort,
-		inbound.GetShow:       getShowPort,
-		inbound.CreateEpisode: createEpisodePort,
-		inbound.GetEpisode:    getEpisodePort,
+		inbound.CreateShow:         createShowPort,
+		inbound.GetShow:            getShowPort,
+		inbound.CreateEpisode:      createEpisodePort,
+		inbound.GetEpisode:         getEpisodePort,
+		inbound.GetShowFeed:        getShowFeedPort,
+		inbound.UploadEpisodeMedia: uploadEpisodeMediaPort,
+		inbound.GetEpisodeMedia:    getEpisodeMediaPort,
+		inbound.GetAnalytics:       getAnalyticsPort,
+		inbound.ListShows:          listShowsPort,
+		inbound.ListEpisodes:       listEpisodesPort,
+		inbound.UpdateShow:         updateShowPort,
+		inbound.DeleteShow:         deleteShowPort,
+		inbound.UpdateEpisode:      updateEpisodePort,
+		inbound.DeleteEpisode:      deleteEpisodePort,
+		inbound.GetShowBySlug:      getShowBySlugPort,
+		inbound.PublishEpisode:     publishEpisodePort,
+		inbound.ScheduleEpisode:    scheduleEpisodePort,
+		inbound.UnpublishEpisode:   unpublishEpisodePort,
+		inbound.PublishDueEpisodes: publishDueEpisodesPort,
+		inbound.EnqueueJob:         enqueueJobPort,
+		inbound.ProcessJob:         processJobPort,
+		inbound.CheckReadiness:     app.healthChecks,
+		inbound.BackfillShowGuids:  backfillShowGuidsPort,
 	}
 }
 
+// createRepositories stores shows, episodes, downloads and jobs in Postgres, unless the environment selects
+// a single SQLite file, or the in-memory repositories, which lose everything on shutdown. All of them are measured
+// into the metrics of the app.
+func (app *App) createRepositories() {
+	switch selected := env.Repository.GetValue(); selected {
+	case memoryRepository:
+		store := memory.NewStore()
+		app.repositories = &repository.Repositories{
+			Shows:     memory.NewMemoryShowRepository(store),
+			Episodes:  memory.NewMemoryEpisodeRepository(store),
+			Downloads: memory.NewMemoryDownloadRepository(store),
+			Jobs:      memory.NewMemoryJobRepository(store),
+		}
+	case sqliteRepository:
+		app.createSqliteDb()
+		app.repositories = &repository.Repositories{
+			Shows:     sqliteShow.NewSqliteShowRepository(app.db),
+			Episodes:  sqliteEpisode.NewSqliteEpisodeRepository(app.db),
+			Downloads: sqliteDownload.NewSqliteDownloadRepository(app.db),
+			Jobs:      sqliteJob.NewSqliteJobRepository(app.db),
+		}
+	case postgresRepository, "":
+		app.createSqlDb()
+		app.startMigration()
+		app.repositories = &repository.Repositories{
+			Shows:     repositoryShow.NewPostgresShowRepository(app.db),
+			Episodes:  repositoryEpisode.NewPostgresEpisodeRepository(app.db),
+			Downloads: repositoryDownload.NewPostgresDownloadRepository(app.db),
+			Jobs:      repositoryJob.NewPostgresJobRepository(app.db),
+		}
+	default:
+		fatal(fmt.Errorf("%s '%s' is unknown, use '%s', '%s' or '%s'", env.Repository, selected, postgresRepository, sqliteRepository, memoryRepository))
+	}
+
+	app.repositories = metrics.NewRepositoryMetrics(app.metrics).Instrument(app.repositories)
+	if app.db != nil {
+		app.metrics.MustRegister(collectors.NewDBStatsCollector(app.db, "podgopher"))
+	}
+}
+
+// createSqliteDb opens the SQLite file and migrates it with the migrations embedded in the binary.
+func (app *App) createSqliteDb() {
+	db, err := sqlite.Open(sqliteMigration.GetSqliteConnectionString())
+	if err != nil {
+		fatal(err)
+	}
+	app.db = db
+
+	dbMigration, err := sqliteMigration.NewMigration(db)
+	if err != nil {
+		fatal(err)
+	}
+	if err := dbMigration.Migrate(); err != nil {
+		fatal(err)
+	}
+	app.healthChecks.RegisterHealthCheck(repository.NewDatabaseHealthCheck(db))
+	app.healthChecks.RegisterHealthCheck(repository.NewMigrationHealthCheck(dbMigration))
+}
+
 func (app *App) createSqlDb() {
 	dsn := migration.GetPostgresConnectionString()
-	db, err := postgresClient.Open(app.ctx, dsn)
+	db, err := postgres.Open(app.ctx, dsn)
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
 	}
 	app.db = db
+	app.healthChecks.RegisterHealthCheck(repository.NewDatabaseHealthCheck(db))
+}
+
+func (app *App) createMediaStorage() {
+	mediaStorage, err := file.NewFileMediaStorage(env.MediaDir.GetValue())
+	if err != nil {
+		fatal(err)
+	}
+	app.mediaStorage = mediaStorage
+	app.healthChecks.RegisterHealthCheck(mediaStorage)
+}
+
+// canonicalFeedUrlOf gives the feed urls under BaseUrl, the public url of the app. The podcast:guid of shows derives
+// from them, so it does not change with the host a request was sent to.
+func canonicalFeedUrlOf() func(showId string) string {
+	baseUrl := strings.TrimRight(env.BaseUrl.GetValue(), "/")
+	if parsed, err := url.Parse(baseUrl); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		fatal(fmt.Errorf("%s '%s' must be an absolute url like 'https://podcasts.example.com'", env.BaseUrl, baseUrl))
+	}
+	return handler.FeedUrlOf(baseUrl)
+}
+
+// backfillShowGuids completes the migration of the database: shows stored without podcast:guid, whose feeds derived
+// it on every request, get the guid derived from their canonical feed url stored.
+func (app *App) backfillShowGuids(portMap inbound.PortMap) {
+	backfillShowGuidsPort := portMap[inbound.BackfillShowGuids].(inbound.BackfillShowGuidsPort)
+	backfilled, err := backfillShowGuidsPort.BackfillShowGuids(app.ctx, &inbound.BackfillShowGuidsCommand{})
+	if err != nil {
+		fatal(err)
+	}
+	if len(backfilled.ShowIds) > 0 {
+		slog.Info("stored podcast:guid of shows", "shows", len(backfilled.ShowIds))
+	}
+}
+
+// createAnonymizer requires a secret, since hashes of remote addresses without one can be reversed by trying all addresses
+func createAnonymizer() *analytics.Anonymizer {
+	secret := env.AnalyticsSecret.GetValue()
+	if secret == "" {
+		fatal(fmt.Errorf("%s must be set", env.AnalyticsSecret))
+	}
+	return analytics.NewAnonymizer(secret)
 }
 
+// startMigration stops the app if the migration fails, like the SQLite migration does. The database is reachable
+// once the migration is created, so the failure is one of the schema, which the app cannot run on.
 func (app *App) startMigration() {
 	dbMigration, err := migration.NewMigration()
 	if err != nil {
-		log.Fatal(err)
+		fatal(err)
 	}
+	app.migration = dbMigration
+	app.healthChecks.RegisterHealthCheck(repository.NewMigrationHealthCheck(dbMigration))
 	if err := dbMigration.Migrate(); err != nil {
-		log.Printf("WARNING on migration: %s", err)
+		fatal(err)
 	}
 }
@@ -2,13 +2,26 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"podGopher/adapter/outbound/repository/postgres/postgresTestSetup"
+	"podGopher/core/domain/model"
+	"podGopher/env"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var app *App
@@ -16,6 +29,7 @@ var db *sql.DB
 
 func setup(t *testing.T) {
 	db = postgresTestSetup.StartTestcontainersPostgres(t, "adapter/outbound/repository/postgres/postgresTestSetup/")
+	t.Setenv(string(env.ListenAddress), "localhost:0")
 	app = NewApp("env/.testcontainers-env")
 }
 
@@ -23,17 +37,266 @@ func Test_should_load_context(t *testing.T) {
 	setup(t)
 
 	defer postgresTestSetup.Teardown(t, db)
-	defer app.Stop()
+	defer func() {
+		assert.Nil(t, app.Stop())
+	}()
 
-	go app.Start()
-	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, app.Start())
 
 	t.Run("should add a show", func(t *testing.T) {
-		postShowRequest := `{"Title":"some title", "Slug":"some slug"}`
-		response, err := http.Post("http://localhost:3000/show", "application/json", bytes.NewBuffer([]byte(postShowRequest)))
+		postShowRequest := `{"Title":"some title", "Slug":"some-slug"}`
+		response, err := http.Post("http://"+app.address+"/show", "application/json", bytes.NewBuffer([]byte(postShowRequest)))
 		if err != nil {
 			t.Fatal(err)
 		}
 		assert.Equal(t, http.StatusCreated, response.StatusCode)
 	})
 }
+
+func Test_should_load_context_with_memory_repositories(t *testing.T) {
+	t.Setenv(string(env.Repository), "memory")
+	memoryApp := NewApp("env/.testcontainers-env")
+	defer func() {
+		assert.Nil(t, memoryApp.Stop())
+	}()
+
+	postShowRequest := `{"Title":"some title", "Slug":"some-slug"}`
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/show", bytes.NewBuffer([]byte(postShowRequest)))
+	memoryApp.router.ServeHTTP(recorder, request)
+
+	assert.Nil(t, memoryApp.db)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_load_context_with_sqlite_repositories(t *testing.T) {
+	t.Setenv(string(env.Repository), "sqlite")
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	sqliteApp := NewApp("env/.testcontainers-env")
+	defer func() {
+		assert.Nil(t, sqliteApp.Stop())
+	}()
+
+	postShowRequest := `{"Title":"some title", "Slug":"some-slug"}`
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/show", bytes.NewBuffer([]byte(postShowRequest)))
+	sqliteApp.router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+}
+
+func Test_should_store_guid_of_shows_without_guid_on_start(t *testing.T) {
+	t.Setenv(string(env.Repository), "sqlite")
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	formerApp := NewApp("env/.testcontainers-env")
+	show := &model.Show{Id: uuid.NewString(), Title: "some title", Slug: "some-slug"}
+	require.Nil(t, formerApp.repositories.Shows.SaveShow(t.Context(), show))
+	require.Nil(t, formerApp.Stop())
+
+	sqliteApp := NewApp("env/.testcontainers-env")
+	defer func() {
+		assert.Nil(t, sqliteApp.Stop())
+	}()
+
+	found, err := sqliteApp.repositories.Shows.GetShowOrNil(t.Context(), show.Id)
+	require.Nil(t, err)
+	assert.Equal(t, model.NewPodcastGuid("http://localhost:3000/show/"+show.Id+"/feed.xml"), found.Guid)
+}
+
+func Test_should_expose_metrics_of_sqlite_repositories(t *testing.T) {
+	t.Setenv(string(env.Repository), "sqlite")
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	sqliteApp := NewApp("env/.testcontainers-env")
+	defer func() {
+		assert.Nil(t, sqliteApp.Stop())
+	}()
+
+	postShowRequest := `{"Title":"some title", "Slug":"some-slug"}`
+	request, _ := http.NewRequest("POST", "/show", bytes.NewBuffer([]byte(postShowRequest)))
+	sqliteApp.router.ServeHTTP(httptest.NewRecorder(), request)
+	recorder := httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/metrics", nil)
+	sqliteApp.router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `podgopher_http_requests_total{method="POST",route="/show",status="201"} 1`)
+	assert.Contains(t, recorder.Body.String(), `podgopher_repository_call_duration_seconds_count{method="SaveShow",port="SaveShowPort"} 1`)
+	assert.Contains(t, recorder.Body.String(), "podgopher_shows_created_total 1")
+	assert.Contains(t, recorder.Body.String(), `go_sql_open_connections{db_name="podgopher"}`)
+	assert.Contains(t, recorder.Body.String(), "go_goroutines")
+}
+
+func Test_should_be_ready_with_migrated_sqlite_database(t *testing.T) {
+	t.Setenv(string(env.Repository), "sqlite")
+	t.Setenv(string(env.SqlitePath), filepath.Join(t.TempDir(), "podgopher.db"))
+	t.Setenv(string(env.MediaDir), t.TempDir())
+	sqliteApp := NewApp("env/.testcontainers-env")
+	defer func() {
+		assert.Nil(t, sqliteApp.Stop())
+	}()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/readyz", nil)
+	sqliteApp.router.ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"name":"database","status":"up"`)
+	assert.Contains(t, recorder.Body.String(), `"name":"migration","status":"up","details":{"dirty":false,"expectedVersion":9,"version":9}`)
+	assert.Contains(t, recorder.Body.String(), `"name":"storage","status":"up"`)
+}
+
+// newMemoryApp starts nothing, the test starts the app on a free port of localhost.
+func newMemoryApp(t *testing.T) *App {
+	t.Setenv(string(env.Repository), "memory")
+	t.Setenv(string(env.ListenAddress), "localhost:0")
+	t.Setenv(string(env.MediaDir), t.TempDir())
+	return NewApp("env/.testcontainers-env")
+}
+
+func Test_should_finish_in_flight_request_on_stop(t *testing.T) {
+	shutdownApp := newMemoryApp(t)
+	entered, release := make(chan struct{}), make(chan struct{})
+	shutdownApp.router.GET("/slow", func(context *gin.Context) {
+		close(entered)
+		<-release
+		context.String(http.StatusOK, "finished")
+	})
+	require.Nil(t, shutdownApp.Start())
+	address := shutdownApp.address
+
+	responses := make(chan *http.Response, 1)
+	go func() {
+		response, err := http.Get("http://" + address + "/slow")
+		assert.Nil(t, err)
+		responses <- response
+	}()
+	<-entered
+
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- shutdownApp.Stop()
+	}()
+
+	assert.Eventually(t, func() bool {
+		connection, err := net.Dial("tcp", address)
+		if err == nil {
+			_ = connection.Close()
+		}
+		return err != nil
+	}, time.Second, time.Millisecond, "the server still accepts connections")
+	assert.Empty(t, stopped, "stopped before the request finished")
+	close(release)
+
+	response := <-responses
+	require.NotNil(t, response)
+	body, _ := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "finished", string(body))
+	assert.Nil(t, <-stopped)
+}
+
+func Test_should_abandon_requests_exceeding_shutdown_timeout(t *testing.T) {
+	t.Setenv(string(env.ShutdownTimeout), "10ms")
+	shutdownApp := newMemoryApp(t)
+	entered, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	shutdownApp.router.GET("/slow", func(context *gin.Context) {
+		close(entered)
+		<-release
+	})
+	require.Nil(t, shutdownApp.Start())
+
+	go func() {
+		_, _ = http.Get("http://" + shutdownApp.address + "/slow")
+	}()
+	<-entered
+
+	err := shutdownApp.Stop()
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotContains(t, err.Error(), "abandoning jobs", "the jobs got no time of their own")
+}
+
+func Test_should_stop_when_context_of_run_is_done(t *testing.T) {
+	runApp := newMemoryApp(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	assert.Nil(t, runApp.Run(ctx))
+}
+
+func Test_should_fail_to_run_on_address_in_use(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.Nil(t, err)
+	defer func() {
+		_ = listener.Close()
+	}()
+	runApp := newMemoryApp(t)
+	runApp.server.Addr = listener.Addr().String()
+
+	err = runApp.Run(t.Context())
+
+	assert.NotNil(t, err)
+}
+
+func Test_should_list_latest_episodes_first_in_feed(t *testing.T) {
+	feedApp := newMemoryApp(t)
+	defer func() {
+		assert.Nil(t, feedApp.Stop())
+	}()
+	show := &model.Show{Id: uuid.NewString(), Title: "some title", Slug: "some-slug"}
+	require.Nil(t, feedApp.repositories.Shows.SaveShow(t.Context(), show))
+	publishedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	for _, days := range []int{1, 3, 2} {
+		require.Nil(t, feedApp.repositories.Episodes.SaveEpisode(t.Context(), &model.Episode{
+			Id:          uuid.NewString(),
+			ShowId:      show.Id,
+			Title:       fmt.Sprintf("episode of day %d", days),
+			Status:      model.EpisodePublished,
+			PublishedAt: publishedAt.AddDate(0, 0, days),
+		}))
+	}
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/show/"+show.Id+"/feed.xml", nil)
+	feedApp.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	feed := recorder.Body.String()
+	third, second, first := strings.Index(feed, "episode of day 3"), strings.Index(feed, "episode of day 2"), strings.Index(feed, "episode of day 1")
+	assert.True(t, 0 <= third && third < second && second < first, "items are not ordered latest first")
+}
+
+func Test_should_derive_guid_of_show_from_base_url_instead_of_request(t *testing.T) {
+	guidApp := newMemoryApp(t)
+	defer func() {
+		assert.Nil(t, guidApp.Stop())
+	}()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/show", strings.NewReader(`{"title":"some title"}`))
+	request.Host = "attacker.example.com"
+	guidApp.router.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var created struct{ Id, Guid string }
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &created))
+	assert.Equal(t, model.NewPodcastGuid("http://localhost:3000/show/"+created.Id+"/feed.xml"), created.Guid)
+}
+
+func Test_should_name_trace_of_request_without_trace_exporter(t *testing.T) {
+	t.Setenv(string(env.TraceExporter), "none")
+	tracedApp := newMemoryApp(t)
+	defer func() {
+		assert.Nil(t, tracedApp.Stop())
+	}()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/show/"+uuid.NewString(), nil)
+	tracedApp.router.ServeHTTP(recorder, request)
+
+	traceparent := strings.Split(recorder.Header().Get("traceparent"), "-")
+	require.Len(t, traceparent, 4)
+	assert.NotEqual(t, strings.Repeat("0", 32), traceparent[1])
+}